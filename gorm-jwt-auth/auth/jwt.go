@@ -99,6 +99,28 @@ func (a *Auth) RevokeToken(jwt string) error {
 	return a.RedisRepo.DeleteKey(jwtRedisKey(jwt))
 }
 
+// RevokeUserTokens revokes every token of the user except the one passed, which can be empty
+func (a *Auth) RevokeUserTokens(userID int, except string) error {
+	keys, err := a.RedisRepo.GetMembers(userIdIndexKey(userID))
+	if err != nil {
+		return errors.Wrap(err, "failed to get tokens of user")
+	}
+
+	for _, key := range keys {
+		keyString := string(key.([]uint8))
+		if except != "" && keyString == jwtRedisKey(except) {
+			continue
+		}
+
+		err = a.RedisRepo.DeleteKeyAndSetMembership(userIdIndexKey(userID), keyString)
+		if err != nil {
+			return errors.Wrap(err, "failed to revoke token")
+		}
+	}
+
+	return nil
+}
+
 func (a *Auth) GetTTL(jwt string) (int, error) {
 	return a.RedisRepo.GetTTL(jwtRedisKey(jwt))
 }
@@ -0,0 +1,259 @@
+package loginguard
+
+import (
+	"context"
+	"github.com/gomodule/redigo/redis"
+	"github.com/pkg/errors"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type Action string
+
+const (
+	ActionLogin              Action = "Login"
+	ActionForgotPassword     Action = "ForgotPassword"
+	ActionResendConfirmation Action = "ResendConfirmation"
+
+	attemptsKeyPrefix = "Guard:Attempts:"
+	lockKeyPrefix     = "Guard:Lock:"
+)
+
+var (
+	ErrLocked = errors.New("too many attempts, try again later")
+)
+
+// Policy limits the failed attempts of an action inside a sliding window. After FreeAttempts failures every new
+// attempt is delayed exponentially starting at BaseDelay, reaching MaxFailures locks the email or IP for LockDuration
+type Policy struct {
+	Window           time.Duration
+	MaxFailuresEmail int
+	MaxFailuresIP    int
+	FreeAttempts     int
+	BaseDelay        time.Duration
+	MaxDelay         time.Duration
+	LockDuration     time.Duration
+}
+
+var DefaultPolicies = map[Action]Policy{
+	ActionLogin: {
+		Window:           15 * time.Minute,
+		MaxFailuresEmail: 10,
+		MaxFailuresIP:    50,
+		FreeAttempts:     3,
+		BaseDelay:        250 * time.Millisecond,
+		MaxDelay:         4 * time.Second,
+		LockDuration:     15 * time.Minute,
+	},
+	ActionForgotPassword: {
+		Window:           time.Hour,
+		MaxFailuresEmail: 5,
+		MaxFailuresIP:    20,
+		FreeAttempts:     5,
+		LockDuration:     time.Hour,
+	},
+	ActionResendConfirmation: {
+		Window:           time.Hour,
+		MaxFailuresEmail: 5,
+		MaxFailuresIP:    20,
+		FreeAttempts:     5,
+		LockDuration:     time.Hour,
+	},
+}
+
+// Attempt identifies who is trying an action
+type Attempt struct {
+	Action Action
+	Email  string
+	IP     string
+}
+
+// slidingWindowScript registers a failure at ARGV[1] (ms) and returns the failures inside the window of ARGV[2] ms
+var slidingWindowScript = redis.NewScript(1, `
+redis.call("ZREMRANGEBYSCORE", KEYS[1], 0, tonumber(ARGV[1]) - tonumber(ARGV[2]))
+redis.call("ZADD", KEYS[1], ARGV[1], ARGV[3])
+redis.call("PEXPIRE", KEYS[1], ARGV[2])
+return redis.call("ZCARD", KEYS[1])
+`)
+
+// Guard keeps Redis backed counters of failed attempts per email and per IP
+type Guard struct {
+	Pool     *redis.Pool
+	Policies map[Action]Policy
+	// Sleep is replaceable so tests don't wait for the progressive delays
+	Sleep func(ctx context.Context, delay time.Duration) error
+}
+
+func NewGuard(pool *redis.Pool) *Guard {
+	return &Guard{
+		Pool:     pool,
+		Policies: DefaultPolicies,
+		Sleep:    sleep,
+	}
+}
+
+// Wait rejects the attempt if the email or IP are locked, otherwise it applies the progressive delay
+func (g *Guard) Wait(ctx context.Context, attempt Attempt) error {
+	conn := g.Pool.Get()
+	defer conn.Close()
+
+	policy := g.Policies[attempt.Action]
+	failures := 0
+	for _, subject := range attempt.subjects() {
+		locked, err := redis.Bool(conn.Do("EXISTS", lockRedisKey(attempt.Action, subject)))
+		if err != nil {
+			return errors.Wrap(err, "failed to check lock")
+		} else if locked {
+			return ErrLocked
+		}
+
+		count, err := redis.Int(conn.Do("ZCOUNT", attemptsRedisKey(attempt.Action, subject), nowMillis()-policy.Window.Milliseconds(), "+inf"))
+		if err != nil {
+			return errors.Wrap(err, "failed to count attempts")
+		}
+		if count > failures {
+			failures = count
+		}
+	}
+
+	delay := policy.delay(failures)
+	if delay > 0 {
+		return g.Sleep(ctx, delay)
+	}
+
+	return nil
+}
+
+// Fail registers a failed attempt and locks the email or IP that reached the limit
+func (g *Guard) Fail(attempt Attempt) error {
+	conn := g.Pool.Get()
+	defer conn.Close()
+
+	policy := g.Policies[attempt.Action]
+	now := nowMillis()
+	member := strconv.FormatInt(time.Now().UnixNano(), 10)
+
+	for subject, maxFailures := range map[string]int{
+		emailSubject(attempt.Email): policy.MaxFailuresEmail,
+		ipSubject(attempt.IP):       policy.MaxFailuresIP,
+	} {
+		if subject == "" {
+			continue
+		}
+
+		count, err := redis.Int(slidingWindowScript.Do(conn, attemptsRedisKey(attempt.Action, subject), now, policy.Window.Milliseconds(), member))
+		if err != nil {
+			return errors.Wrap(err, "failed to register attempt")
+		}
+
+		if maxFailures > 0 && count >= maxFailures {
+			_, err = conn.Do("SET", lockRedisKey(attempt.Action, subject), now, "PX", policy.LockDuration.Milliseconds())
+			if err != nil {
+				return errors.Wrap(err, "failed to lock")
+			}
+		}
+	}
+
+	return nil
+}
+
+// Succeed clears the failures of the email, IP failures are kept so one valid account can't reset them
+func (g *Guard) Succeed(attempt Attempt) error {
+	conn := g.Pool.Get()
+	defer conn.Close()
+
+	if attempt.Email == "" {
+		return nil
+	}
+
+	_, err := conn.Do("DEL", attemptsRedisKey(attempt.Action, emailSubject(attempt.Email)))
+	if err != nil {
+		return errors.Wrap(err, "failed to clear attempts")
+	}
+	return nil
+}
+
+// Unlock clears the locks and failures of the email and/or IP for every action
+func (g *Guard) Unlock(email string, ip string) error {
+	conn := g.Pool.Get()
+	defer conn.Close()
+
+	var keys []interface{}
+	for action := range g.Policies {
+		for _, subject := range (Attempt{Email: email, IP: ip}).subjects() {
+			keys = append(keys, attemptsRedisKey(action, subject), lockRedisKey(action, subject))
+		}
+	}
+	if len(keys) == 0 {
+		return nil
+	}
+
+	_, err := conn.Do("DEL", keys...)
+	if err != nil {
+		return errors.Wrap(err, "failed to unlock")
+	}
+	return nil
+}
+
+func (p Policy) delay(failures int) time.Duration {
+	if p.BaseDelay == 0 || failures <= p.FreeAttempts {
+		return 0
+	}
+
+	delay := time.Duration(float64(p.BaseDelay) * math.Pow(2, float64(failures-p.FreeAttempts-1)))
+	if delay > p.MaxDelay || delay <= 0 {
+		return p.MaxDelay
+	}
+	return delay
+}
+
+func (a Attempt) subjects() []string {
+	var subjects []string
+	if a.Email != "" {
+		subjects = append(subjects, emailSubject(a.Email))
+	}
+	if a.IP != "" {
+		subjects = append(subjects, ipSubject(a.IP))
+	}
+	return subjects
+}
+
+func emailSubject(email string) string {
+	if email == "" {
+		return ""
+	}
+	return "Email:" + strings.ToLower(strings.TrimSpace(email))
+}
+
+func ipSubject(ip string) string {
+	if ip == "" {
+		return ""
+	}
+	return "IP:" + ip
+}
+
+func attemptsRedisKey(action Action, subject string) string {
+	return attemptsKeyPrefix + string(action) + ":" + subject
+}
+
+func lockRedisKey(action Action, subject string) string {
+	return lockKeyPrefix + string(action) + ":" + subject
+}
+
+func nowMillis() int64 {
+	return time.Now().UnixNano() / int64(time.Millisecond)
+}
+
+func sleep(ctx context.Context, delay time.Duration) error {
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,93 @@
+package loginguard
+
+import (
+	"context"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/gomodule/redigo/redis"
+	"github.com/stretchr/testify/suite"
+	"testing"
+	"time"
+)
+
+type GuardSuite struct {
+	suite.Suite
+	redis  *miniredis.Miniredis
+	guard  *Guard
+	delays []time.Duration
+}
+
+func (s *GuardSuite) SetupTest() {
+	s.redis = miniredis.RunT(s.T())
+	s.guard = NewGuard(&redis.Pool{
+		Dial: func() (redis.Conn, error) {
+			return redis.Dial("tcp", s.redis.Addr())
+		},
+	})
+	s.guard.Policies = map[Action]Policy{
+		ActionLogin: {
+			Window:           time.Minute,
+			MaxFailuresEmail: 5,
+			MaxFailuresIP:    8,
+			FreeAttempts:     2,
+			BaseDelay:        100 * time.Millisecond,
+			MaxDelay:         300 * time.Millisecond,
+			LockDuration:     time.Minute,
+		},
+	}
+	s.delays = nil
+	s.guard.Sleep = func(ctx context.Context, delay time.Duration) error {
+		s.delays = append(s.delays, delay)
+		return nil
+	}
+}
+
+func (s *GuardSuite) TestGuard_ProgressiveDelayAndLock() {
+	attempt := Attempt{Action: ActionLogin, Email: "User@Jevels.com", IP: "10.0.0.1"}
+
+	for i := 0; i < 5; i++ {
+		s.Require().NoError(s.guard.Wait(context.Background(), attempt))
+		s.Require().NoError(s.guard.Fail(attempt))
+	}
+	s.Assert().Equal([]time.Duration{100 * time.Millisecond, 200 * time.Millisecond}, s.delays)
+	s.Assert().ErrorIs(s.guard.Wait(context.Background(), attempt), ErrLocked)
+
+	// the email is locked regardless of the IP or casing
+	s.Assert().ErrorIs(s.guard.Wait(context.Background(), Attempt{Action: ActionLogin, Email: "user@jevels.com", IP: "10.0.0.2"}), ErrLocked)
+
+	s.Require().NoError(s.guard.Unlock("user@jevels.com", ""))
+	s.Assert().NoError(s.guard.Wait(context.Background(), Attempt{Action: ActionLogin, Email: "user@jevels.com", IP: "10.0.0.2"}))
+}
+
+func (s *GuardSuite) TestGuard_IPLock() {
+	for i := 0; i < 8; i++ {
+		s.Require().NoError(s.guard.Fail(Attempt{Action: ActionLogin, Email: "user" + string(rune('a'+i)) + "@jevels.com", IP: "10.0.0.1"}))
+	}
+
+	s.Assert().ErrorIs(s.guard.Wait(context.Background(), Attempt{Action: ActionLogin, Email: "other@jevels.com", IP: "10.0.0.1"}), ErrLocked)
+	s.Assert().NoError(s.guard.Wait(context.Background(), Attempt{Action: ActionLogin, Email: "other@jevels.com", IP: "10.0.0.2"}))
+}
+
+func (s *GuardSuite) TestGuard_SucceedClearsEmail() {
+	attempt := Attempt{Action: ActionLogin, Email: "user@jevels.com", IP: "10.0.0.1"}
+	for i := 0; i < 4; i++ {
+		s.Require().NoError(s.guard.Fail(attempt))
+	}
+	s.Require().NoError(s.guard.Succeed(attempt))
+
+	s.Require().NoError(s.guard.Wait(context.Background(), Attempt{Action: ActionLogin, Email: "user@jevels.com"}))
+	s.Assert().Empty(s.delays)
+}
+
+func (s *GuardSuite) TestGuard_WindowExpires() {
+	attempt := Attempt{Action: ActionLogin, Email: "user@jevels.com", IP: "10.0.0.1"}
+	for i := 0; i < 5; i++ {
+		s.Require().NoError(s.guard.Fail(attempt))
+	}
+
+	s.redis.FastForward(2 * time.Minute)
+	s.Assert().NoError(s.guard.Wait(context.Background(), attempt))
+}
+
+func TestGuard(t *testing.T) {
+	suite.Run(t, new(GuardSuite))
+}
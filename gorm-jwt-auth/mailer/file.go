@@ -0,0 +1,47 @@
+package mailer
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"github.com/pkg/errors"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// FileMailer writes every message to an .eml file in Dir, they can be opened by any mail client
+type FileMailer struct {
+	Dir string
+}
+
+func (m *FileMailer) Send(message Message) error {
+	body, err := message.Bytes()
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(m.Dir, 0o755)
+	if err != nil {
+		return errors.Wrap(err, "failed to create mail drop directory")
+	}
+
+	random := make([]byte, 4)
+	_, err = rand.Read(random)
+	if err != nil {
+		return errors.Wrap(err, "failed to generate mail file name")
+	}
+	name := time.Now().UTC().Format("20060102T150405.000000000") + "-" + hex.EncodeToString(random) + ".eml"
+
+	// the file is renamed once complete so readers of the directory never see a partial message
+	path := filepath.Join(m.Dir, name)
+	err = os.WriteFile(path+".tmp", body, 0o644)
+	if err != nil {
+		return errors.Wrap(err, "failed to write mail file")
+	}
+	err = os.Rename(path+".tmp", path)
+	if err != nil {
+		return errors.Wrap(err, "failed to write mail file")
+	}
+
+	return nil
+}
@@ -0,0 +1,70 @@
+package mailer
+
+import (
+	"github.com/pkg/errors"
+	"os"
+	"strconv"
+	"time"
+)
+
+const (
+	TransportSMTP   = "smtp"
+	TransportFile   = "file"
+	TransportMemory = "memory"
+)
+
+// Mailer sends a message to its recipient, the transports are interchangeable so dev and tests don't send real emails
+type Mailer interface {
+	Send(message Message) error
+}
+
+// New returns the transport set with the MAIL_TRANSPORT env variable:
+//   - smtp (default) sends through MAIL_SMTP_HOST, MAIL_SMTP_PORT, MAIL_SMTP_USERNAME, MAIL_SMTP_PASSWORD with
+//     MAIL_SMTP_TLS (starttls, implicit or none) and MAIL_SMTP_TIMEOUT in time.ParseDuration format
+//   - file writes .eml files to MAIL_DROP_DIR
+//   - memory keeps the messages, for tests
+func New() (Mailer, error) {
+	switch transport := os.Getenv("MAIL_TRANSPORT"); transport {
+	case "", TransportSMTP:
+		smtpMailer := &SMTPMailer{
+			Host:     os.Getenv("MAIL_SMTP_HOST"),
+			Port:     587,
+			Username: os.Getenv("MAIL_SMTP_USERNAME"),
+			Password: os.Getenv("MAIL_SMTP_PASSWORD"),
+			Timeout:  10 * time.Second,
+		}
+		if smtpMailer.Host == "" {
+			return nil, errors.New("MAIL_SMTP_HOST is required by the smtp mail transport")
+		}
+		if rawPort := os.Getenv("MAIL_SMTP_PORT"); rawPort != "" {
+			port, err := strconv.Atoi(rawPort)
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to parse MAIL_SMTP_PORT")
+			}
+			smtpMailer.Port = port
+		}
+		tlsMode, err := ParseTLSMode(os.Getenv("MAIL_SMTP_TLS"))
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to parse MAIL_SMTP_TLS")
+		}
+		smtpMailer.TLS = tlsMode
+		if rawTimeout := os.Getenv("MAIL_SMTP_TIMEOUT"); rawTimeout != "" {
+			timeout, err := time.ParseDuration(rawTimeout)
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to parse MAIL_SMTP_TIMEOUT")
+			}
+			smtpMailer.Timeout = timeout
+		}
+		return smtpMailer, nil
+	case TransportFile:
+		dir := os.Getenv("MAIL_DROP_DIR")
+		if dir == "" {
+			return nil, errors.New("MAIL_DROP_DIR is required by the file mail transport")
+		}
+		return &FileMailer{Dir: dir}, nil
+	case TransportMemory:
+		return NewMemoryMailer(), nil
+	default:
+		return nil, errors.New("unknown mail transport " + transport)
+	}
+}
@@ -0,0 +1,228 @@
+package mailer
+
+import (
+	"bufio"
+	"github.com/stretchr/testify/suite"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net"
+	"net/mail"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+var testMessage = Message{
+	From:    `"Jevels" <no-reply@jevels.com>`,
+	To:      "ada@example.com",
+	Subject: "Réinitialisez votre mot de passe",
+	HTML:    "<p>Bonjour Ada,</p><p><a href=\"https://jevels.com/reset?key=abc\">Réinitialiser</a></p>",
+	Text:    "Bonjour Ada,\n\nhttps://jevels.com/reset?key=abc\n",
+}
+
+type MailerSuite struct {
+	suite.Suite
+}
+
+// parse decodes the message and returns the bodies of its parts by content type, with LF line endings
+func (s *MailerSuite) parse(raw []byte) (*mail.Message, map[string]string) {
+	message, err := mail.ReadMessage(strings.NewReader(string(raw)))
+	s.Require().NoError(err)
+
+	mediaType, params, err := mime.ParseMediaType(message.Header.Get("Content-Type"))
+	s.Require().NoError(err)
+
+	bodies := map[string]string{}
+	if !strings.HasPrefix(mediaType, "multipart/") {
+		body, err := io.ReadAll(quotedprintable.NewReader(message.Body))
+		s.Require().NoError(err)
+		bodies[mediaType] = strings.ReplaceAll(string(body), "\r\n", "\n")
+		return message, bodies
+	}
+
+	s.Require().Equal("multipart/alternative", mediaType)
+	reader := multipart.NewReader(message.Body, params["boundary"])
+	for {
+		part, err := reader.NextPart()
+		if err == io.EOF {
+			break
+		}
+		s.Require().NoError(err)
+		partType, _, err := mime.ParseMediaType(part.Header.Get("Content-Type"))
+		s.Require().NoError(err)
+		// multipart decodes quoted-printable parts itself
+		body, err := io.ReadAll(part)
+		s.Require().NoError(err)
+		bodies[partType] = strings.ReplaceAll(string(body), "\r\n", "\n")
+	}
+	return message, bodies
+}
+
+func (s *MailerSuite) TestMessage_Bytes() {
+	raw, err := testMessage.Bytes()
+	s.Require().NoError(err)
+
+	message, bodies := s.parse(raw)
+	subject, err := new(mime.WordDecoder).DecodeHeader(message.Header.Get("Subject"))
+	s.Assert().NoError(err)
+	s.Assert().Equal(testMessage.Subject, subject)
+	s.Assert().Equal(`"Jevels" <no-reply@jevels.com>`, message.Header.Get("From"))
+	s.Assert().Equal("<ada@example.com>", message.Header.Get("To"))
+	s.Assert().True(strings.HasSuffix(message.Header.Get("Message-ID"), "@jevels.com>"))
+	s.Assert().Equal(testMessage.HTML, bodies["text/html"])
+	s.Assert().Equal(testMessage.Text, bodies["text/plain"])
+
+	textOnly := testMessage
+	textOnly.HTML = ""
+	raw, err = textOnly.Bytes()
+	s.Require().NoError(err)
+	_, bodies = s.parse(raw)
+	s.Assert().Equal(map[string]string{"text/plain": testMessage.Text}, bodies)
+
+	invalid := testMessage
+	invalid.To = "not an address"
+	_, err = invalid.Bytes()
+	s.Assert().Error(err)
+}
+
+func (s *MailerSuite) TestMemoryMailer() {
+	mailer := NewMemoryMailer()
+	s.Require().NoError(mailer.Send(testMessage))
+	other := testMessage
+	other.To = "grace@example.com"
+	s.Require().NoError(mailer.Send(other))
+
+	s.Assert().Len(mailer.Messages(), 2)
+	s.Assert().Equal([]Message{other}, mailer.SentTo("grace@example.com"))
+
+	mailer.Reset()
+	s.Assert().Empty(mailer.Messages())
+}
+
+func (s *MailerSuite) TestFileMailer() {
+	dir := filepath.Join(s.T().TempDir(), "mail")
+	mailer := &FileMailer{Dir: dir}
+	s.Require().NoError(mailer.Send(testMessage))
+	s.Require().NoError(mailer.Send(testMessage))
+
+	files, err := filepath.Glob(filepath.Join(dir, "*"))
+	s.Require().NoError(err)
+	s.Require().Len(files, 2)
+
+	raw, err := os.ReadFile(files[0])
+	s.Require().NoError(err)
+	s.Assert().Equal(".eml", filepath.Ext(files[0]))
+	_, bodies := s.parse(raw)
+	s.Assert().Equal(testMessage.Text, bodies["text/plain"])
+}
+
+func (s *MailerSuite) TestSMTPMailer() {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	s.Require().NoError(err)
+	defer listener.Close()
+
+	received := make(chan []string, 1)
+	go serveSMTP(listener, received)
+
+	port := listener.Addr().(*net.TCPAddr).Port
+	mailer := &SMTPMailer{Host: "127.0.0.1", Port: port, TLS: TLSNone, Timeout: 5 * time.Second}
+	s.Require().NoError(mailer.Send(testMessage))
+
+	commands := <-received
+	s.Assert().Contains(commands, "MAIL FROM:<no-reply@jevels.com>")
+	s.Assert().Contains(commands, "RCPT TO:<ada@example.com>")
+	s.Assert().Equal("QUIT", commands[len(commands)-1])
+
+	// STARTTLS is required unless explicitly disabled
+	go serveSMTP(listener, received)
+	mailer.TLS = TLSStartTLS
+	s.Assert().ErrorContains(mailer.Send(testMessage), "doesn't support STARTTLS")
+}
+
+func (s *MailerSuite) TestNew() {
+	s.T().Setenv("MAIL_TRANSPORT", TransportMemory)
+	mailer, err := New()
+	s.Require().NoError(err)
+	s.Assert().IsType(&MemoryMailer{}, mailer)
+
+	s.T().Setenv("MAIL_TRANSPORT", TransportSMTP)
+	s.T().Setenv("MAIL_SMTP_HOST", "smtp.example.com")
+	s.T().Setenv("MAIL_SMTP_PORT", "465")
+	s.T().Setenv("MAIL_SMTP_TLS", "Implicit")
+	mailer, err = New()
+	s.Require().NoError(err)
+	s.Assert().Equal(&SMTPMailer{Host: "smtp.example.com", Port: 465, TLS: TLSImplicit, Timeout: 10 * time.Second}, mailer)
+
+	// a typo must not fall back to clear text
+	s.T().Setenv("MAIL_SMTP_TLS", "startls")
+	_, err = New()
+	s.Assert().ErrorContains(err, "unknown SMTP TLS mode startls")
+
+	s.T().Setenv("MAIL_SMTP_TLS", "")
+	mailer, err = New()
+	s.Require().NoError(err)
+	s.Assert().Equal(TLSStartTLS, mailer.(*SMTPMailer).TLS)
+
+	s.T().Setenv("MAIL_TRANSPORT", TransportFile)
+	_, err = New()
+	s.Assert().Error(err)
+
+	s.T().Setenv("MAIL_TRANSPORT", "pigeon")
+	_, err = New()
+	s.Assert().Error(err)
+}
+
+// serveSMTP accepts one connection and answers like a server without extensions, it sends the commands it received
+func serveSMTP(listener net.Listener, received chan<- []string) {
+	conn, err := listener.Accept()
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+
+	reader := bufio.NewReader(conn)
+	reply := func(code int, text string) {
+		_, _ = conn.Write([]byte(strconv.Itoa(code) + " " + text + "\r\n"))
+	}
+
+	var commands []string
+	reply(220, "localhost ESMTP")
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			received <- commands
+			return
+		}
+		command := strings.TrimRight(line, "\r\n")
+		commands = append(commands, command)
+
+		switch verb := strings.ToUpper(strings.SplitN(command, " ", 2)[0]); verb {
+		case "EHLO", "HELO", "MAIL", "RCPT", "RSET", "NOOP":
+			reply(250, "OK")
+		case "DATA":
+			reply(354, "go ahead")
+			for {
+				line, err := reader.ReadString('\n')
+				if err != nil || line == ".\r\n" {
+					break
+				}
+			}
+			reply(250, "queued")
+		case "QUIT":
+			reply(221, "bye")
+			received <- commands
+			return
+		default:
+			reply(502, "not implemented")
+		}
+	}
+}
+
+func TestMailerSuite(t *testing.T) {
+	suite.Run(t, new(MailerSuite))
+}
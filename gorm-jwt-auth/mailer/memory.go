@@ -0,0 +1,52 @@
+package mailer
+
+import (
+	"sync"
+)
+
+// MemoryMailer keeps the messages it sends so tests can assert on them
+type MemoryMailer struct {
+	mutex    sync.Mutex
+	messages []Message
+}
+
+func NewMemoryMailer() *MemoryMailer {
+	return &MemoryMailer{}
+}
+
+func (m *MemoryMailer) Send(message Message) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	m.messages = append(m.messages, message)
+	return nil
+}
+
+// Messages returns the messages sent so far, the oldest first
+func (m *MemoryMailer) Messages() []Message {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	return append([]Message(nil), m.messages...)
+}
+
+// SentTo returns the messages sent to the address, the oldest first
+func (m *MemoryMailer) SentTo(address string) []Message {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	var messages []Message
+	for _, message := range m.messages {
+		if message.To == address {
+			messages = append(messages, message)
+		}
+	}
+	return messages
+}
+
+func (m *MemoryMailer) Reset() {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	m.messages = nil
+}
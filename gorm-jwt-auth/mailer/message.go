@@ -0,0 +1,130 @@
+package mailer
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"github.com/pkg/errors"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/mail"
+	"net/textproto"
+	"strings"
+	"time"
+)
+
+// Message is an email with an HTML body and its plain text alternative, either can be empty
+type Message struct {
+	From    string
+	To      string
+	Subject string
+	HTML    string
+	Text    string
+}
+
+// Bytes encodes the message in the RFC 5322 format. The body is multipart/alternative when the message has both an
+// HTML and a text part, the parts are quoted-printable encoded.
+func (m Message) Bytes() ([]byte, error) {
+	from, err := mail.ParseAddress(m.From)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse sender address")
+	}
+	to, err := mail.ParseAddress(m.To)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse recipient address")
+	}
+
+	messageID, err := newMessageID(from.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	buffer := new(bytes.Buffer)
+	writeHeader(buffer, "From", from.String())
+	writeHeader(buffer, "To", to.String())
+	writeHeader(buffer, "Subject", mime.QEncoding.Encode("utf-8", m.Subject))
+	writeHeader(buffer, "Date", time.Now().Format(time.RFC1123Z))
+	writeHeader(buffer, "Message-ID", messageID)
+	writeHeader(buffer, "MIME-Version", "1.0")
+
+	if m.HTML == "" || m.Text == "" {
+		contentType, body := "text/html; charset=utf-8", m.HTML
+		if m.HTML == "" {
+			contentType, body = "text/plain; charset=utf-8", m.Text
+		}
+		writeHeader(buffer, "Content-Type", contentType)
+		writeHeader(buffer, "Content-Transfer-Encoding", "quoted-printable")
+		buffer.WriteString("\r\n")
+		err = writeQuotedPrintable(buffer, body)
+		if err != nil {
+			return nil, err
+		}
+		return buffer.Bytes(), nil
+	}
+
+	writer := multipart.NewWriter(buffer)
+	writeHeader(buffer, "Content-Type", "multipart/alternative; boundary="+writer.Boundary())
+	buffer.WriteString("\r\n")
+
+	// the preferred part comes last
+	for _, part := range []struct {
+		contentType string
+		body        string
+	}{
+		{"text/plain; charset=utf-8", m.Text},
+		{"text/html; charset=utf-8", m.HTML},
+	} {
+		partWriter, err := writer.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {part.contentType},
+			"Content-Transfer-Encoding": {"quoted-printable"},
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to create message part")
+		}
+		err = writeQuotedPrintable(partWriter, part.body)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = writer.Close()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to close message parts")
+	}
+
+	return buffer.Bytes(), nil
+}
+
+func writeHeader(buffer *bytes.Buffer, key string, value string) {
+	buffer.WriteString(key + ": " + value + "\r\n")
+}
+
+func writeQuotedPrintable(writer io.Writer, body string) error {
+	encoder := quotedprintable.NewWriter(writer)
+	_, err := encoder.Write([]byte(strings.ReplaceAll(body, "\r\n", "\n")))
+	if err != nil {
+		return errors.Wrap(err, "failed to encode message body")
+	}
+	err = encoder.Close()
+	if err != nil {
+		return errors.Wrap(err, "failed to encode message body")
+	}
+	return nil
+}
+
+func newMessageID(fromAddress string) (string, error) {
+	random := make([]byte, 16)
+	_, err := rand.Read(random)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to generate message id")
+	}
+
+	domain := "localhost"
+	if at := strings.LastIndex(fromAddress, "@"); at >= 0 {
+		domain = fromAddress[at+1:]
+	}
+
+	return "<" + hex.EncodeToString(random) + "@" + domain + ">", nil
+}
@@ -0,0 +1,151 @@
+package mailer
+
+import (
+	"crypto/tls"
+	"github.com/pkg/errors"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type TLSMode string
+
+const (
+	// TLSStartTLS upgrades the connection before authenticating, it fails if the server doesn't support it
+	TLSStartTLS TLSMode = "starttls"
+	// TLSImplicit connects with TLS from the start, usually on port 465
+	TLSImplicit TLSMode = "implicit"
+	// TLSNone sends in clear text, only for local servers
+	TLSNone TLSMode = "none"
+)
+
+// ParseTLSMode parses a TLS mode case-insensitively, an empty value defaults to TLSStartTLS
+func ParseTLSMode(value string) (TLSMode, error) {
+	switch mode := TLSMode(strings.ToLower(strings.TrimSpace(value))); mode {
+	case "":
+		return TLSStartTLS, nil
+	case TLSStartTLS, TLSImplicit, TLSNone:
+		return mode, nil
+	default:
+		return "", errors.New("unknown SMTP TLS mode " + value)
+	}
+}
+
+type SMTPMailer struct {
+	Host     string
+	Port     int
+	Username string
+	Password string
+	TLS      TLSMode
+	// TLSConfig defaults to verifying the certificate of Host
+	TLSConfig *tls.Config
+	// Timeout bounds the whole exchange with the server
+	Timeout time.Duration
+}
+
+func (m *SMTPMailer) Send(message Message) error {
+	from, err := mail.ParseAddress(message.From)
+	if err != nil {
+		return errors.Wrap(err, "failed to parse sender address")
+	}
+	to, err := mail.ParseAddress(message.To)
+	if err != nil {
+		return errors.Wrap(err, "failed to parse recipient address")
+	}
+
+	body, err := message.Bytes()
+	if err != nil {
+		return err
+	}
+
+	client, err := m.dial()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	if m.TLS == TLSStartTLS {
+		if ok, _ := client.Extension("STARTTLS"); !ok {
+			return errors.New("SMTP server doesn't support STARTTLS")
+		}
+		err = client.StartTLS(m.tlsConfig())
+		if err != nil {
+			return errors.Wrap(err, "failed to start TLS with SMTP server")
+		}
+	}
+
+	if m.Username != "" {
+		// PlainAuth refuses to send the password over an unencrypted connection to anything but localhost
+		err = client.Auth(smtp.PlainAuth("", m.Username, m.Password, m.Host))
+		if err != nil {
+			return errors.Wrap(err, "failed to authenticate with SMTP server")
+		}
+	}
+
+	err = client.Mail(from.Address)
+	if err != nil {
+		return errors.Wrap(err, "SMTP server refused sender")
+	}
+	err = client.Rcpt(to.Address)
+	if err != nil {
+		return errors.Wrap(err, "SMTP server refused recipient")
+	}
+
+	writer, err := client.Data()
+	if err != nil {
+		return errors.Wrap(err, "SMTP server refused message")
+	}
+	_, err = writer.Write(body)
+	if err != nil {
+		return errors.Wrap(err, "failed to send message to SMTP server")
+	}
+	err = writer.Close()
+	if err != nil {
+		return errors.Wrap(err, "SMTP server refused message")
+	}
+
+	err = client.Quit()
+	if err != nil {
+		return errors.Wrap(err, "failed to close SMTP session")
+	}
+
+	return nil
+}
+
+func (m *SMTPMailer) dial() (*smtp.Client, error) {
+	address := net.JoinHostPort(m.Host, strconv.Itoa(m.Port))
+	dialer := &net.Dialer{Timeout: m.Timeout}
+
+	var conn net.Conn
+	var err error
+	if m.TLS == TLSImplicit {
+		conn, err = tls.DialWithDialer(dialer, "tcp", address, m.tlsConfig())
+	} else {
+		conn, err = dialer.Dial("tcp", address)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to connect to SMTP server")
+	}
+
+	if m.Timeout > 0 {
+		_ = conn.SetDeadline(time.Now().Add(m.Timeout))
+	}
+
+	client, err := smtp.NewClient(conn, m.Host)
+	if err != nil {
+		_ = conn.Close()
+		return nil, errors.Wrap(err, "failed to start SMTP session")
+	}
+
+	return client, nil
+}
+
+func (m *SMTPMailer) tlsConfig() *tls.Config {
+	if m.TLSConfig != nil {
+		return m.TLSConfig
+	}
+	return &tls.Config{ServerName: m.Host}
+}
@@ -0,0 +1,259 @@
+package mailtemplate
+
+import (
+	"bytes"
+	"embed"
+	htmltemplate "html/template"
+	"io/fs"
+	"net/mail"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	texttemplate "text/template"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	SenderNoReply  = "no-reply"
+	SenderSecurity = "security"
+
+	defaultLocale = "en"
+)
+
+var ErrUnknownTemplate = errors.New("unknown mail template")
+
+//go:embed templates
+var templatesFS embed.FS
+
+// countryLocales maps the ISO 3166-1 alpha-2 countries whose only official language is supported to its locale, users
+// of other countries get the default locale unless they set a preferred one
+var countryLocales = map[string]string{
+	"FR": "fr",
+	"MC": "fr",
+}
+
+type Sender struct {
+	Name    string
+	Address string
+}
+
+func (s Sender) String() string {
+	return (&mail.Address{Name: s.Name, Address: s.Address}).String()
+}
+
+// Message is a rendered template, Text is the plain text alternative of HTML
+type Message struct {
+	From    string
+	Subject string
+	HTML    string
+	Text    string
+}
+
+type localized struct {
+	subject *texttemplate.Template
+	html    *htmltemplate.Template
+	text    *texttemplate.Template
+}
+
+type Template struct {
+	Name   string
+	Sender string
+	// Sample is the data rendered by previews
+	Sample interface{}
+
+	locales map[string]*localized
+}
+
+// Registry holds the templates of every locale. They're read from templates/<locale>/: <name>.subject.txt,
+// <name>.html, which is rendered inside the layout.html of the locale, and <name>.txt.
+type Registry struct {
+	DefaultLocale string
+	Senders       map[string]Sender
+
+	templates map[string]*Template
+}
+
+func NewRegistry() *Registry {
+	registry := &Registry{}
+	registry.Init()
+	return registry
+}
+
+// Init loads the registry from the MAIL_DEFAULT_LOCALE and MAIL_SENDER_<IDENTITY> env variables, senders are in the
+// "Name <address>" format. It panics if an embedded template doesn't parse.
+func (r *Registry) Init() {
+	r.DefaultLocale = os.Getenv("MAIL_DEFAULT_LOCALE")
+	if r.DefaultLocale == "" {
+		r.DefaultLocale = defaultLocale
+	}
+
+	r.Senders = map[string]Sender{
+		SenderNoReply:  envSender("MAIL_SENDER_NO_REPLY", Sender{Name: "Jevels", Address: "no-reply@jevels.com"}),
+		SenderSecurity: envSender("MAIL_SENDER_SECURITY", Sender{Name: "Jevels", Address: "no-reply@jevels.com"}),
+	}
+
+	r.templates = map[string]*Template{}
+	for _, definition := range definitions {
+		template := definition
+		template.locales = map[string]*localized{}
+		r.templates[template.Name] = &template
+	}
+
+	locales, err := fs.ReadDir(templatesFS, "templates")
+	if err != nil {
+		panic(errors.Wrap(err, "failed to read mail templates"))
+	}
+	for _, locale := range locales {
+		err = r.parseLocale(locale.Name())
+		if err != nil {
+			panic(err)
+		}
+	}
+}
+
+func (r *Registry) parseLocale(locale string) error {
+	dir := path.Join("templates", locale)
+	layout, err := htmltemplate.ParseFS(templatesFS, path.Join(dir, "layout.html"))
+	if err != nil {
+		return errors.Wrap(err, "failed to parse mail layout of locale "+locale)
+	}
+
+	for name, template := range r.templates {
+		subject, err := texttemplate.ParseFS(templatesFS, path.Join(dir, name+".subject.txt"))
+		if errors.Is(err, fs.ErrNotExist) {
+			// the template falls back to the default locale
+			continue
+		} else if err != nil {
+			return errors.Wrap(err, "failed to parse subject of mail template "+locale+"/"+name)
+		}
+
+		html, err := htmltemplate.Must(layout.Clone()).ParseFS(templatesFS, path.Join(dir, name+".html"))
+		if err != nil {
+			return errors.Wrap(err, "failed to parse HTML of mail template "+locale+"/"+name)
+		}
+
+		text, err := texttemplate.ParseFS(templatesFS, path.Join(dir, name+".txt"))
+		if err != nil {
+			return errors.Wrap(err, "failed to parse text of mail template "+locale+"/"+name)
+		}
+
+		template.locales[locale] = &localized{
+			subject: subject,
+			html:    html,
+			text:    text,
+		}
+	}
+
+	return nil
+}
+
+// Names returns the names of the templates in alphabetical order
+func (r *Registry) Names() []string {
+	names := make([]string, 0, len(r.templates))
+	for name := range r.templates {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Locale returns the locale to render the templates in: the preferred one if it's supported, then the one of the
+// country and the default one otherwise
+func (r *Registry) Locale(preferred string, country string) string {
+	preferred = strings.ToLower(preferred)
+	if r.supports(preferred) {
+		return preferred
+	}
+	if language := strings.SplitN(preferred, "-", 2)[0]; r.supports(language) {
+		return language
+	}
+
+	if locale, ok := countryLocales[strings.ToUpper(country)]; ok && r.supports(locale) {
+		return locale
+	}
+
+	return r.DefaultLocale
+}
+
+// IsSupported reports if every template has a translation in the locale
+func (r *Registry) IsSupported(locale string) bool {
+	return r.supports(locale)
+}
+
+func (r *Registry) supports(locale string) bool {
+	if locale == "" {
+		return false
+	}
+	for _, template := range r.templates {
+		if _, ok := template.locales[locale]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// Render renders the template in the locale, falling back to the default locale if it isn't translated
+func (r *Registry) Render(name string, locale string, data interface{}) (*Message, error) {
+	template, ok := r.templates[name]
+	if !ok {
+		return nil, errors.Wrap(ErrUnknownTemplate, name)
+	}
+
+	translation, ok := template.locales[locale]
+	if !ok {
+		translation, ok = template.locales[r.DefaultLocale]
+		if !ok {
+			return nil, errors.New("mail template " + name + " has no translation in the default locale")
+		}
+	}
+
+	sender, ok := r.Senders[template.Sender]
+	if !ok {
+		return nil, errors.New("unknown sender " + template.Sender + " of mail template " + name)
+	}
+
+	subject := new(bytes.Buffer)
+	err := translation.subject.Execute(subject, data)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to render subject of mail template "+name)
+	}
+
+	html := new(bytes.Buffer)
+	err = translation.html.ExecuteTemplate(html, "layout.html", data)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to render HTML of mail template "+name)
+	}
+
+	text := new(bytes.Buffer)
+	err = translation.text.Execute(text, data)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to render text of mail template "+name)
+	}
+
+	return &Message{
+		From:    sender.String(),
+		Subject: strings.TrimSpace(subject.String()),
+		HTML:    html.String(),
+		Text:    text.String(),
+	}, nil
+}
+
+// Preview renders the template with its sample data
+func (r *Registry) Preview(name string, locale string) (*Message, error) {
+	template, ok := r.templates[name]
+	if !ok {
+		return nil, errors.Wrap(ErrUnknownTemplate, name)
+	}
+
+	return r.Render(name, locale, template.Sample)
+}
+
+func envSender(key string, defaultSender Sender) Sender {
+	address, err := mail.ParseAddress(os.Getenv(key))
+	if err != nil {
+		return defaultSender
+	}
+	return Sender{Name: address.Name, Address: address.Address}
+}
@@ -0,0 +1,76 @@
+package mailtemplate
+
+import (
+	"github.com/stretchr/testify/suite"
+	"testing"
+)
+
+type RegistrySuite struct {
+	suite.Suite
+	registry *Registry
+}
+
+func (s *RegistrySuite) SetupTest() {
+	s.registry = NewRegistry()
+}
+
+func (s *RegistrySuite) TestRegistry_EveryTemplateRendersInEveryLocale() {
+	for _, locale := range []string{"en", "fr"} {
+		s.Require().True(s.registry.IsSupported(locale), locale)
+
+		for _, name := range s.registry.Names() {
+			message, err := s.registry.Preview(name, locale)
+			s.Require().NoError(err, locale+"/"+name)
+			s.Assert().NotEmpty(message.Subject, locale+"/"+name)
+			s.Assert().Contains(message.HTML, `lang="`+locale+`"`, locale+"/"+name)
+			s.Assert().Contains(message.HTML, "Ada", locale+"/"+name)
+			s.Assert().Contains(message.Text, "Ada", locale+"/"+name)
+		}
+	}
+}
+
+func (s *RegistrySuite) TestRegistry_Render() {
+	message, err := s.registry.Render(TemplateConfirmEmail, "fr", ConfirmEmailData{
+		PreferredName: "<b>Ada</b>",
+		URL:           "https://jevels.com/confirm?key=abc",
+	})
+	s.Require().NoError(err)
+
+	s.Assert().Equal(`"Jevels" <no-reply@jevels.com>`, message.From)
+	s.Assert().Equal("Confirmez votre compte", message.Subject)
+	s.Assert().Contains(message.HTML, "&lt;b&gt;Ada&lt;/b&gt;")
+	s.Assert().Contains(message.HTML, `href="https://jevels.com/confirm?key=abc"`)
+	s.Assert().Contains(message.Text, "Bonjour <b>Ada</b>,")
+	s.Assert().Contains(message.Text, "https://jevels.com/confirm?key=abc")
+}
+
+func (s *RegistrySuite) TestRegistry_RenderFallsBackToDefaultLocale() {
+	message, err := s.registry.Render(TemplatePassReset, "de", PassResetData{PreferredName: "Ada"})
+	s.Require().NoError(err)
+	s.Assert().Equal("Reset your password", message.Subject)
+
+	_, err = s.registry.Render("unknown", "en", nil)
+	s.Assert().ErrorIs(err, ErrUnknownTemplate)
+}
+
+func (s *RegistrySuite) TestRegistry_Locale() {
+	s.Assert().Equal("fr", s.registry.Locale("fr", "US"))
+	s.Assert().Equal("fr", s.registry.Locale("fr-CA", ""))
+	s.Assert().Equal("en", s.registry.Locale("en", "FR"))
+	s.Assert().Equal("fr", s.registry.Locale("", "fr"))
+	s.Assert().Equal("fr", s.registry.Locale("de", "FR"))
+	s.Assert().Equal("en", s.registry.Locale("", "DE"))
+}
+
+func (s *RegistrySuite) TestRegistry_Senders() {
+	s.T().Setenv("MAIL_SENDER_SECURITY", "Jevels Security <security@jevels.com>")
+	s.registry.Init()
+
+	message, err := s.registry.Preview(TemplateEmailChangeNotice, "en")
+	s.Require().NoError(err)
+	s.Assert().Equal(`"Jevels Security" <security@jevels.com>`, message.From)
+}
+
+func TestRegistrySuite(t *testing.T) {
+	suite.Run(t, new(RegistrySuite))
+}
@@ -0,0 +1,63 @@
+package mailtemplate
+
+const (
+	TemplateConfirmEmail       = "confirm_email"
+	TemplatePassReset          = "pass_reset"
+	TemplateEmailChangeConfirm = "email_change_confirm"
+	TemplateEmailChangeNotice  = "email_change_notice"
+)
+
+type ConfirmEmailData struct {
+	PreferredName string
+	URL           string
+}
+
+type PassResetData struct {
+	PreferredName string
+	URL           string
+}
+
+type EmailChangeConfirmData struct {
+	PreferredName string
+	URL           string
+}
+
+type EmailChangeNoticeData struct {
+	PreferredName string
+	NewEmail      string
+}
+
+var definitions = []Template{
+	{
+		Name:   TemplateConfirmEmail,
+		Sender: SenderNoReply,
+		Sample: ConfirmEmailData{
+			PreferredName: "Ada",
+			URL:           "https://jevels.com/confirm-email?key=sample",
+		},
+	},
+	{
+		Name:   TemplatePassReset,
+		Sender: SenderSecurity,
+		Sample: PassResetData{
+			PreferredName: "Ada",
+			URL:           "https://jevels.com/reset-password?key=sample",
+		},
+	},
+	{
+		Name:   TemplateEmailChangeConfirm,
+		Sender: SenderSecurity,
+		Sample: EmailChangeConfirmData{
+			PreferredName: "Ada",
+			URL:           "https://jevels.com/confirm-email-change?key=sample",
+		},
+	},
+	{
+		Name:   TemplateEmailChangeNotice,
+		Sender: SenderSecurity,
+		Sample: EmailChangeNoticeData{
+			PreferredName: "Ada",
+			NewEmail:      "ada@example.com",
+		},
+	},
+}
@@ -0,0 +1,80 @@
+package oidclogin
+
+import (
+	"context"
+	"github.com/coreos/go-oidc/v3/oidc"
+	"github.com/pkg/errors"
+	"golang.org/x/oauth2"
+)
+
+// DiscoveryProvider is any OpenID Connect provider that publishes /.well-known/openid-configuration (Google, Apple,
+// Microsoft, Auth0, a local mock provider...)
+type DiscoveryProvider struct {
+	name     string
+	config   oauth2.Config
+	verifier *oidc.IDTokenVerifier
+}
+
+func NewDiscoveryProvider(ctx context.Context, name string, issuer string, config oauth2.Config) (*DiscoveryProvider, error) {
+	provider, err := oidc.NewProvider(ctx, issuer)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to discover "+name+" provider")
+	}
+
+	config.Endpoint = provider.Endpoint()
+	config.Scopes = []string{oidc.ScopeOpenID, "email", "profile"}
+
+	return &DiscoveryProvider{
+		name:     name,
+		config:   config,
+		verifier: provider.Verifier(&oidc.Config{ClientID: config.ClientID}),
+	}, nil
+}
+
+func (p *DiscoveryProvider) Name() string {
+	return p.name
+}
+
+func (p *DiscoveryProvider) AuthCodeURL(state string, nonce string, codeVerifier string) string {
+	return p.config.AuthCodeURL(state, oidc.Nonce(nonce), oauth2.S256ChallengeOption(codeVerifier))
+}
+
+func (p *DiscoveryProvider) Exchange(ctx context.Context, code string, nonce string, codeVerifier string) (*Claims, error) {
+	token, err := p.config.Exchange(ctx, code, oauth2.VerifierOption(codeVerifier))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to exchange authorization code")
+	}
+
+	rawIDToken, ok := token.Extra("id_token").(string)
+	if !ok {
+		return nil, errors.New("provider didn't return an id token")
+	}
+
+	idToken, err := p.verifier.Verify(ctx, rawIDToken)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid id token")
+	} else if idToken.Nonce != nonce {
+		return nil, errors.New("invalid id token nonce")
+	}
+
+	var claims struct {
+		Email         string `json:"email"`
+		EmailVerified bool   `json:"email_verified"`
+		GivenName     string `json:"given_name"`
+		FamilyName    string `json:"family_name"`
+		Name          string `json:"name"`
+	}
+	err = idToken.Claims(&claims)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse id token claims")
+	}
+
+	return &Claims{
+		Subject:       idToken.Subject,
+		Email:         claims.Email,
+		EmailVerified: claims.EmailVerified,
+		GivenName:     claims.GivenName,
+		FamilyName:    claims.FamilyName,
+		Name:          claims.Name,
+	}, nil
+}
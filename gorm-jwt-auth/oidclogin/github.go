@@ -0,0 +1,101 @@
+package oidclogin
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/pkg/errors"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/github"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+const (
+	GithubProviderName = "github"
+
+	githubUserURL   = "https://api.github.com/user"
+	githubEmailsURL = "https://api.github.com/user/emails"
+)
+
+// GithubProvider uses GitHub's OAuth2 flow, GitHub doesn't issue ID tokens so the claims come from its REST API
+type GithubProvider struct {
+	config oauth2.Config
+}
+
+func NewGithubProvider(config oauth2.Config) *GithubProvider {
+	config.Endpoint = github.Endpoint
+	config.Scopes = []string{"read:user", "user:email"}
+
+	return &GithubProvider{
+		config: config,
+	}
+}
+
+func (p *GithubProvider) Name() string {
+	return GithubProviderName
+}
+
+func (p *GithubProvider) AuthCodeURL(state string, nonce string, codeVerifier string) string {
+	return p.config.AuthCodeURL(state, oauth2.S256ChallengeOption(codeVerifier))
+}
+
+func (p *GithubProvider) Exchange(ctx context.Context, code string, nonce string, codeVerifier string) (*Claims, error) {
+	token, err := p.config.Exchange(ctx, code, oauth2.VerifierOption(codeVerifier))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to exchange authorization code")
+	}
+	client := p.config.Client(ctx, token)
+
+	var githubUser struct {
+		ID   int64  `json:"id"`
+		Name string `json:"name"`
+	}
+	err = getJSON(client, githubUserURL, &githubUser)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get github user")
+	}
+
+	var githubEmails []struct {
+		Email    string `json:"email"`
+		Primary  bool   `json:"primary"`
+		Verified bool   `json:"verified"`
+	}
+	err = getJSON(client, githubEmailsURL, &githubEmails)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get github user emails")
+	}
+
+	claims := &Claims{
+		Subject: strconv.FormatInt(githubUser.ID, 10),
+		Name:    githubUser.Name,
+	}
+	for _, githubEmail := range githubEmails {
+		if githubEmail.Primary {
+			claims.Email = githubEmail.Email
+			claims.EmailVerified = githubEmail.Verified
+		}
+	}
+
+	names := strings.SplitN(githubUser.Name, " ", 2)
+	claims.GivenName = names[0]
+	if len(names) > 1 {
+		claims.FamilyName = names[1]
+	}
+
+	return claims, nil
+}
+
+func getJSON(client *http.Client, url string, v interface{}) error {
+	response, err := client.Get(url)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return errors.New("unexpected status " + response.Status)
+	}
+
+	return json.NewDecoder(response.Body).Decode(v)
+}
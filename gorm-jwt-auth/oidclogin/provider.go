@@ -0,0 +1,78 @@
+package oidclogin
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"golang.org/x/oauth2"
+	"os"
+	"strings"
+)
+
+var (
+	ErrUnknownProvider = errors.New("unknown login provider")
+)
+
+// Claims are the identity claims shared by every provider
+type Claims struct {
+	Subject       string
+	Email         string
+	EmailVerified bool
+	GivenName     string
+	FamilyName    string
+	Name          string
+}
+
+// Provider runs the authorization code flow with PKCE against an external identity provider
+type Provider interface {
+	Name() string
+	// AuthCodeURL returns the URL the user must be redirected to
+	AuthCodeURL(state string, nonce string, codeVerifier string) string
+	// Exchange trades the authorization code for the user's claims, the nonce is checked when the provider issues ID
+	// tokens
+	Exchange(ctx context.Context, code string, nonce string, codeVerifier string) (*Claims, error)
+}
+
+type Providers map[string]Provider
+
+// NewProvidersFromEnv loads the providers listed in OIDC_PROVIDERS (comma separated names), each one is configured
+// with OIDC_<NAME>_CLIENT_ID, OIDC_<NAME>_CLIENT_SECRET, OIDC_<NAME>_REDIRECT_URL and, for discovery based providers,
+// OIDC_<NAME>_ISSUER. The github provider doesn't support discovery and needs no issuer.
+func NewProvidersFromEnv(ctx context.Context) (Providers, error) {
+	providers := Providers{}
+
+	for _, name := range strings.Split(os.Getenv("OIDC_PROVIDERS"), ",") {
+		name = strings.TrimSpace(strings.ToLower(name))
+		if name == "" {
+			continue
+		}
+
+		envPrefix := "OIDC_" + strings.ToUpper(name) + "_"
+		config := oauth2.Config{
+			ClientID:     os.Getenv(envPrefix + "CLIENT_ID"),
+			ClientSecret: os.Getenv(envPrefix + "CLIENT_SECRET"),
+			RedirectURL:  os.Getenv(envPrefix + "REDIRECT_URL"),
+		}
+
+		var provider Provider
+		var err error
+		if name == GithubProviderName {
+			provider = NewGithubProvider(config)
+		} else {
+			provider, err = NewDiscoveryProvider(ctx, name, os.Getenv(envPrefix+"ISSUER"), config)
+			if err != nil {
+				return nil, err
+			}
+		}
+		providers[name] = provider
+	}
+
+	return providers, nil
+}
+
+func (p Providers) Get(name string) (Provider, error) {
+	provider, ok := p[strings.ToLower(name)]
+	if !ok {
+		return nil, ErrUnknownProvider
+	}
+	return provider, nil
+}
@@ -0,0 +1,174 @@
+package onetimekey
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"github.com/gomodule/redigo/redis"
+	"github.com/pkg/errors"
+	"time"
+)
+
+const (
+	tokenBytes = 32
+	// maxSaveAttempts bounds the retries of a replacing Save whose set keeps changing while it runs
+	maxSaveAttempts = 5
+)
+
+var ErrNotFound = errors.New("key is no longer valid")
+
+// Every key the scripts touch is declared in KEYS, as Redis Cluster and ACL key patterns require, so the keys of a set
+// to replace are read before the script runs and passed along with it.
+
+// saveScript sets KEYS[1] and adds it to the set KEYS[2], if any. With ARGV[3] it first deletes the set and its keys,
+// KEYS[3] and after, it returns false without changing anything if the set no longer holds exactly these keys.
+var saveScript = redis.NewScript(-1, `
+if ARGV[3] == "1" then
+	local declared = {}
+	for i = 3, #KEYS do
+		declared[KEYS[i]] = true
+	end
+	local previous = redis.call("SMEMBERS", KEYS[2])
+	if #previous ~= #KEYS - 2 then
+		return false
+	end
+	for _, key in ipairs(previous) do
+		if not declared[key] then
+			return false
+		end
+	end
+	for i = 3, #KEYS do
+		redis.call("DEL", KEYS[i])
+	end
+	redis.call("DEL", KEYS[2])
+end
+redis.call("SET", KEYS[1], ARGV[1], "PX", ARGV[2])
+if #KEYS >= 2 then
+	redis.call("SADD", KEYS[2], KEYS[1])
+	redis.call("PEXPIRE", KEYS[2], ARGV[2])
+end
+return "OK"
+`)
+
+// consumeScript is GETDEL of KEYS[1] that also removes it from the set KEYS[2], if any
+var consumeScript = redis.NewScript(-1, `
+local value = redis.call("GET", KEYS[1])
+if not value then
+	return false
+end
+redis.call("DEL", KEYS[1])
+if #KEYS >= 2 then
+	redis.call("SREM", KEYS[2], KEYS[1])
+end
+return value
+`)
+
+// Store saves single use keys in Redis, reading a key through Consume deletes it atomically so two concurrent
+// requests can't both use it. Keys can be grouped in a set, usually per user, to revoke the previous ones.
+type Store struct {
+	Pool *redis.Pool
+}
+
+func NewStore(pool *redis.Pool) *Store {
+	return &Store{
+		Pool: pool,
+	}
+}
+
+// NewToken returns 256 random bits encoded as URL safe base64, only its Hash should be stored
+func NewToken() (string, error) {
+	token := make([]byte, tokenBytes)
+	_, err := rand.Read(token)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to generate random token")
+	}
+	return base64.RawURLEncoding.EncodeToString(token), nil
+}
+
+// Hash is the SHA-256 of the token, so a leaked Redis dump doesn't contain usable tokens
+func Hash(token string) string {
+	hash := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(hash[:])
+}
+
+// Save stores the value under key, if replace is true every other key of the set is deleted first. set can be empty.
+func (s *Store) Save(key string, value string, set string, ttl time.Duration, replace bool) error {
+	if ttl <= 0 {
+		return errors.New("single use keys must expire")
+	}
+
+	conn := s.Pool.Get()
+	defer conn.Close()
+
+	if !replace || set == "" {
+		_, err := saveScript.Do(conn, scriptArgs(keys(key, set), value, ttl.Milliseconds(), "0")...)
+		if err != nil {
+			return errors.Wrap(err, "failed to save key")
+		}
+		return nil
+	}
+
+	for attempt := 0; attempt < maxSaveAttempts; attempt++ {
+		previous, err := redis.Strings(conn.Do("SMEMBERS", set))
+		if err != nil {
+			return errors.Wrap(err, "failed to get keys to replace")
+		}
+
+		scriptKeys := append(keys(key, set), previous...)
+		reply, err := saveScript.Do(conn, scriptArgs(scriptKeys, value, ttl.Milliseconds(), "1")...)
+		if err != nil {
+			return errors.Wrap(err, "failed to save key")
+		} else if reply != nil {
+			return nil
+		}
+	}
+
+	return errors.New("failed to save key: its set kept changing")
+}
+
+// Get returns the value without consuming the key
+func (s *Store) Get(key string) (string, error) {
+	conn := s.Pool.Get()
+	defer conn.Close()
+
+	value, err := redis.String(conn.Do("GET", key))
+	if errors.Is(err, redis.ErrNil) {
+		return "", ErrNotFound
+	} else if err != nil {
+		return "", errors.Wrap(err, "failed to get key")
+	}
+	return value, nil
+}
+
+// Consume returns the value and deletes the key, only one caller gets the value
+func (s *Store) Consume(key string, set string) (string, error) {
+	conn := s.Pool.Get()
+	defer conn.Close()
+
+	value, err := redis.String(consumeScript.Do(conn, scriptArgs(keys(key, set))...))
+	if errors.Is(err, redis.ErrNil) {
+		return "", ErrNotFound
+	} else if err != nil {
+		return "", errors.Wrap(err, "failed to consume key")
+	}
+	return value, nil
+}
+
+// keys are the key and its set, if any
+func keys(key string, set string) []string {
+	if set == "" {
+		return []string{key}
+	}
+	return []string{key, set}
+}
+
+// scriptArgs are the arguments of a script whose key count is the first argument
+func scriptArgs(keys []string, args ...interface{}) []interface{} {
+	scriptArgs := make([]interface{}, 0, 1+len(keys)+len(args))
+	scriptArgs = append(scriptArgs, len(keys))
+	for _, key := range keys {
+		scriptArgs = append(scriptArgs, key)
+	}
+	return append(scriptArgs, args...)
+}
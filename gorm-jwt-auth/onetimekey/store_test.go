@@ -0,0 +1,139 @@
+package onetimekey
+
+import (
+	"github.com/alicebob/miniredis/v2"
+	"github.com/gomodule/redigo/redis"
+	"github.com/stretchr/testify/suite"
+	"sync"
+	"testing"
+	"time"
+)
+
+type StoreSuite struct {
+	suite.Suite
+	redis *miniredis.Miniredis
+	store *Store
+}
+
+func (s *StoreSuite) SetupTest() {
+	s.redis = miniredis.RunT(s.T())
+	s.store = NewStore(&redis.Pool{
+		Dial: func() (redis.Conn, error) {
+			return redis.Dial("tcp", s.redis.Addr())
+		},
+	})
+}
+
+func (s *StoreSuite) TestStore_ConsumeOnce() {
+	s.Require().NoError(s.store.Save("Reset:a", "1", "Reset:UserID:1", time.Hour, false))
+
+	value, err := s.store.Get("Reset:a")
+	s.Assert().NoError(err)
+	s.Assert().Equal("1", value)
+
+	value, err = s.store.Consume("Reset:a", "Reset:UserID:1")
+	s.Assert().NoError(err)
+	s.Assert().Equal("1", value)
+	s.Assert().False(s.redis.Exists("Reset:UserID:1"))
+
+	_, err = s.store.Consume("Reset:a", "Reset:UserID:1")
+	s.Assert().ErrorIs(err, ErrNotFound)
+}
+
+func (s *StoreSuite) TestStore_ConcurrentConsume() {
+	s.Require().NoError(s.store.Save("Reset:a", "1", "", time.Hour, false))
+
+	var wg sync.WaitGroup
+	results := make(chan error, 10)
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := s.store.Consume("Reset:a", "")
+			results <- err
+		}()
+	}
+	wg.Wait()
+	close(results)
+
+	succeeded := 0
+	for err := range results {
+		if err == nil {
+			succeeded++
+		} else {
+			s.Assert().ErrorIs(err, ErrNotFound)
+		}
+	}
+	s.Assert().Equal(1, succeeded)
+}
+
+func (s *StoreSuite) TestStore_Replace() {
+	s.Require().NoError(s.store.Save("Reset:a", "1", "Reset:UserID:1", time.Hour, true))
+	s.Require().NoError(s.store.Save("Reset:b", "1", "Reset:UserID:1", time.Hour, true))
+
+	_, err := s.store.Get("Reset:a")
+	s.Assert().ErrorIs(err, ErrNotFound)
+	_, err = s.store.Get("Reset:b")
+	s.Assert().NoError(err)
+}
+
+func (s *StoreSuite) TestStore_ReplaceEveryKeyOfTheSet() {
+	s.Require().NoError(s.store.Save("Reset:a", "1", "Reset:UserID:1", time.Hour, false))
+	s.Require().NoError(s.store.Save("Reset:b", "1", "Reset:UserID:1", time.Hour, false))
+	s.Require().NoError(s.store.Save("Reset:c", "1", "Reset:UserID:1", time.Hour, true))
+
+	s.Assert().Equal([]string{"Reset:UserID:1", "Reset:c"}, s.redis.Keys())
+	members, err := s.redis.Members("Reset:UserID:1")
+	s.Require().NoError(err)
+	s.Assert().Equal([]string{"Reset:c"}, members)
+}
+
+func (s *StoreSuite) TestSaveScript_SetChanged() {
+	s.Require().NoError(s.store.Save("Reset:a", "1", "Reset:UserID:1", time.Hour, false))
+	s.Require().NoError(s.store.Save("Reset:b", "1", "Reset:UserID:1", time.Hour, false))
+
+	conn := s.store.Pool.Get()
+	defer conn.Close()
+
+	// Reset:b was added after the keys to replace were read
+	reply, err := saveScript.Do(conn, scriptArgs([]string{"Reset:c", "Reset:UserID:1", "Reset:a"}, "1", 1000, "1")...)
+	s.Require().NoError(err)
+	s.Assert().Nil(reply)
+	s.Assert().Equal([]string{"Reset:UserID:1", "Reset:a", "Reset:b"}, s.redis.Keys())
+}
+
+func (s *StoreSuite) TestStore_WithoutSet() {
+	s.Require().NoError(s.store.Save("Confirm:a", "1", "", time.Hour, true))
+	s.Assert().Equal([]string{"Confirm:a"}, s.redis.Keys())
+
+	value, err := s.store.Consume("Confirm:a", "")
+	s.Require().NoError(err)
+	s.Assert().Equal("1", value)
+	s.Assert().Empty(s.redis.Keys())
+}
+
+func (s *StoreSuite) TestStore_Expires() {
+	s.Require().NoError(s.store.Save("Confirm:a", "1", "", time.Minute, false))
+	s.redis.FastForward(2 * time.Minute)
+
+	_, err := s.store.Consume("Confirm:a", "")
+	s.Assert().ErrorIs(err, ErrNotFound)
+
+	s.Assert().Error(s.store.Save("Confirm:b", "1", "", 0, false))
+}
+
+func (s *StoreSuite) TestToken() {
+	first, err := NewToken()
+	s.Require().NoError(err)
+	second, err := NewToken()
+	s.Require().NoError(err)
+
+	s.Assert().Len(first, 43)
+	s.Assert().NotEqual(first, second)
+	s.Assert().Len(Hash(first), 64)
+	s.Assert().NotEqual(Hash(first), first)
+}
+
+func TestStore(t *testing.T) {
+	suite.Run(t, new(StoreSuite))
+}
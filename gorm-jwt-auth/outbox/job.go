@@ -0,0 +1,30 @@
+package outbox
+
+import (
+	"time"
+)
+
+const (
+	StatusPending   = "pending"
+	StatusSucceeded = "succeeded"
+	StatusDead      = "dead"
+)
+
+// @GormDBNames
+type Job struct {
+	ID   int
+	Type string `gorm:"index"`
+	// IdempotencyKey is optional, a job with the same key as a previous one isn't enqueued again
+	IdempotencyKey *string `gorm:"uniqueIndex"`
+	Payload        string
+	Status         string `gorm:"index;default:pending"`
+	Attempts       int
+	LastError      string
+	// NextAttemptTime is when the job can run next, it's pushed back exponentially after each failure
+	NextAttemptTime time.Time `gorm:"type:timestamp without time zone;index"`
+	// LockedUntil is the lease of the worker running the job, a job whose worker crashed runs again once it expires
+	LockedUntil   *time.Time `gorm:"type:timestamp without time zone;"`
+	CreationTime  time.Time  `gorm:"type:timestamp without time zone;"`
+	ProcessedTime *time.Time `gorm:"type:timestamp without time zone;"`
+	DeadTime      *time.Time `gorm:"type:timestamp without time zone;"`
+}
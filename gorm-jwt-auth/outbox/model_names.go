@@ -0,0 +1,34 @@
+package outbox
+
+type defDBNamesJob_ struct {
+	TableName string
+	ID string
+	Type string
+	IdempotencyKey string
+	Payload string
+	Status string
+	Attempts string
+	LastError string
+	NextAttemptTime string
+	LockedUntil string
+	CreationTime string
+	ProcessedTime string
+	DeadTime string
+	
+}
+
+var DBNamesJob = &defDBNamesJob_{
+	TableName: "jobs",
+	ID: "id",
+	Type: "type",
+	IdempotencyKey: "idempotency_key",
+	Payload: "payload",
+	Status: "status",
+	Attempts: "attempts",
+	LastError: "last_error",
+	NextAttemptTime: "next_attempt_time",
+	LockedUntil: "locked_until",
+	CreationTime: "creation_time",
+	ProcessedTime: "processed_time",
+	DeadTime: "dead_time",
+}
@@ -0,0 +1,316 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"strconv"
+	"sync"
+	"time"
+)
+
+type ctxKey string
+
+const ctxKeyJob ctxKey = "job"
+
+// maxErrorLength keeps huge error messages of external APIs out of the table
+const maxErrorLength = 1000
+
+// Handler runs the side effect of a job, the payload is the JSON passed to Enqueue. Jobs run at least once so
+// handlers must be idempotent, JobFromContext gives access to the job and its idempotency key.
+type Handler func(ctx context.Context, payload []byte) error
+
+// Enqueue writes a job in the caller's transaction, it's only processed once the transaction commits so side effects
+// never run for changes that were rolled back
+func Enqueue(tx *gorm.DB, jobType string, payload interface{}) error {
+	return enqueue(tx, jobType, nil, payload)
+}
+
+// EnqueueOnce is Enqueue for jobs that must only exist once, it's a no-op if a job with the same key was enqueued
+// before, even if it has already been processed
+func EnqueueOnce(tx *gorm.DB, jobType string, idempotencyKey string, payload interface{}) error {
+	return enqueue(tx, jobType, &idempotencyKey, payload)
+}
+
+func enqueue(tx *gorm.DB, jobType string, idempotencyKey *string, payload interface{}) error {
+	payloadBytes, err := json.Marshal(payload)
+	if err != nil {
+		return errors.Wrap(err, "failed to encode payload of "+jobType+" job")
+	}
+
+	now := time.Now()
+	err = tx.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: DBNamesJob.IdempotencyKey}},
+		DoNothing: true,
+	}).Create(&Job{
+		Type:            jobType,
+		IdempotencyKey:  idempotencyKey,
+		Payload:         string(payloadBytes),
+		Status:          StatusPending,
+		NextAttemptTime: now,
+		CreationTime:    now,
+	}).Error
+	if err != nil {
+		return errors.Wrap(err, "failed to enqueue "+jobType+" job")
+	}
+
+	return nil
+}
+
+// JobFromContext returns the job being run by the handler
+func JobFromContext(ctx context.Context) *Job {
+	jobDB, _ := ctx.Value(ctxKeyJob).(*Job)
+	return jobDB
+}
+
+// Worker claims pending jobs from the jobs table and runs them in a pool. Failed jobs are retried with an exponential
+// backoff and dead-lettered once they reach MaxAttempts, dead jobs stay in the table until retried by an admin.
+type Worker struct {
+	DB       *gorm.DB
+	Handlers map[string]Handler
+	Logger   *zap.Logger
+
+	Interval    time.Duration
+	BatchSize   int
+	Concurrency int
+	// Lease is how long a claimed job is reserved for its worker, it's also the timeout of the handler
+	Lease       time.Duration
+	MaxAttempts int
+	BaseDelay   time.Duration
+	MaxDelay    time.Duration
+
+	// Now is replaceable so tests can move through the backoff
+	Now func() time.Time
+}
+
+func NewWorker(db *gorm.DB, logger *zap.Logger) *Worker {
+	return &Worker{
+		DB:          db,
+		Handlers:    map[string]Handler{},
+		Logger:      logger,
+		Interval:    5 * time.Second,
+		BatchSize:   20,
+		Concurrency: 4,
+		Lease:       time.Minute,
+		MaxAttempts: 8,
+		BaseDelay:   10 * time.Second,
+		MaxDelay:    time.Hour,
+		Now:         time.Now,
+	}
+}
+
+func (w *Worker) Handle(jobType string, handler Handler) {
+	w.Handlers[jobType] = handler
+}
+
+// Run processes batches every interval until the context is done
+func (w *Worker) Run(ctx context.Context) {
+	ticker := time.NewTicker(w.Interval)
+	defer ticker.Stop()
+
+	for {
+		_, err := w.ProcessBatch(ctx)
+		if err != nil {
+			w.Logger.Error("failed to process jobs", zap.Error(err))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// ProcessBatch claims the due jobs and runs them, it returns how many succeeded. Rows are claimed with SKIP LOCKED
+// and a lease so several workers can run at the same time.
+func (w *Worker) ProcessBatch(ctx context.Context) (int, error) {
+	jobsDB, err := w.claim()
+	if err != nil {
+		return 0, err
+	}
+
+	var (
+		wg        sync.WaitGroup
+		mutex     sync.Mutex
+		succeeded int
+		errs      []error
+	)
+	semaphore := make(chan struct{}, w.Concurrency)
+
+	for _, jobDB := range jobsDB {
+		wg.Add(1)
+		semaphore <- struct{}{}
+		go func(jobDB *Job) {
+			defer wg.Done()
+			defer func() { <-semaphore }()
+
+			ok, err := w.process(ctx, jobDB)
+
+			mutex.Lock()
+			defer mutex.Unlock()
+			if err != nil {
+				errs = append(errs, err)
+			} else if ok {
+				succeeded++
+			}
+		}(jobDB)
+	}
+	wg.Wait()
+
+	if len(errs) > 0 {
+		return succeeded, errs[0]
+	}
+	return succeeded, nil
+}
+
+// claim reserves the due jobs for this worker and counts the attempt
+func (w *Worker) claim() ([]*Job, error) {
+	var jobsDB []*Job
+	err := w.DB.Transaction(func(tx *gorm.DB) error {
+		now := w.Now()
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where(DBNamesJob.Status, StatusPending).
+			Where(DBNamesJob.NextAttemptTime+" <= ?", now).
+			Where(tx.Where(DBNamesJob.LockedUntil+" IS NULL").Or(DBNamesJob.LockedUntil+" < ?", now)).
+			Order(DBNamesJob.NextAttemptTime).
+			Order(DBNamesJob.ID).
+			Limit(w.BatchSize).
+			Find(&jobsDB).Error
+		if err != nil {
+			return errors.Wrap(err, "failed to get due jobs")
+		} else if len(jobsDB) == 0 {
+			return nil
+		}
+
+		ids := make([]int, len(jobsDB))
+		lockedUntil := now.Add(w.Lease)
+		for i, jobDB := range jobsDB {
+			ids[i] = jobDB.ID
+			jobDB.Attempts++
+			jobDB.LockedUntil = &lockedUntil
+		}
+
+		err = tx.Model(&Job{}).Where(DBNamesJob.ID+" IN ?", ids).Updates(map[string]interface{}{
+			DBNamesJob.LockedUntil: lockedUntil,
+			DBNamesJob.Attempts:    gorm.Expr(DBNamesJob.Attempts + " + 1"),
+		}).Error
+		if err != nil {
+			return errors.Wrap(err, "failed to claim jobs")
+		}
+
+		return nil
+	})
+
+	return jobsDB, err
+}
+
+// process runs the job and saves the outcome, ok reports if the handler succeeded
+func (w *Worker) process(ctx context.Context, jobDB *Job) (ok bool, err error) {
+	handlerErr := w.run(ctx, jobDB)
+	now := w.Now()
+
+	updates := map[string]interface{}{
+		DBNamesJob.LockedUntil: nil,
+	}
+	if handlerErr == nil {
+		updates[DBNamesJob.Status] = StatusSucceeded
+		updates[DBNamesJob.ProcessedTime] = now
+		updates[DBNamesJob.LastError] = ""
+	} else {
+		lastError := handlerErr.Error()
+		if len(lastError) > maxErrorLength {
+			lastError = lastError[:maxErrorLength]
+		}
+		updates[DBNamesJob.LastError] = lastError
+
+		if jobDB.Attempts >= w.MaxAttempts {
+			updates[DBNamesJob.Status] = StatusDead
+			updates[DBNamesJob.DeadTime] = now
+			w.Logger.Error(
+				"job is dead",
+				zap.Int("jobID", jobDB.ID),
+				zap.String("type", jobDB.Type),
+				zap.Int("attempts", jobDB.Attempts),
+				zap.String("lastError", lastError),
+			)
+		} else {
+			updates[DBNamesJob.NextAttemptTime] = now.Add(w.backoff(jobDB.Attempts))
+		}
+	}
+
+	err = w.DB.Model(&Job{}).Where(DBNamesJob.ID, jobDB.ID).Updates(updates).Error
+	if err != nil {
+		return false, errors.Wrap(err, "failed to save outcome of job with id "+strconv.Itoa(jobDB.ID))
+	}
+
+	return handlerErr == nil, nil
+}
+
+func (w *Worker) run(ctx context.Context, jobDB *Job) (err error) {
+	handler, ok := w.Handlers[jobDB.Type]
+	if !ok {
+		return errors.New("no handler for " + jobDB.Type + " jobs")
+	}
+
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			err = errors.Errorf("handler panicked: %v", recovered)
+		}
+	}()
+
+	ctx, cancel := context.WithTimeout(context.WithValue(ctx, ctxKeyJob, jobDB), w.Lease)
+	defer cancel()
+
+	return handler(ctx, []byte(jobDB.Payload))
+}
+
+// backoff doubles the delay after each failed attempt
+func (w *Worker) backoff(attempts int) time.Duration {
+	delay := w.BaseDelay
+	for i := 1; i < attempts; i++ {
+		delay *= 2
+		if delay >= w.MaxDelay {
+			return w.MaxDelay
+		}
+	}
+	return delay
+}
+
+// DeadJobs returns the jobs that ran out of attempts, the most recent first
+func (w *Worker) DeadJobs(limit int, offset int) ([]*Job, error) {
+	var jobsDB []*Job
+	err := w.DB.Where(DBNamesJob.Status, StatusDead).
+		Order(DBNamesJob.DeadTime + " DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&jobsDB).Error
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get dead jobs from DB")
+	}
+
+	return jobsDB, nil
+}
+
+// Retry puts a dead job back in the queue with its attempts reset
+func (w *Worker) Retry(jobID int) error {
+	result := w.DB.Model(&Job{}).
+		Where(DBNamesJob.ID, jobID).
+		Where(DBNamesJob.Status, StatusDead).
+		Updates(map[string]interface{}{
+			DBNamesJob.Status:          StatusPending,
+			DBNamesJob.Attempts:        0,
+			DBNamesJob.NextAttemptTime: w.Now(),
+			DBNamesJob.DeadTime:        nil,
+		})
+	if result.Error != nil {
+		return errors.Wrap(result.Error, "failed to retry job")
+	} else if result.RowsAffected == 0 {
+		return errors.New("job doesn't exist or isn't dead")
+	}
+
+	return nil
+}
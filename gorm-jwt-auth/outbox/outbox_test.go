@@ -0,0 +1,218 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+type testPayload struct {
+	UserID int
+}
+
+type OutboxSuite struct {
+	suite.Suite
+	db     *gorm.DB
+	worker *Worker
+	logs   *observer.ObservedLogs
+	now    time.Time
+}
+
+func (s *OutboxSuite) SetupTest() {
+	db, err := gorm.Open(sqlite.Open("file::memory:"), &gorm.Config{})
+	s.Require().NoError(err)
+	sqlDB, err := db.DB()
+	s.Require().NoError(err)
+	sqlDB.SetMaxOpenConns(1)
+
+	// created by hand as SQLite only parses timestamps from columns declared as such
+	s.Require().NoError(db.Exec(`CREATE TABLE jobs (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		type TEXT,
+		idempotency_key TEXT UNIQUE,
+		payload TEXT,
+		status TEXT DEFAULT 'pending',
+		attempts INTEGER DEFAULT 0,
+		last_error TEXT,
+		next_attempt_time TIMESTAMP,
+		locked_until TIMESTAMP,
+		creation_time TIMESTAMP,
+		processed_time TIMESTAMP,
+		dead_time TIMESTAMP
+	)`).Error)
+	s.db = db
+
+	// jobs are enqueued with the real clock, the worker's starts a bit later so they're due
+	s.now = time.Now().Add(time.Second)
+	var core zapcore.Core
+	core, s.logs = observer.New(zapcore.InfoLevel)
+	s.worker = NewWorker(db, zap.New(core))
+	s.worker.MaxAttempts = 3
+	s.worker.BaseDelay = time.Second
+	s.worker.MaxDelay = 3 * time.Second
+	s.worker.Now = func() time.Time {
+		return s.now
+	}
+}
+
+func (s *OutboxSuite) job(id int) *Job {
+	var jobDB Job
+	s.Require().NoError(s.db.First(&jobDB, id).Error)
+	return &jobDB
+}
+
+func (s *OutboxSuite) TestEnqueue_RolledBack() {
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		s.Require().NoError(Enqueue(tx, "test", testPayload{UserID: 1}))
+		return errors.New("rollback")
+	})
+	s.Require().Error(err)
+
+	var count int64
+	s.Require().NoError(s.db.Model(&Job{}).Count(&count).Error)
+	s.Assert().Zero(count)
+}
+
+func (s *OutboxSuite) TestEnqueueOnce() {
+	s.Require().NoError(EnqueueOnce(s.db, "test", "key", testPayload{UserID: 1}))
+	s.Require().NoError(EnqueueOnce(s.db, "test", "key", testPayload{UserID: 2}))
+	s.Require().NoError(Enqueue(s.db, "test", testPayload{UserID: 3}))
+
+	var count int64
+	s.Require().NoError(s.db.Model(&Job{}).Count(&count).Error)
+	s.Assert().EqualValues(2, count)
+}
+
+func (s *OutboxSuite) TestProcessBatch() {
+	var received int32
+	s.worker.Handle("test", func(ctx context.Context, payload []byte) error {
+		var p testPayload
+		err := json.Unmarshal(payload, &p)
+		if err != nil {
+			return err
+		}
+		s.Assert().NotNil(JobFromContext(ctx))
+		atomic.AddInt32(&received, int32(p.UserID))
+		return nil
+	})
+
+	s.Require().NoError(s.db.Transaction(func(tx *gorm.DB) error {
+		s.Require().NoError(Enqueue(tx, "test", testPayload{UserID: 1}))
+		return Enqueue(tx, "test", testPayload{UserID: 2})
+	}))
+
+	succeeded, err := s.worker.ProcessBatch(context.Background())
+	s.Require().NoError(err)
+	s.Assert().Equal(2, succeeded)
+	s.Assert().EqualValues(3, received)
+	s.Assert().Equal(StatusSucceeded, s.job(1).Status)
+	s.Assert().NotNil(s.job(1).ProcessedTime)
+
+	// processed jobs don't run again
+	succeeded, err = s.worker.ProcessBatch(context.Background())
+	s.Require().NoError(err)
+	s.Assert().Zero(succeeded)
+	s.Assert().EqualValues(3, received)
+}
+
+func (s *OutboxSuite) TestProcessBatch_BackoffAndDeadLetter() {
+	calls := 0
+	s.worker.Handle("test", func(ctx context.Context, payload []byte) error {
+		calls++
+		return errors.New("failed")
+	})
+	s.Require().NoError(Enqueue(s.db, "test", testPayload{UserID: 1}))
+
+	_, err := s.worker.ProcessBatch(context.Background())
+	s.Require().NoError(err)
+	s.Assert().Equal(1, calls)
+	s.Assert().Equal("failed", s.job(1).LastError)
+	s.Assert().WithinDuration(s.now.Add(time.Second), s.job(1).NextAttemptTime, time.Millisecond)
+
+	// not due yet
+	_, err = s.worker.ProcessBatch(context.Background())
+	s.Require().NoError(err)
+	s.Assert().Equal(1, calls)
+
+	s.now = s.now.Add(time.Second)
+	_, err = s.worker.ProcessBatch(context.Background())
+	s.Require().NoError(err)
+	s.Assert().Equal(2, calls)
+	s.Assert().WithinDuration(s.now.Add(2*time.Second), s.job(1).NextAttemptTime, time.Millisecond)
+
+	s.now = s.now.Add(2 * time.Second)
+	_, err = s.worker.ProcessBatch(context.Background())
+	s.Require().NoError(err)
+	s.Assert().Equal(3, calls)
+	s.Assert().Equal(StatusDead, s.job(1).Status)
+
+	deadJobs, err := s.worker.DeadJobs(10, 0)
+	s.Require().NoError(err)
+	s.Require().Len(deadJobs, 1)
+	s.Assert().Equal(3, deadJobs[0].Attempts)
+
+	logs := s.logs.FilterMessage("job is dead").All()
+	s.Require().Len(logs, 1)
+	s.Assert().Equal(int64(1), logs[0].ContextMap()["jobID"])
+	s.Assert().Equal("failed", logs[0].ContextMap()["lastError"])
+
+	// dead jobs only run again once retried
+	s.now = s.now.Add(time.Hour)
+	_, err = s.worker.ProcessBatch(context.Background())
+	s.Require().NoError(err)
+	s.Assert().Equal(3, calls)
+
+	s.Require().NoError(s.worker.Retry(1))
+	s.Assert().Error(s.worker.Retry(1))
+	_, err = s.worker.ProcessBatch(context.Background())
+	s.Require().NoError(err)
+	s.Assert().Equal(4, calls)
+}
+
+func (s *OutboxSuite) TestProcessBatch_UnknownTypeAndPanic() {
+	s.worker.MaxAttempts = 1
+	s.worker.Handle("panic", func(ctx context.Context, payload []byte) error {
+		panic("boom")
+	})
+	s.Require().NoError(Enqueue(s.db, "unknown", testPayload{UserID: 1}))
+	s.Require().NoError(Enqueue(s.db, "panic", testPayload{UserID: 1}))
+
+	succeeded, err := s.worker.ProcessBatch(context.Background())
+	s.Require().NoError(err)
+	s.Assert().Zero(succeeded)
+	s.Assert().Equal(StatusDead, s.job(1).Status)
+	s.Assert().Equal(StatusDead, s.job(2).Status)
+	s.Assert().Contains(s.job(2).LastError, "boom")
+}
+
+func (s *OutboxSuite) TestClaim_Lease() {
+	s.Require().NoError(Enqueue(s.db, "test", testPayload{UserID: 1}))
+
+	jobsDB, err := s.worker.claim()
+	s.Require().NoError(err)
+	s.Require().Len(jobsDB, 1)
+
+	// claimed by a worker that crashed
+	jobsDB, err = s.worker.claim()
+	s.Require().NoError(err)
+	s.Assert().Empty(jobsDB)
+
+	s.now = s.now.Add(s.worker.Lease + time.Second)
+	jobsDB, err = s.worker.claim()
+	s.Require().NoError(err)
+	s.Require().Len(jobsDB, 1)
+	s.Assert().Equal(2, jobsDB[0].Attempts)
+}
+
+func TestOutbox(t *testing.T) {
+	suite.Run(t, new(OutboxSuite))
+}
@@ -0,0 +1,77 @@
+package pagination
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"github.com/pkg/errors"
+	"os"
+)
+
+// CipherKeySize is the size of the keys of AES-256
+const CipherKeySize = 32
+
+// Cipher seals the cursors with AES-GCM, so clients can neither read the values of the rows they're made of, like
+// the names of users, nor forge cursors
+type Cipher struct {
+	aead cipher.AEAD
+}
+
+func NewCipher(key []byte) (*Cipher, error) {
+	if len(key) != CipherKeySize {
+		return nil, errors.New("the cursor key must be 32 bytes")
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create cursor cipher")
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create cursor cipher")
+	}
+
+	return &Cipher{aead: aead}, nil
+}
+
+// NewCipherFromEnv loads the key from the PAGINATION_CURSOR_KEY env variable, 32 random bytes in standard base64.
+// Cursors issued with a key are rejected once it changes.
+func NewCipherFromEnv() (*Cipher, error) {
+	key, err := base64.StdEncoding.DecodeString(os.Getenv("PAGINATION_CURSOR_KEY"))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode PAGINATION_CURSOR_KEY")
+	}
+	return NewCipher(key)
+}
+
+// Seal encrypts the plaintext behind a random nonce and encodes it for URLs
+func (c *Cipher) Seal(plaintext []byte) (string, error) {
+	nonce := make([]byte, c.aead.NonceSize())
+	_, err := rand.Read(nonce)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to generate cursor nonce")
+	}
+
+	return base64.RawURLEncoding.EncodeToString(c.aead.Seal(nonce, nonce, plaintext, nil)), nil
+}
+
+// Open returns the plaintext of a sealed cursor, ErrInvalidCursor if it wasn't sealed with the key
+func (c *Cipher) Open(sealed string) ([]byte, error) {
+	decoded, err := base64.RawURLEncoding.DecodeString(sealed)
+	if err != nil {
+		return nil, errors.Wrap(ErrInvalidCursor, err.Error())
+	}
+
+	nonceSize := c.aead.NonceSize()
+	if len(decoded) < nonceSize {
+		return nil, errors.Wrap(ErrInvalidCursor, "cursor too short")
+	}
+
+	plaintext, err := c.aead.Open(nil, decoded[:nonceSize], decoded[nonceSize:], nil)
+	if err != nil {
+		return nil, errors.Wrap(ErrInvalidCursor, err.Error())
+	}
+
+	return plaintext, nil
+}
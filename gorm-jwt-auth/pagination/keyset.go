@@ -0,0 +1,249 @@
+package pagination
+
+import (
+	"bytes"
+	"encoding/gob"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// MaxPageSize is the most rows a page can have, it's also the size of a page when first and last are both missing
+const MaxPageSize = 100
+
+var (
+	ErrInvalidCursor = errors.New("invalid cursor")
+	ErrInvalidArgs   = errors.New("invalid pagination arguments")
+)
+
+func init() {
+	// the values of the cursors are interfaces, gob needs their types other than the basic ones
+	gob.Register(time.Time{})
+}
+
+// Column is a column the rows are ordered by
+type Column struct {
+	// Name is the DB name of the column
+	Name string
+	Desc bool
+	// Value reads the value of the column from a row, it can't be nil as NULL isn't comparable
+	Value func(row interface{}) interface{}
+}
+
+// Args are the Relay arguments of a connection: the first rows after a cursor or the last ones before a cursor
+type Args struct {
+	First  *int
+	After  *string
+	Last   *int
+	Before *string
+}
+
+func (a Args) backward() bool {
+	return a.Last != nil || a.Before != nil
+}
+
+func (a Args) cursor() *string {
+	if a.backward() {
+		return a.Before
+	}
+	return a.After
+}
+
+func (a Args) limit() (int, error) {
+	if (a.First != nil || a.After != nil) && a.backward() {
+		return 0, errors.Wrap(ErrInvalidArgs, "first and after can't be used with last and before")
+	}
+
+	limit := MaxPageSize
+	if a.First != nil {
+		limit = *a.First
+	} else if a.Last != nil {
+		limit = *a.Last
+	}
+	if limit < 1 || limit > MaxPageSize {
+		return 0, errors.Wrap(ErrInvalidArgs, "the page size has to be between 1 and "+strconv.Itoa(MaxPageSize))
+	}
+
+	return limit, nil
+}
+
+// Page tells the cursors of the rows of a page, Cursors[i] is the one of the i-th row, and if there are rows around it
+type Page struct {
+	Cursors         []string
+	HasNextPage     bool
+	HasPreviousPage bool
+}
+
+// StartCursor is the cursor of the first row, nil for an empty page
+func (p *Page) StartCursor() *string {
+	if len(p.Cursors) == 0 {
+		return nil
+	}
+	return &p.Cursors[0]
+}
+
+// EndCursor is the cursor of the last row, nil for an empty page
+func (p *Page) EndCursor() *string {
+	if len(p.Cursors) == 0 {
+		return nil
+	}
+	return &p.Cursors[len(p.Cursors)-1]
+}
+
+// Keyset pages rows in the order of its columns with cursors made of the values of the columns of a row, sealed by
+// the cipher, so pages don't shift when rows are added and deep pages are as fast as the first one. The last column
+// has to be unique, like the id, for the order to be total.
+type Keyset struct {
+	cipher  *Cipher
+	columns []Column
+}
+
+func NewKeyset(cipher *Cipher, columns ...Column) *Keyset {
+	return &Keyset{cipher: cipher, columns: columns}
+}
+
+// keysetCursor is what a cursor encodes, the names of the columns reject the cursors of another order
+type keysetCursor struct {
+	Columns []string
+	Values  []interface{}
+}
+
+// Apply orders the query by the columns and keeps the rows of the page of the arguments, plus one telling if there's
+// another page after it. Paging backward reverses the order, Page puts the rows back in order.
+func (k *Keyset) Apply(query *gorm.DB, args Args) (*gorm.DB, error) {
+	limit, err := args.limit()
+	if err != nil {
+		return nil, err
+	}
+	backward := args.backward()
+
+	if cursor := args.cursor(); cursor != nil {
+		values, err := k.decode(*cursor)
+		if err != nil {
+			return nil, err
+		}
+		condition, vars := k.condition(values, backward)
+		query = query.Where(condition, vars...)
+	}
+
+	for _, column := range k.columns {
+		direction := "ASC"
+		if column.Desc != backward {
+			direction = "DESC"
+		}
+		query = query.Order(column.Name + " " + direction)
+	}
+
+	return query.Limit(limit + 1), nil
+}
+
+// Page trims the extra row fetched because of Apply from rows, a pointer to the slice of the rows of the query, puts
+// them back in order and returns their cursors. Rows before the cursor of a forward page are assumed to exist, as the
+// cursor comes from one of them, and the other way around.
+func (k *Keyset) Page(rows interface{}, args Args) (*Page, error) {
+	limit, err := args.limit()
+	if err != nil {
+		return nil, err
+	}
+
+	slice := reflect.ValueOf(rows).Elem()
+	hasMore := slice.Len() > limit
+	if hasMore {
+		slice.Set(slice.Slice(0, limit))
+	}
+
+	page := &Page{Cursors: make([]string, slice.Len())}
+	if args.backward() {
+		swap := reflect.Swapper(slice.Interface())
+		for i, j := 0, slice.Len()-1; i < j; i, j = i+1, j-1 {
+			swap(i, j)
+		}
+		page.HasPreviousPage = hasMore
+		page.HasNextPage = args.Before != nil
+	} else {
+		page.HasNextPage = hasMore
+		page.HasPreviousPage = args.After != nil
+	}
+
+	for i := range page.Cursors {
+		page.Cursors[i], err = k.Cursor(slice.Index(i).Interface())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return page, nil
+}
+
+// Cursor returns the opaque cursor of the row
+func (k *Keyset) Cursor(row interface{}) (string, error) {
+	cursor := keysetCursor{
+		Columns: make([]string, len(k.columns)),
+		Values:  make([]interface{}, len(k.columns)),
+	}
+	for i, column := range k.columns {
+		cursor.Columns[i] = column.Name
+		cursor.Values[i] = column.Value(row)
+	}
+
+	var buffer bytes.Buffer
+	err := gob.NewEncoder(&buffer).Encode(cursor)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to encode cursor")
+	}
+
+	return k.cipher.Seal(buffer.Bytes())
+}
+
+func (k *Keyset) decode(sealed string) ([]interface{}, error) {
+	decoded, err := k.cipher.Open(sealed)
+	if err != nil {
+		return nil, err
+	}
+
+	var cursor keysetCursor
+	err = gob.NewDecoder(bytes.NewReader(decoded)).Decode(&cursor)
+	if err != nil {
+		return nil, errors.Wrap(ErrInvalidCursor, err.Error())
+	}
+
+	if len(cursor.Columns) != len(k.columns) || len(cursor.Values) != len(k.columns) {
+		return nil, errors.Wrap(ErrInvalidCursor, "cursor of another order")
+	}
+	for i, column := range k.columns {
+		if cursor.Columns[i] != column.Name {
+			return nil, errors.Wrap(ErrInvalidCursor, "cursor of another order")
+		}
+	}
+
+	return cursor.Values, nil
+}
+
+// condition keeps the rows after the values in the order of the columns, reversed when paging backward:
+// (c1 > v1) OR (c1 = v1 AND c2 > v2) OR ... with < for the descending columns
+func (k *Keyset) condition(values []interface{}, backward bool) (string, []interface{}) {
+	var ors []string
+	var vars []interface{}
+
+	for i, column := range k.columns {
+		var ands []string
+		for j := 0; j < i; j++ {
+			ands = append(ands, k.columns[j].Name+" = ?")
+			vars = append(vars, values[j])
+		}
+
+		operator := " > ?"
+		if column.Desc != backward {
+			operator = " < ?"
+		}
+		ands = append(ands, column.Name+operator)
+		vars = append(vars, values[i])
+
+		ors = append(ors, "("+strings.Join(ands, " AND ")+")")
+	}
+
+	return "(" + strings.Join(ors, " OR ") + ")", vars
+}
@@ -0,0 +1,189 @@
+package pagination
+
+import (
+	"bytes"
+	"encoding/base64"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"testing"
+)
+
+type item struct {
+	ID   int
+	Name string
+}
+
+var (
+	itemID   = Column{Name: "id", Value: func(row interface{}) interface{} { return row.(*item).ID }}
+	itemName = Column{Name: "name", Value: func(row interface{}) interface{} { return row.(*item).Name }}
+)
+
+type KeysetSuite struct {
+	suite.Suite
+	db     *gorm.DB
+	cipher *Cipher
+}
+
+func (s *KeysetSuite) SetupTest() {
+	db, err := gorm.Open(sqlite.Open("file::memory:"), &gorm.Config{})
+	s.Require().NoError(err)
+	sqlDB, err := db.DB()
+	s.Require().NoError(err)
+	sqlDB.SetMaxOpenConns(1)
+	s.db = db
+	s.cipher, err = NewCipher(bytes.Repeat([]byte{1}, CipherKeySize))
+	s.Require().NoError(err)
+
+	s.Require().NoError(db.AutoMigrate(&item{}))
+	s.Require().NoError(db.Create([]*item{
+		{ID: 1, Name: "b"},
+		{ID: 2, Name: "a"},
+		{ID: 3, Name: "c"},
+		{ID: 4, Name: "b"},
+		{ID: 5, Name: "a"},
+	}).Error)
+}
+
+// pages fetches every page of the keyset following the cursors and returns the ids of each page
+func (s *KeysetSuite) pages(keyset *Keyset, size int, backward bool) [][]int {
+	var pages [][]int
+	args := Args{First: &size}
+	if backward {
+		args = Args{Last: &size}
+	}
+
+	for {
+		query, err := keyset.Apply(s.db.Model(&item{}), args)
+		s.Require().NoError(err)
+		var items []*item
+		s.Require().NoError(query.Find(&items).Error)
+
+		page, err := keyset.Page(&items, args)
+		s.Require().NoError(err)
+		s.Require().Len(page.Cursors, len(items))
+
+		var ids []int
+		for _, item := range items {
+			ids = append(ids, item.ID)
+		}
+		pages = append(pages, ids)
+
+		if backward {
+			if !page.HasPreviousPage {
+				return pages
+			}
+			args.Before = page.StartCursor()
+		} else {
+			if !page.HasNextPage {
+				return pages
+			}
+			args.After = page.EndCursor()
+		}
+	}
+}
+
+func (s *KeysetSuite) TestPages() {
+	s.Assert().Equal([][]int{{2, 5}, {1, 4}, {3}}, s.pages(NewKeyset(s.cipher, itemName, itemID), 2, false))
+	s.Assert().Equal([][]int{{4, 3}, {5, 1}, {2}}, s.pages(NewKeyset(s.cipher, itemName, itemID), 2, true))
+}
+
+func (s *KeysetSuite) TestPages_Descending() {
+	nameDesc := itemName
+	nameDesc.Desc = true
+
+	s.Assert().Equal([][]int{{3, 1, 4}, {2, 5}}, s.pages(NewKeyset(s.cipher, nameDesc, itemID), 3, false))
+	s.Assert().Equal([][]int{{4, 2, 5}, {3, 1}}, s.pages(NewKeyset(s.cipher, nameDesc, itemID), 3, true))
+}
+
+func (s *KeysetSuite) TestPage_Info() {
+	keyset := NewKeyset(s.cipher, itemID)
+	size := 5
+
+	query, err := keyset.Apply(s.db.Model(&item{}), Args{First: &size})
+	s.Require().NoError(err)
+	var items []*item
+	s.Require().NoError(query.Find(&items).Error)
+
+	page, err := keyset.Page(&items, Args{First: &size})
+	s.Require().NoError(err)
+	s.Assert().Len(items, 5)
+	s.Assert().False(page.HasNextPage)
+	s.Assert().False(page.HasPreviousPage)
+
+	query, err = keyset.Apply(s.db.Model(&item{}), Args{First: &size, After: page.EndCursor()})
+	s.Require().NoError(err)
+	items = nil
+	s.Require().NoError(query.Find(&items).Error)
+
+	page, err = keyset.Page(&items, Args{First: &size, After: page.EndCursor()})
+	s.Require().NoError(err)
+	s.Assert().Empty(items)
+	s.Assert().Nil(page.StartCursor())
+	s.Assert().Nil(page.EndCursor())
+	s.Assert().True(page.HasPreviousPage)
+}
+
+func (s *KeysetSuite) TestApply_InvalidCursor() {
+	cursor, err := NewKeyset(s.cipher, itemID).Cursor(&item{ID: 1})
+	s.Require().NoError(err)
+
+	_, err = NewKeyset(s.cipher, itemName, itemID).Apply(s.db, Args{After: &cursor})
+	s.Assert().ErrorIs(err, ErrInvalidCursor)
+
+	garbage := "not a cursor"
+	_, err = NewKeyset(s.cipher, itemID).Apply(s.db, Args{After: &garbage})
+	s.Assert().ErrorIs(err, ErrInvalidCursor)
+}
+
+func (s *KeysetSuite) TestCursor_Sealed() {
+	cursor, err := NewKeyset(s.cipher, itemName, itemID).Cursor(&item{ID: 1, Name: "secret name"})
+	s.Require().NoError(err)
+	decoded, err := base64.RawURLEncoding.DecodeString(cursor)
+	s.Require().NoError(err)
+	s.Assert().NotContains(string(decoded), "secret name")
+	s.Assert().NotContains(string(decoded), "name")
+
+	otherCipher, err := NewCipher(bytes.Repeat([]byte{2}, CipherKeySize))
+	s.Require().NoError(err)
+	_, err = NewKeyset(otherCipher, itemName, itemID).Apply(s.db, Args{After: &cursor})
+	s.Assert().ErrorIs(err, ErrInvalidCursor, "sealed with another key")
+
+	decoded[len(decoded)-1] ^= 1
+	tampered := base64.RawURLEncoding.EncodeToString(decoded)
+	_, err = NewKeyset(s.cipher, itemName, itemID).Apply(s.db, Args{After: &tampered})
+	s.Assert().ErrorIs(err, ErrInvalidCursor, "tampered")
+}
+
+func (s *KeysetSuite) TestNewCipherFromEnv() {
+	s.T().Setenv("PAGINATION_CURSOR_KEY", base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{1}, CipherKeySize)))
+	_, err := NewCipherFromEnv()
+	s.Assert().NoError(err)
+
+	s.T().Setenv("PAGINATION_CURSOR_KEY", base64.StdEncoding.EncodeToString([]byte("too short")))
+	_, err = NewCipherFromEnv()
+	s.Assert().Error(err)
+
+	s.T().Setenv("PAGINATION_CURSOR_KEY", "")
+	_, err = NewCipherFromEnv()
+	s.Assert().Error(err, "missing")
+}
+
+func (s *KeysetSuite) TestApply_InvalidArgs() {
+	zero, tooMany, one := 0, MaxPageSize+1, 1
+	cursor := ""
+
+	for _, args := range []Args{
+		{First: &zero},
+		{Last: &tooMany},
+		{First: &one, Last: &one},
+		{After: &cursor, Before: &cursor},
+	} {
+		_, err := NewKeyset(s.cipher, itemID).Apply(s.db, args)
+		s.Assert().ErrorIs(err, ErrInvalidArgs)
+	}
+}
+
+func TestKeyset(t *testing.T) {
+	suite.Run(t, new(KeysetSuite))
+}
@@ -0,0 +1,200 @@
+package passwordhash
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"fmt"
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+type Algorithm string
+
+const (
+	AlgorithmArgon2id Algorithm = "argon2id"
+	AlgorithmBcrypt   Algorithm = "bcrypt"
+
+	argon2SaltLength = 16
+	argon2KeyLength  = 32
+
+	// bcryptMaxLength is the number of bytes bcrypt uses, the rest of a longer password would be ignored
+	bcryptMaxLength = 72
+)
+
+var (
+	ErrMismatchedHashAndPassword = errors.New("hash doesn't match password")
+	ErrUnknownHashFormat         = errors.New("unknown password hash format")
+	ErrPasswordTooLong           = errors.New("password is too long for the hash algorithm")
+)
+
+type Argon2Params struct {
+	Memory  uint32 // KiB
+	Time    uint32
+	Threads uint8
+}
+
+// Hasher hashes new passwords with the configured algorithm and verifies any of the supported formats. Hashes are
+// self describing: argon2id uses the PHC string format ($argon2id$v=19$m=...,t=...,p=...$salt$hash) and bcrypt its
+// modular crypt format ($2a$cost$...), so the parameters can change without breaking stored passwords.
+type Hasher struct {
+	Algorithm  Algorithm
+	BcryptCost int
+	Argon2     Argon2Params
+
+	dummyHashOnce sync.Once
+	dummyHash     string
+}
+
+func NewHasher() *Hasher {
+	hasher := &Hasher{}
+	hasher.Init()
+	return hasher
+}
+
+// Init loads the hasher from the PASSWORD_HASH_* env variables, the argon2id defaults follow the OWASP recommendations
+func (h *Hasher) Init() {
+	h.Algorithm = Algorithm(os.Getenv("PASSWORD_HASH_ALGORITHM"))
+	if h.Algorithm != AlgorithmBcrypt {
+		h.Algorithm = AlgorithmArgon2id
+	}
+	h.BcryptCost = envInt("PASSWORD_HASH_BCRYPT_COST", 10)
+	h.Argon2 = Argon2Params{
+		Memory:  uint32(envInt("PASSWORD_HASH_ARGON2_MEMORY_KIB", 19*1024)),
+		Time:    uint32(envInt("PASSWORD_HASH_ARGON2_TIME", 2)),
+		Threads: uint8(envInt("PASSWORD_HASH_ARGON2_THREADS", 1)),
+	}
+}
+
+// CheckLength returns ErrPasswordTooLong when the current algorithm would ignore part of the password
+func (h *Hasher) CheckLength(password string) error {
+	if h.Algorithm == AlgorithmBcrypt && len(password) > bcryptMaxLength {
+		return ErrPasswordTooLong
+	}
+	return nil
+}
+
+func (h *Hasher) Hash(password string) (string, error) {
+	err := h.CheckLength(password)
+	if err != nil {
+		return "", err
+	}
+
+	switch h.Algorithm {
+	case AlgorithmBcrypt:
+		hash, err := bcrypt.GenerateFromPassword([]byte(password), h.BcryptCost)
+		if err != nil {
+			return "", errors.Wrap(err, "failed to hash password with bcrypt")
+		}
+		return string(hash), nil
+	case AlgorithmArgon2id:
+		salt := make([]byte, argon2SaltLength)
+		_, err := rand.Read(salt)
+		if err != nil {
+			return "", errors.Wrap(err, "failed to generate salt")
+		}
+		key := argon2.IDKey([]byte(password), salt, h.Argon2.Time, h.Argon2.Memory, h.Argon2.Threads, argon2KeyLength)
+		return encodeArgon2(h.Argon2, salt, key), nil
+	default:
+		return "", errors.New("unsupported hash algorithm " + string(h.Algorithm))
+	}
+}
+
+// Verify checks the password against a hash of any supported format, needsRehash reports if the hash wasn't made with
+// the current algorithm and parameters
+func (h *Hasher) Verify(password string, hash string) (needsRehash bool, err error) {
+	hash = strings.TrimSpace(hash)
+
+	switch {
+	case strings.HasPrefix(hash, "$argon2id$"):
+		params, salt, key, err := decodeArgon2(hash)
+		if err != nil {
+			return false, err
+		}
+		computed := argon2.IDKey([]byte(password), salt, params.Time, params.Memory, params.Threads, uint32(len(key)))
+		if subtle.ConstantTimeCompare(computed, key) != 1 {
+			return false, ErrMismatchedHashAndPassword
+		}
+		return h.Algorithm != AlgorithmArgon2id || params != h.Argon2, nil
+	case strings.HasPrefix(hash, "$2"):
+		err := bcrypt.CompareHashAndPassword([]byte(hash), []byte(password))
+		if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+			return false, ErrMismatchedHashAndPassword
+		} else if err != nil {
+			return false, errors.Wrap(err, "failed to verify bcrypt hash")
+		}
+		cost, err := bcrypt.Cost([]byte(hash))
+		if err != nil {
+			return false, errors.Wrap(err, "failed to get bcrypt cost")
+		}
+		return h.Algorithm != AlgorithmBcrypt || cost != h.BcryptCost, nil
+	default:
+		return false, ErrUnknownHashFormat
+	}
+}
+
+// VerifyDummy takes as long as Verify with the current parameters, it's used when there is no hash to compare against
+// so the response time doesn't reveal it
+func (h *Hasher) VerifyDummy(password string) {
+	h.dummyHashOnce.Do(func() {
+		h.dummyHash, _ = h.Hash("dummy password")
+	})
+	_, _ = h.Verify(password, h.dummyHash)
+}
+
+func encodeArgon2(params Argon2Params, salt []byte, key []byte) string {
+	return fmt.Sprintf(
+		"$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s",
+		argon2.Version,
+		params.Memory,
+		params.Time,
+		params.Threads,
+		base64.RawStdEncoding.EncodeToString(salt),
+		base64.RawStdEncoding.EncodeToString(key),
+	)
+}
+
+func decodeArgon2(hash string) (Argon2Params, []byte, []byte, error) {
+	var params Argon2Params
+
+	parts := strings.Split(hash, "$")
+	if len(parts) != 6 {
+		return params, nil, nil, ErrUnknownHashFormat
+	}
+
+	var version int
+	_, err := fmt.Sscanf(parts[2], "v=%d", &version)
+	if err != nil || version != argon2.Version {
+		return params, nil, nil, errors.Wrap(ErrUnknownHashFormat, "unsupported argon2 version")
+	}
+
+	_, err = fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &params.Memory, &params.Time, &params.Threads)
+	if err != nil {
+		return params, nil, nil, errors.Wrap(ErrUnknownHashFormat, "invalid argon2 parameters")
+	}
+
+	salt, err := base64.RawStdEncoding.DecodeString(parts[4])
+	if err != nil {
+		return params, nil, nil, errors.Wrap(ErrUnknownHashFormat, "invalid argon2 salt")
+	}
+
+	key, err := base64.RawStdEncoding.DecodeString(parts[5])
+	if err != nil {
+		return params, nil, nil, errors.Wrap(ErrUnknownHashFormat, "invalid argon2 hash")
+	}
+
+	return params, salt, key, nil
+}
+
+func envInt(key string, defaultValue int) int {
+	value, err := strconv.Atoi(os.Getenv(key))
+	if err != nil {
+		return defaultValue
+	}
+	return value
+}
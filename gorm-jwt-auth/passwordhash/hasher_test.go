@@ -0,0 +1,99 @@
+package passwordhash
+
+import (
+	"github.com/stretchr/testify/suite"
+	"golang.org/x/crypto/bcrypt"
+	"strings"
+	"testing"
+)
+
+const testPassword = "Tr0ub4dor&3-xkcd"
+
+type HasherSuite struct {
+	suite.Suite
+	argon2 *Hasher
+	bcrypt *Hasher
+}
+
+func (s *HasherSuite) SetupTest() {
+	s.argon2 = &Hasher{
+		Algorithm: AlgorithmArgon2id,
+		Argon2:    Argon2Params{Memory: 1024, Time: 1, Threads: 1},
+	}
+	s.bcrypt = &Hasher{
+		Algorithm:  AlgorithmBcrypt,
+		BcryptCost: bcrypt.MinCost,
+	}
+}
+
+func (s *HasherSuite) TestHasher_Argon2id() {
+	hash, err := s.argon2.Hash(testPassword)
+	s.Require().NoError(err)
+	s.Assert().True(strings.HasPrefix(hash, "$argon2id$v=19$m=1024,t=1,p=1$"))
+
+	needsRehash, err := s.argon2.Verify(testPassword, hash)
+	s.Assert().NoError(err)
+	s.Assert().False(needsRehash)
+
+	_, err = s.argon2.Verify("wrong password", hash)
+	s.Assert().ErrorIs(err, ErrMismatchedHashAndPassword)
+}
+
+func (s *HasherSuite) TestHasher_Bcrypt() {
+	hash, err := s.bcrypt.Hash(testPassword)
+	s.Require().NoError(err)
+
+	needsRehash, err := s.bcrypt.Verify(testPassword, hash)
+	s.Assert().NoError(err)
+	s.Assert().False(needsRehash)
+
+	_, err = s.bcrypt.Verify("wrong password", hash)
+	s.Assert().ErrorIs(err, ErrMismatchedHashAndPassword)
+}
+
+func (s *HasherSuite) TestHasher_BcryptTooLong() {
+	password := strings.Repeat("x", 73)
+
+	_, err := s.bcrypt.Hash(password)
+	s.Assert().ErrorIs(err, ErrPasswordTooLong)
+
+	// argon2id uses the whole password
+	hash, err := s.argon2.Hash(password)
+	s.Require().NoError(err)
+	_, err = s.argon2.Verify(password[:72], hash)
+	s.Assert().ErrorIs(err, ErrMismatchedHashAndPassword)
+}
+
+func (s *HasherSuite) TestHasher_NeedsRehash() {
+	bcryptHash, err := s.bcrypt.Hash(testPassword)
+	s.Require().NoError(err)
+
+	// legacy char(60) column values are space padded
+	needsRehash, err := s.argon2.Verify(testPassword, bcryptHash+"   ")
+	s.Assert().NoError(err)
+	s.Assert().True(needsRehash, "other algorithm")
+
+	s.bcrypt.BcryptCost = bcrypt.MinCost + 1
+	needsRehash, err = s.bcrypt.Verify(testPassword, bcryptHash)
+	s.Assert().NoError(err)
+	s.Assert().True(needsRehash, "other bcrypt cost")
+
+	argon2Hash, err := s.argon2.Hash(testPassword)
+	s.Require().NoError(err)
+	s.argon2.Argon2.Time = 2
+	needsRehash, err = s.argon2.Verify(testPassword, argon2Hash)
+	s.Assert().NoError(err)
+	s.Assert().True(needsRehash, "other argon2 parameters")
+}
+
+func (s *HasherSuite) TestHasher_UnknownFormat() {
+	_, err := s.argon2.Verify(testPassword, "plaintext")
+	s.Assert().ErrorIs(err, ErrUnknownHashFormat)
+
+	_, err = s.argon2.Verify(testPassword, "$argon2id$v=19$m=1024$salt$hash")
+	s.Assert().ErrorIs(err, ErrUnknownHashFormat)
+}
+
+func TestHasher(t *testing.T) {
+	suite.Run(t, new(HasherSuite))
+}
@@ -0,0 +1,60 @@
+package passwordpolicy
+
+import (
+	"bufio"
+	"crypto/sha1"
+	"encoding/hex"
+	"github.com/pkg/errors"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+const hashPrefixLength = 5
+
+// Corpus is an offline copy of the Have I Been Pwned range API. Dir holds one file per SHA-1 prefix (the first 5 hex
+// characters, e.g. 21BD1) with a "SUFFIX:COUNT" line per breached password, which is what the HIBP downloader
+// produces. Only the prefix is used to select a file so the full hash never leaves the lookup.
+type Corpus struct {
+	Dir string
+	// MinCount ignores passwords seen fewer times in breaches
+	MinCount int
+}
+
+func (c *Corpus) Contains(password string) (bool, error) {
+	hash := sha1.Sum([]byte(password))
+	hashHex := strings.ToUpper(hex.EncodeToString(hash[:]))
+	prefix, suffix := hashHex[:hashPrefixLength], hashHex[hashPrefixLength:]
+
+	file, err := os.Open(filepath.Join(c.Dir, prefix))
+	if errors.Is(err, os.ErrNotExist) {
+		file, err = os.Open(filepath.Join(c.Dir, prefix+".txt"))
+	}
+	if errors.Is(err, os.ErrNotExist) {
+		return false, nil
+	} else if err != nil {
+		return false, errors.Wrap(err, "failed to open breached passwords range")
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		lineSuffix, countString, _ := strings.Cut(line, ":")
+		if !strings.EqualFold(lineSuffix, suffix) {
+			continue
+		}
+
+		count, err := strconv.Atoi(countString)
+		if err != nil {
+			count = 1
+		}
+		return count >= c.MinCount, nil
+	}
+	if err := scanner.Err(); err != nil {
+		return false, errors.Wrap(err, "failed to read breached passwords range")
+	}
+
+	return false, nil
+}
@@ -0,0 +1,168 @@
+package passwordpolicy
+
+import (
+	"github.com/pkg/errors"
+	"os"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+type Rule string
+
+const (
+	RuleMinLength    Rule = "MIN_LENGTH"
+	RuleMaxLength    Rule = "MAX_LENGTH"
+	RuleUppercase    Rule = "UPPERCASE"
+	RuleLowercase    Rule = "LOWERCASE"
+	RuleDigit        Rule = "DIGIT"
+	RuleSymbol       Rule = "SYMBOL"
+	RulePersonalInfo Rule = "PERSONAL_INFO"
+	RuleBreached     Rule = "BREACHED"
+
+	// minPersonalInfoLength skips short names so passwords aren't rejected because of two letters in common
+	minPersonalInfoLength = 3
+)
+
+// ViolationError lists every rule the password failed
+type ViolationError struct {
+	Rules []Rule
+}
+
+func (e *ViolationError) Error() string {
+	rules := make([]string, len(e.Rules))
+	for i, rule := range e.Rules {
+		rules[i] = string(rule)
+	}
+	return "password doesn't satisfy the rules: " + strings.Join(rules, ", ")
+}
+
+type Policy struct {
+	MinLength        int
+	MaxLength        int
+	RequireUppercase bool
+	RequireLowercase bool
+	RequireDigit     bool
+	RequireSymbol    bool
+	// ForbidPersonalInfo rejects passwords that contain the email or names of the user
+	ForbidPersonalInfo bool
+	// Breached is optional, when set passwords found in the corpus are rejected
+	Breached *Corpus
+}
+
+func NewPolicy() *Policy {
+	policy := &Policy{}
+	policy.Init()
+	return policy
+}
+
+// Init loads the policy from the PASSWORD_* env variables, the defaults follow NIST 800-63B
+func (p *Policy) Init() {
+	p.MinLength = envInt("PASSWORD_MIN_LENGTH", 10)
+	p.MaxLength = envInt("PASSWORD_MAX_LENGTH", 72)
+	p.RequireUppercase = envBool("PASSWORD_REQUIRE_UPPERCASE", false)
+	p.RequireLowercase = envBool("PASSWORD_REQUIRE_LOWERCASE", false)
+	p.RequireDigit = envBool("PASSWORD_REQUIRE_DIGIT", false)
+	p.RequireSymbol = envBool("PASSWORD_REQUIRE_SYMBOL", false)
+	p.ForbidPersonalInfo = envBool("PASSWORD_FORBID_PERSONAL_INFO", true)
+
+	if corpusDir := os.Getenv("PASSWORD_BREACHED_CORPUS_DIR"); corpusDir != "" {
+		p.Breached = &Corpus{
+			Dir:      corpusDir,
+			MinCount: envInt("PASSWORD_BREACHED_MIN_COUNT", 1),
+		}
+	}
+}
+
+// Validate returns a *ViolationError listing every failed rule, personalInfo are the email and names of the user
+func (p *Policy) Validate(password string, personalInfo ...string) error {
+	var rules []Rule
+
+	length := utf8.RuneCountInString(password)
+	if length < p.MinLength {
+		rules = append(rules, RuleMinLength)
+	}
+	if p.MaxLength > 0 && length > p.MaxLength {
+		rules = append(rules, RuleMaxLength)
+	}
+
+	var hasUppercase, hasLowercase, hasDigit, hasSymbol bool
+	for _, r := range password {
+		switch {
+		case unicode.IsUpper(r):
+			hasUppercase = true
+		case unicode.IsLower(r):
+			hasLowercase = true
+		case unicode.IsDigit(r):
+			hasDigit = true
+		case unicode.IsPunct(r) || unicode.IsSymbol(r) || unicode.IsSpace(r):
+			hasSymbol = true
+		}
+	}
+	if p.RequireUppercase && !hasUppercase {
+		rules = append(rules, RuleUppercase)
+	}
+	if p.RequireLowercase && !hasLowercase {
+		rules = append(rules, RuleLowercase)
+	}
+	if p.RequireDigit && !hasDigit {
+		rules = append(rules, RuleDigit)
+	}
+	if p.RequireSymbol && !hasSymbol {
+		rules = append(rules, RuleSymbol)
+	}
+
+	if p.ForbidPersonalInfo && containsPersonalInfo(password, personalInfo) {
+		rules = append(rules, RulePersonalInfo)
+	}
+
+	if p.Breached != nil && password != "" {
+		breached, err := p.Breached.Contains(password)
+		if err != nil {
+			return errors.Wrap(err, "failed to check breached passwords")
+		} else if breached {
+			rules = append(rules, RuleBreached)
+		}
+	}
+
+	if len(rules) > 0 {
+		return &ViolationError{Rules: rules}
+	}
+	return nil
+}
+
+func containsPersonalInfo(password string, personalInfo []string) bool {
+	passwordLower := strings.ToLower(password)
+
+	for _, info := range personalInfo {
+		info = strings.ToLower(strings.TrimSpace(info))
+		// check the local part of emails on its own, the domain is usually a common word
+		if at := strings.LastIndex(info, "@"); at > 0 {
+			if len(info[:at]) >= minPersonalInfoLength && strings.Contains(passwordLower, info[:at]) {
+				return true
+			}
+		}
+		if utf8.RuneCountInString(info) >= minPersonalInfoLength && strings.Contains(passwordLower, info) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func envInt(key string, defaultValue int) int {
+	value, err := strconv.Atoi(os.Getenv(key))
+	if err != nil {
+		return defaultValue
+	}
+	return value
+}
+
+func envBool(key string, defaultValue bool) bool {
+	value, err := strconv.ParseBool(os.Getenv(key))
+	if err != nil {
+		return defaultValue
+	}
+	return value
+}
@@ -0,0 +1,90 @@
+package passwordpolicy
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const breachedPassword = "correct horse battery staple"
+
+type PolicySuite struct {
+	suite.Suite
+	policy *Policy
+}
+
+func (s *PolicySuite) SetupTest() {
+	corpusDir := s.T().TempDir()
+	hash := sha1.Sum([]byte(breachedPassword))
+	hashHex := strings.ToUpper(hex.EncodeToString(hash[:]))
+	err := os.WriteFile(
+		filepath.Join(corpusDir, hashHex[:hashPrefixLength]),
+		[]byte("0000000000000000000000000000000000A:3\r\n"+hashHex[hashPrefixLength:]+":42\r\n"),
+		0o644,
+	)
+	s.Require().NoError(err)
+
+	s.policy = &Policy{
+		MinLength:          10,
+		MaxLength:          64,
+		RequireUppercase:   true,
+		RequireLowercase:   true,
+		RequireDigit:       true,
+		RequireSymbol:      true,
+		ForbidPersonalInfo: true,
+		Breached:           &Corpus{Dir: corpusDir, MinCount: 1},
+	}
+}
+
+func (s *PolicySuite) TestValidate_Valid() {
+	s.Assert().NoError(s.policy.Validate("Tr0ub4dor&3-xkcd", "ana@jevels.com", "Ana", "Smith"))
+}
+
+func (s *PolicySuite) TestValidate_Violations() {
+	for name, tc := range map[string]struct {
+		password string
+		expected []Rule
+	}{
+		"empty":         {"", []Rule{RuleMinLength, RuleUppercase, RuleLowercase, RuleDigit, RuleSymbol}},
+		"lowercase":     {"abcdefghijkl", []Rule{RuleUppercase, RuleDigit, RuleSymbol}},
+		"too long":      {"Aa1!" + strings.Repeat("x", 70), []Rule{RuleMaxLength}},
+		"email":         {"Ana.Smith-2024!", []Rule{RulePersonalInfo}},
+		"email local":   {"xX-ana.smith-9", []Rule{RulePersonalInfo}},
+		"breached only": {breachedPassword, []Rule{RuleUppercase, RuleDigit, RuleBreached}},
+	} {
+		err := s.policy.Validate(tc.password, "ana.smith@jevels.com", "Ana", "Smith")
+		var violationErr *ViolationError
+		s.Require().ErrorAs(err, &violationErr, name)
+		s.Assert().Equal(tc.expected, violationErr.Rules, name)
+	}
+}
+
+func TestPolicy(t *testing.T) {
+	suite.Run(t, new(PolicySuite))
+}
+
+func TestCorpus_Contains(t *testing.T) {
+	corpus := &Corpus{Dir: t.TempDir(), MinCount: 10}
+	hash := sha1.Sum([]byte(breachedPassword))
+	hashHex := strings.ToUpper(hex.EncodeToString(hash[:]))
+	err := os.WriteFile(filepath.Join(corpus.Dir, hashHex[:hashPrefixLength]+".txt"), []byte(hashHex[hashPrefixLength:]+":3\n"), 0o644)
+	assert.NoError(t, err)
+
+	breached, err := corpus.Contains(breachedPassword)
+	assert.NoError(t, err)
+	assert.False(t, breached, "seen fewer times than MinCount")
+
+	corpus.MinCount = 1
+	breached, err = corpus.Contains(breachedPassword)
+	assert.NoError(t, err)
+	assert.True(t, breached)
+
+	breached, err = corpus.Contains("some other password")
+	assert.NoError(t, err)
+	assert.False(t, breached)
+}
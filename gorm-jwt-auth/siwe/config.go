@@ -0,0 +1,118 @@
+package siwe
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"os"
+	"strconv"
+	"time"
+)
+
+var (
+	ErrInvalidDomain  = errors.New("sign in message was issued for another domain")
+	ErrInvalidURI     = errors.New("sign in message was issued for another uri")
+	ErrInvalidChainID = errors.New("sign in message was issued for another chain")
+	ErrInvalidVersion = errors.New("unsupported sign in message version")
+	ErrInvalidNonce   = errors.New("invalid sign in message nonce")
+	ErrExpired        = errors.New("sign in message has expired")
+	ErrNotYetValid    = errors.New("sign in message is not yet valid")
+	ErrIssuedInFuture = errors.New("sign in message was issued in the future")
+	ErrMissingExpiry  = errors.New("sign in message has no expiration time")
+)
+
+// allowedClockSkew tolerates small differences between the server clock and the one that issued the message
+const allowedClockSkew = 30 * time.Second
+
+// Config binds the messages to this relying party
+type Config struct {
+	Domain  string
+	URI     string
+	ChainID int
+}
+
+func NewConfig() (*Config, error) {
+	config := &Config{}
+	err := config.Init()
+	if err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+// Init loads the config from the SIWE_DOMAIN, SIWE_URI and SIWE_CHAIN_ID env variables, the domain and the chain id
+// are required as every message is bound to them
+func (c *Config) Init() error {
+	c.Domain = os.Getenv("SIWE_DOMAIN")
+	if c.Domain == "" {
+		return errors.New("SIWE_DOMAIN is not set")
+	}
+	c.URI = os.Getenv("SIWE_URI")
+
+	chainID, err := strconv.Atoi(os.Getenv("SIWE_CHAIN_ID"))
+	if err != nil {
+		return errors.Wrap(err, "invalid SIWE_CHAIN_ID")
+	}
+	c.ChainID = chainID
+
+	return nil
+}
+
+// NewMessage builds a message for the address that expires after ttl
+func (c *Config) NewMessage(address string, statement string, ttl time.Duration) (*Message, error) {
+	if !common.IsHexAddress(address) {
+		return nil, errors.New("invalid address")
+	}
+
+	nonce, err := NewNonce()
+	if err != nil {
+		return nil, err
+	}
+
+	issuedAt := time.Now().UTC().Truncate(time.Second)
+	expirationTime := issuedAt.Add(ttl)
+
+	return &Message{
+		Domain:         c.Domain,
+		Address:        common.HexToAddress(address).Hex(),
+		Statement:      statement,
+		URI:            c.URI,
+		Version:        Version,
+		ChainID:        c.ChainID,
+		Nonce:          nonce,
+		IssuedAt:       issuedAt,
+		ExpirationTime: &expirationTime,
+	}, nil
+}
+
+// Validate checks that every field of the message is bound to this relying party and that it's valid at now
+func (c *Config) Validate(m *Message, now time.Time) error {
+	if m.Domain != c.Domain {
+		return ErrInvalidDomain
+	}
+	if m.URI != c.URI {
+		return ErrInvalidURI
+	}
+	if m.Version != Version {
+		return ErrInvalidVersion
+	}
+	if m.ChainID != c.ChainID {
+		return ErrInvalidChainID
+	}
+	if len(m.Nonce) < minNonceLength {
+		return ErrInvalidNonce
+	}
+	if m.IssuedAt.After(now.Add(allowedClockSkew)) {
+		return ErrIssuedInFuture
+	}
+	if m.ExpirationTime == nil {
+		return ErrMissingExpiry
+	}
+	if !now.Before(*m.ExpirationTime) {
+		return ErrExpired
+	}
+	if m.NotBefore != nil && now.Add(allowedClockSkew).Before(*m.NotBefore) {
+		return ErrNotYetValid
+	}
+
+	return nil
+}
@@ -0,0 +1,219 @@
+package siwe
+
+import (
+	"crypto/rand"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	Version = "1"
+
+	headerSuffix   = " wants you to sign in with your Ethereum account:"
+	uriTag         = "URI: "
+	versionTag     = "Version: "
+	chainIDTag     = "Chain ID: "
+	nonceTag       = "Nonce: "
+	issuedAtTag    = "Issued At: "
+	expirationTag  = "Expiration Time: "
+	notBeforeTag   = "Not Before: "
+	requestIDTag   = "Request ID: "
+	resourcesTag   = "Resources:"
+	resourcePrefix = "- "
+	nonceAlphabet  = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+	nonceLength    = 17
+	minNonceLength = 8
+)
+
+var (
+	ErrMalformedMessage = errors.New("malformed sign in message")
+)
+
+// Message is an EIP-4361 Sign-In with Ethereum message
+type Message struct {
+	Domain         string
+	Address        string
+	Statement      string
+	URI            string
+	Version        string
+	ChainID        int
+	Nonce          string
+	IssuedAt       time.Time
+	ExpirationTime *time.Time
+	NotBefore      *time.Time
+	RequestID      string
+	Resources      []string
+}
+
+// String serializes the message in the EIP-4361 format, that's the exact text the wallet signs
+func (m *Message) String() string {
+	var b strings.Builder
+
+	b.WriteString(m.Domain + headerSuffix + "\n")
+	b.WriteString(m.Address + "\n")
+	b.WriteString("\n")
+	if m.Statement != "" {
+		b.WriteString(m.Statement + "\n")
+		b.WriteString("\n")
+	}
+	b.WriteString(uriTag + m.URI + "\n")
+	b.WriteString(versionTag + m.Version + "\n")
+	b.WriteString(chainIDTag + strconv.Itoa(m.ChainID) + "\n")
+	b.WriteString(nonceTag + m.Nonce + "\n")
+	b.WriteString(issuedAtTag + m.IssuedAt.UTC().Format(time.RFC3339))
+	if m.ExpirationTime != nil {
+		b.WriteString("\n" + expirationTag + m.ExpirationTime.UTC().Format(time.RFC3339))
+	}
+	if m.NotBefore != nil {
+		b.WriteString("\n" + notBeforeTag + m.NotBefore.UTC().Format(time.RFC3339))
+	}
+	if m.RequestID != "" {
+		b.WriteString("\n" + requestIDTag + m.RequestID)
+	}
+	if len(m.Resources) > 0 {
+		b.WriteString("\n" + resourcesTag)
+		for _, resource := range m.Resources {
+			b.WriteString("\n" + resourcePrefix + resource)
+		}
+	}
+
+	return b.String()
+}
+
+// Parse decodes an EIP-4361 message, every mandatory field must be present and in order
+func Parse(message string) (*Message, error) {
+	lines := strings.Split(message, "\n")
+	m := &Message{}
+	i := 0
+
+	next := func() (string, bool) {
+		if i >= len(lines) {
+			return "", false
+		}
+		line := lines[i]
+		i++
+		return line, true
+	}
+
+	tagged := func(tag string, optional bool) (string, error) {
+		if i >= len(lines) || !strings.HasPrefix(lines[i], tag) {
+			if optional {
+				return "", nil
+			}
+			return "", errors.Wrap(ErrMalformedMessage, "missing "+strings.TrimSuffix(tag, ": "))
+		}
+		line, _ := next()
+		return strings.TrimPrefix(line, tag), nil
+	}
+
+	// header
+	header, ok := next()
+	if !ok || !strings.HasSuffix(header, headerSuffix) {
+		return nil, errors.Wrap(ErrMalformedMessage, "invalid header")
+	}
+	m.Domain = strings.TrimSuffix(header, headerSuffix)
+	if m.Domain == "" {
+		return nil, errors.Wrap(ErrMalformedMessage, "missing domain")
+	}
+
+	// address
+	address, ok := next()
+	if !ok || !common.IsHexAddress(address) || common.HexToAddress(address).Hex() != address {
+		return nil, errors.Wrap(ErrMalformedMessage, "address must be EIP-55 checksummed")
+	}
+	m.Address = address
+
+	// statement
+	if line, ok := next(); !ok || line != "" {
+		return nil, errors.Wrap(ErrMalformedMessage, "expected empty line after address")
+	}
+	if i < len(lines) && !strings.HasPrefix(lines[i], uriTag) {
+		m.Statement, _ = next()
+		if line, ok := next(); !ok || line != "" {
+			return nil, errors.Wrap(ErrMalformedMessage, "expected empty line after statement")
+		}
+	}
+
+	var err error
+	if m.URI, err = tagged(uriTag, false); err != nil {
+		return nil, err
+	}
+	if m.Version, err = tagged(versionTag, false); err != nil {
+		return nil, err
+	}
+
+	chainID, err := tagged(chainIDTag, false)
+	if err != nil {
+		return nil, err
+	}
+	m.ChainID, err = strconv.Atoi(chainID)
+	if err != nil {
+		return nil, errors.Wrap(ErrMalformedMessage, "invalid chain id")
+	}
+
+	if m.Nonce, err = tagged(nonceTag, false); err != nil {
+		return nil, err
+	}
+
+	issuedAt, err := tagged(issuedAtTag, false)
+	if err != nil {
+		return nil, err
+	}
+	m.IssuedAt, err = time.Parse(time.RFC3339, issuedAt)
+	if err != nil {
+		return nil, errors.Wrap(ErrMalformedMessage, "invalid issued at")
+	}
+
+	if m.ExpirationTime, err = parseOptionalTime(tagged(expirationTag, true)); err != nil {
+		return nil, errors.Wrap(ErrMalformedMessage, "invalid expiration time")
+	}
+	if m.NotBefore, err = parseOptionalTime(tagged(notBeforeTag, true)); err != nil {
+		return nil, errors.Wrap(ErrMalformedMessage, "invalid not before")
+	}
+	if m.RequestID, err = tagged(requestIDTag, true); err != nil {
+		return nil, err
+	}
+
+	if i < len(lines) && lines[i] == resourcesTag {
+		i++
+		for i < len(lines) && strings.HasPrefix(lines[i], resourcePrefix) {
+			resource, _ := next()
+			m.Resources = append(m.Resources, strings.TrimPrefix(resource, resourcePrefix))
+		}
+	}
+
+	if i != len(lines) {
+		return nil, errors.Wrap(ErrMalformedMessage, "unexpected content after message")
+	}
+
+	return m, nil
+}
+
+// NewNonce generates a random alphanumeric nonce as required by EIP-4361
+func NewNonce() (string, error) {
+	nonce := make([]byte, nonceLength)
+	max := big.NewInt(int64(len(nonceAlphabet)))
+	for i := range nonce {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", errors.Wrap(err, "failed to generate nonce")
+		}
+		nonce[i] = nonceAlphabet[n.Int64()]
+	}
+	return string(nonce), nil
+}
+
+func parseOptionalTime(value string, err error) (*time.Time, error) {
+	if err != nil || value == "" {
+		return nil, err
+	}
+	parsed, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, err
+	}
+	return &parsed, nil
+}
@@ -0,0 +1,134 @@
+package siwe
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"strings"
+	"testing"
+	"time"
+)
+
+const (
+	testAddress   = "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"
+	testStatement = "Sign in to Jevels"
+)
+
+type MessageSuite struct {
+	suite.Suite
+	config *Config
+}
+
+func (s *MessageSuite) SetupTest() {
+	s.config = &Config{
+		Domain:  "jevels.com",
+		URI:     "https://jevels.com/login",
+		ChainID: 1,
+	}
+}
+
+func (s *MessageSuite) TestMessage_RoundTrip() {
+	message, err := s.config.NewMessage(testAddress, testStatement, time.Minute)
+	s.Require().NoError(err)
+	message.Resources = []string{"https://jevels.com/terms"}
+
+	parsed, err := Parse(message.String())
+	s.Require().NoError(err)
+	s.Assert().Equal(message.String(), parsed.String())
+	s.Assert().Equal(testAddress, parsed.Address)
+	s.Assert().Equal(testStatement, parsed.Statement)
+	s.Assert().NoError(s.config.Validate(parsed, time.Now()))
+}
+
+func (s *MessageSuite) TestMessage_WithoutStatement() {
+	message, err := s.config.NewMessage(testAddress, "", time.Minute)
+	s.Require().NoError(err)
+
+	parsed, err := Parse(message.String())
+	s.Require().NoError(err)
+	s.Assert().Equal("", parsed.Statement)
+	s.Assert().Equal(message.URI, parsed.URI)
+}
+
+func (s *MessageSuite) TestParse_Malformed() {
+	message, err := s.config.NewMessage(testAddress, testStatement, time.Minute)
+	s.Require().NoError(err)
+
+	lowercaseAddress := *message
+	lowercaseAddress.Address = "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed"
+
+	for name, raw := range map[string]string{
+		"empty":             "",
+		"lowercase address": lowercaseAddress.String(),
+		"trailing content":  message.String() + "\nextra",
+		"missing nonce":     strings.Replace(message.String(), nonceTag+message.Nonce+"\n", "", 1),
+	} {
+		_, err := Parse(raw)
+		s.Assert().ErrorIs(err, ErrMalformedMessage, name)
+	}
+}
+
+func (s *MessageSuite) TestConfig_Validate() {
+	now := time.Now()
+
+	for name, tc := range map[string]struct {
+		modify   func(m *Message)
+		at       time.Time
+		expected error
+	}{
+		"valid":         {modify: func(m *Message) {}, at: now, expected: nil},
+		"other domain":  {modify: func(m *Message) { m.Domain = "evil.com" }, at: now, expected: ErrInvalidDomain},
+		"other uri":     {modify: func(m *Message) { m.URI = "https://evil.com" }, at: now, expected: ErrInvalidURI},
+		"other chain":   {modify: func(m *Message) { m.ChainID = 5 }, at: now, expected: ErrInvalidChainID},
+		"other version": {modify: func(m *Message) { m.Version = "2" }, at: now, expected: ErrInvalidVersion},
+		"short nonce":   {modify: func(m *Message) { m.Nonce = "abc" }, at: now, expected: ErrInvalidNonce},
+		"no expiry":     {modify: func(m *Message) { m.ExpirationTime = nil }, at: now, expected: ErrMissingExpiry},
+		"expired":       {modify: func(m *Message) {}, at: now.Add(2 * time.Minute), expected: ErrExpired},
+		"issued later":  {modify: func(m *Message) {}, at: now.Add(-time.Hour), expected: ErrIssuedInFuture},
+		"not yet valid": {modify: func(m *Message) {
+			notBefore := now.Add(time.Hour)
+			m.NotBefore = &notBefore
+		}, at: now, expected: ErrNotYetValid},
+	} {
+		message, err := s.config.NewMessage(testAddress, testStatement, time.Minute)
+		s.Require().NoError(err)
+		tc.modify(message)
+		s.Assert().Equal(tc.expected, s.config.Validate(message, tc.at), name)
+	}
+}
+
+func (s *MessageSuite) TestConfig_Init() {
+	s.T().Setenv("SIWE_DOMAIN", "jevels.com")
+	s.T().Setenv("SIWE_URI", "https://jevels.com/login")
+	s.T().Setenv("SIWE_CHAIN_ID", "137")
+
+	config, err := NewConfig()
+	s.Require().NoError(err)
+	s.Assert().Equal(&Config{Domain: "jevels.com", URI: "https://jevels.com/login", ChainID: 137}, config)
+
+	s.T().Setenv("SIWE_CHAIN_ID", "polygon")
+	_, err = NewConfig()
+	s.Assert().Error(err)
+
+	s.T().Setenv("SIWE_CHAIN_ID", "")
+	_, err = NewConfig()
+	s.Assert().Error(err)
+
+	s.T().Setenv("SIWE_CHAIN_ID", "137")
+	s.T().Setenv("SIWE_DOMAIN", "")
+	_, err = NewConfig()
+	s.Assert().Error(err)
+}
+
+func TestMessage(t *testing.T) {
+	suite.Run(t, new(MessageSuite))
+}
+
+func TestNewNonce(t *testing.T) {
+	first, err := NewNonce()
+	assert.NoError(t, err)
+	second, err := NewNonce()
+	assert.NoError(t, err)
+
+	assert.Len(t, first, nonceLength)
+	assert.NotEqual(t, first, second)
+}
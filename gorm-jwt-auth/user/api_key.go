@@ -0,0 +1,39 @@
+package user
+
+import (
+	"strings"
+	"time"
+)
+
+// @GormDBNames
+type APIKey struct {
+	ID     int
+	UserID int `gorm:"index"`
+	Name   string
+	// Prefix is the start of the key, it's shown to help users tell their keys apart
+	Prefix string
+	// Hash is the SHA-256 of the key, the key itself is only shown once at creation
+	Hash string `gorm:"uniqueIndex" json:"-"`
+	// Scopes is a comma separated list of the scopes granted to the key
+	Scopes       string
+	CreationTime time.Time  `gorm:"type:timestamp without time zone;"`
+	LastUsedTime *time.Time `gorm:"type:timestamp without time zone;"`
+
+	User User `json:"-"`
+}
+
+func (k *APIKey) ScopeList() []string {
+	if k.Scopes == "" {
+		return nil
+	}
+	return strings.Split(k.Scopes, ",")
+}
+
+func (k *APIKey) HasScope(scope string) bool {
+	for _, keyScope := range k.ScopeList() {
+		if keyScope == scope {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,168 @@
+package user
+
+import (
+	"context"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/gomodule/redigo/redis"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/logger"
+	"server/internal/mailer"
+	"server/internal/mailtemplate"
+	"server/internal/onetimekey"
+	"server/internal/outbox"
+	"server/internal/passwordhash"
+	"time"
+)
+
+// testTables are created by hand as SQLite only parses timestamps from columns declared as such
+var testTables = []string{
+	`CREATE TABLE users (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		first_name TEXT,
+		last_name TEXT,
+		preferred_name TEXT,
+		register_time TIMESTAMP,
+		password TEXT,
+		email TEXT UNIQUE,
+		country TEXT,
+		locale TEXT,
+		deletion_time TIMESTAMP,
+		deleted_time TIMESTAMP,
+		has_complete_profile BOOLEAN,
+		has_bank_account BOOLEAN,
+		has_uploaded_one_nft BOOLEAN,
+		stripe_transfer_capability_status TEXT DEFAULT 'inactive'
+	)`,
+	`CREATE TABLE passkeys (
+		id BLOB PRIMARY KEY,
+		user_id INTEGER,
+		public_key BLOB,
+		attestation_type TEXT,
+		transports TEXT,
+		aaguid BLOB,
+		sign_count INTEGER,
+		clone_warning BOOLEAN,
+		backup_eligible BOOLEAN,
+		backup_state BOOLEAN,
+		creation_time TIMESTAMP,
+		last_used_time TIMESTAMP
+	)`,
+	`CREATE TABLE roles (
+		id INTEGER PRIMARY KEY,
+		name TEXT
+	)`,
+	`CREATE TABLE user_has_roles (
+		user_id INTEGER,
+		role_id INTEGER,
+		PRIMARY KEY (user_id, role_id)
+	)`,
+	`CREATE TABLE identities (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		user_id INTEGER,
+		provider TEXT,
+		subject TEXT,
+		email TEXT,
+		creation_time TIMESTAMP,
+		UNIQUE (provider, subject)
+	)`,
+	`CREATE TABLE api_keys (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		user_id INTEGER,
+		name TEXT,
+		prefix TEXT,
+		hash TEXT UNIQUE,
+		scopes TEXT,
+		creation_time TIMESTAMP,
+		last_used_time TIMESTAMP
+	)`,
+	`CREATE TABLE jobs (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		type TEXT,
+		idempotency_key TEXT UNIQUE,
+		payload TEXT,
+		status TEXT DEFAULT 'pending',
+		attempts INTEGER DEFAULT 0,
+		last_error TEXT,
+		next_attempt_time TIMESTAMP,
+		locked_until TIMESTAMP,
+		creation_time TIMESTAMP,
+		processed_time TIMESTAMP,
+		dead_time TIMESTAMP
+	)`,
+}
+
+// repositorySuite runs the repository against an in-memory SQLite database and miniredis
+type repositorySuite struct {
+	suite.Suite
+	redis      *miniredis.Miniredis
+	mail       *mailer.MemoryMailer
+	logs       *observer.ObservedLogs
+	repository *Repository
+}
+
+func (s *repositorySuite) SetupTest() {
+	db, err := gorm.Open(sqlite.Open("file::memory:"), &gorm.Config{Logger: logger.Default.LogMode(logger.Silent)})
+	s.Require().NoError(err)
+	sqlDB, err := db.DB()
+	s.Require().NoError(err)
+	sqlDB.SetMaxOpenConns(1)
+
+	for _, table := range testTables {
+		s.Require().NoError(db.Exec(table).Error)
+	}
+
+	s.redis = miniredis.RunT(s.T())
+	s.mail = mailer.NewMemoryMailer()
+	var core zapcore.Core
+	core, s.logs = observer.New(zapcore.InfoLevel)
+	s.repository = &Repository{
+		DB: db,
+		Keys: onetimekey.NewStore(&redis.Pool{
+			Dial: func() (redis.Conn, error) {
+				return redis.Dial("tcp", s.redis.Addr())
+			},
+		}),
+		Mail:           s.mail,
+		Logger:         zap.New(core),
+		TTLs:           NewTTLs(),
+		MailTemplates:  mailtemplate.NewRegistry(),
+		PasswordHasher: passwordhash.NewHasher(),
+	}
+}
+
+// processJobs runs the user jobs enqueued so far and returns how many succeeded
+func (s *repositorySuite) processJobs() int {
+	worker := outbox.NewWorker(s.repository.DB, s.repository.Logger)
+	// jobs are enqueued with the real clock, the worker's is a bit later so they're due
+	worker.Now = func() time.Time {
+		return time.Now().Add(time.Second)
+	}
+	s.repository.RegisterJobs(worker)
+
+	processed, err := worker.ProcessBatch(context.Background())
+	s.Require().NoError(err)
+	return processed
+}
+
+func (s *repositorySuite) createUser(email string) *User {
+	userDB := &User{PreferredName: "Ada", Email: email, Country: "FR"}
+	s.Require().NoError(s.repository.DB.Omit(clause.Associations).Create(userDB).Error)
+	return userDB
+}
+
+// createUserWithHash creates a user whose password was hashed by the hasher
+func (s *repositorySuite) createUserWithHash(hasher *passwordhash.Hasher, password string) *User {
+	passwordHash, err := hasher.Hash(password)
+	s.Require().NoError(err)
+
+	userDB := s.createUser("ada@example.com")
+	s.Require().NoError(s.repository.DB.Model(userDB).Update(DBNamesUser.Password, passwordHash).Error)
+	userDB.Password = passwordHash
+	return userDB
+}
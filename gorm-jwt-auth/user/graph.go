@@ -0,0 +1,45 @@
+package user
+
+import "server/api/graphql/graph"
+
+// ToGraph maps the user to its GraphQL model. The personal data fields are pointers as @protected redacts them to null,
+// the directive decides who reads them.
+func (u *User) ToGraph() *graph.User {
+	return &graph.User{
+		ID:                             u.ID,
+		FirstName:                      &u.FirstName,
+		LastName:                       &u.LastName,
+		Email:                          &u.Email,
+		PreferredName:                  u.PreferredName,
+		HasCompleteProfile:             u.HasCompleteProfile,
+		HasBankAccount:                 u.HasBankAccount,
+		HasUploadedOneNft:              u.HasUploadedOneNft,
+		StripeTransferCapabilityStatus: u.StripeTransferCapabilityStatus,
+	}
+}
+
+func UsersDBToGraph(usersDB []*User) []*graph.User {
+	usersGraph := make([]*graph.User, len(usersDB))
+	for i, userDB := range usersDB {
+		usersGraph[i] = userDB.ToGraph()
+	}
+	return usersGraph
+}
+
+func RolesDBToGraph(rolesDB []*Role) []*graph.Role {
+	rolesGraph := make([]*graph.Role, len(rolesDB))
+	for i, roleDB := range rolesDB {
+		rolesGraph[i] = &graph.Role{
+			ID:   roleDB.ID,
+			Name: roleDB.Name,
+		}
+	}
+	return rolesGraph
+}
+
+// ToGraph maps the profile, its image is set by the resolver as it's stored apart
+func (p *Profile) ToGraph() graph.Profile {
+	return graph.Profile{
+		Description: &p.Description,
+	}
+}
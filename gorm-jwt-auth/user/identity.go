@@ -0,0 +1,18 @@
+package user
+
+import (
+	"time"
+)
+
+// Identity links an account of an external login provider to a User
+// @GormDBNames
+type Identity struct {
+	ID           int
+	UserID       int    `gorm:"index"`
+	Provider     string `gorm:"uniqueIndex:idx_identity_provider_subject"`
+	Subject      string `gorm:"uniqueIndex:idx_identity_provider_subject"`
+	Email        string
+	CreationTime time.Time `gorm:"type:timestamp without time zone;"`
+
+	User User
+}
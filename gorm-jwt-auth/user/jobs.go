@@ -0,0 +1,173 @@
+package user
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+	"server/internal/omnisend"
+	"server/internal/outbox"
+	"server/internal/subscription"
+	"time"
+)
+
+const (
+	JobSendConfirmationEmail       = "user.send_confirmation_email"
+	JobSendPassResetEmail          = "user.send_pass_reset_email"
+	JobSendEmailChangeConfirmation = "user.send_email_change_confirmation"
+	JobSendEmailChangeNotice       = "user.send_email_change_notice"
+	JobUpsertOmnisendContact       = "user.upsert_omnisend_contact"
+	JobMoveOmnisendContact         = "user.move_omnisend_contact"
+	JobSubscribeNewsletter         = "user.subscribe_newsletter"
+)
+
+type UserJobPayload struct {
+	UserID int
+}
+
+type EmailChangeJobPayload struct {
+	UserID   int
+	OldEmail string
+	NewEmail string
+	// Key is only set for the confirmation email
+	Key string `json:",omitempty"`
+}
+
+// RegisterJobs adds the handlers of the user jobs to the worker
+func (r *Repository) RegisterJobs(worker *outbox.Worker) {
+	worker.Handle(JobSendConfirmationEmail, r.userJob(func(userDB *User) error {
+		if userDB.RegisterTime != nil {
+			// confirmed in the meantime
+			return nil
+		}
+		return r.GenerateAndSendConfirmationKey(*userDB)
+	}))
+	worker.Handle(JobSendPassResetEmail, r.userJob(func(userDB *User) error {
+		return r.GenerateAndSendPassResetKey(*userDB)
+	}))
+	worker.Handle(JobUpsertOmnisendContact, r.userJob(UpsertOmnisendContact))
+	worker.Handle(JobSendEmailChangeConfirmation, r.emailChangeJob(r.sendEmailChangeConfirmation))
+	worker.Handle(JobSendEmailChangeNotice, r.emailChangeJob(r.sendEmailChangeNotice))
+	worker.Handle(JobMoveOmnisendContact, r.emailChangeJob(func(userDB *User, payload EmailChangeJobPayload) error {
+		return MoveOmnisendContact(payload.OldEmail, payload.NewEmail)
+	}))
+}
+
+// RegisterNewsletterJob adds the handler of the newsletter subscription to the worker, it's apart from RegisterJobs as
+// the subscriptions are saved by their own repository
+func (r *Repository) RegisterNewsletterJob(worker *outbox.Worker, subscriptionRepository *subscription.Repository) {
+	worker.Handle(JobSubscribeNewsletter, r.userJob(func(userDB *User) error {
+		err := subscriptionRepository.Create(&subscription.Subscription{
+			Email:              userDB.Email,
+			SubscriptionTypeID: subscription.SubscriptionTypeIDNewsletter,
+		})
+		if err != nil {
+			return errors.Wrap(err, "failed to subscribe user to the newsletter")
+		}
+		return nil
+	}))
+}
+
+// userJob decodes the payload and gets the user, jobs of users that were deleted since are skipped
+func (r *Repository) userJob(handler func(userDB *User) error) outbox.Handler {
+	return func(ctx context.Context, payload []byte) error {
+		var userPayload UserJobPayload
+		err := json.Unmarshal(payload, &userPayload)
+		if err != nil {
+			return errors.Wrap(err, "failed to decode user job payload")
+		}
+
+		var userDB User
+		err = r.DB.WithContext(ctx).First(&userDB, userPayload.UserID).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		} else if err != nil {
+			return errors.Wrap(err, "failed to get user from DB")
+		}
+
+		return handler(&userDB)
+	}
+}
+
+// emailChangeJob decodes the payload and gets the user, jobs of users that were deleted since are skipped
+func (r *Repository) emailChangeJob(handler func(userDB *User, payload EmailChangeJobPayload) error) outbox.Handler {
+	return func(ctx context.Context, payload []byte) error {
+		var emailChangePayload EmailChangeJobPayload
+		err := json.Unmarshal(payload, &emailChangePayload)
+		if err != nil {
+			return errors.Wrap(err, "failed to decode email change job payload")
+		}
+
+		var userDB User
+		err = r.DB.WithContext(ctx).First(&userDB, emailChangePayload.UserID).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		} else if err != nil {
+			return errors.Wrap(err, "failed to get user from DB")
+		}
+
+		return handler(&userDB, emailChangePayload)
+	}
+}
+
+// UpsertOmnisendContact subscribes the user to the newsletter in Omnisend
+func UpsertOmnisendContact(userDB *User) error {
+	omnisendContact, err := omnisend.GetContact(userDB.Email)
+	if err != nil {
+		return errors.Wrap(err, "failed to get Omnisend contact")
+	}
+
+	contactToUpsert := omnisend.Contact{
+		CreatedAt: omnisend.JSONTime(time.Now()),
+		FirstName: userDB.FirstName,
+		LastName:  userDB.LastName,
+		Tags: []omnisend.Tag{
+			omnisend.TagCustomer,
+		},
+		Identifiers: []omnisend.Identifier{{
+			Type: omnisend.IdentifierTypeEmail,
+			Id:   userDB.Email,
+			Channels: omnisend.Channel{Email: &omnisend.ChannelDetails{
+				Status:     omnisend.ChannelStatusSubscribed,
+				StatusDate: omnisend.JSONTime(time.Now()),
+			}},
+		}},
+		CountryCode: userDB.Country,
+	}
+
+	if omnisendContact == nil {
+		err = omnisend.CreateContact(contactToUpsert)
+	} else {
+		err = omnisend.PatchContact(contactToUpsert, omnisendContact.ContactID)
+	}
+	if err != nil {
+		return errors.Wrap(err, "failed to upsert Omnisend contact")
+	}
+
+	return nil
+}
+
+// MoveOmnisendContact changes the email of the Omnisend contact, it's a no-op if there is no contact or it was already
+// moved
+func MoveOmnisendContact(oldEmail string, newEmail string) error {
+	omnisendContact, err := omnisend.GetContact(oldEmail)
+	if err != nil {
+		return errors.Wrap(err, "failed to get Omnisend contact")
+	} else if omnisendContact == nil {
+		return nil
+	}
+
+	identifiers := omnisendContact.Identifiers
+	for i := range identifiers {
+		if identifiers[i].Type == omnisend.IdentifierTypeEmail {
+			identifiers[i].Id = newEmail
+		}
+	}
+
+	err = omnisend.PatchContact(omnisend.Contact{Identifiers: identifiers}, omnisendContact.ContactID)
+	if err != nil {
+		return errors.Wrap(err, "failed to patch Omnisend contact")
+	}
+
+	return nil
+}
@@ -0,0 +1,44 @@
+package user
+
+import (
+	"github.com/pkg/errors"
+	"server/internal/mailer"
+)
+
+var ErrUnsupportedLocale = errors.New("unsupported locale")
+
+// sendMail renders the template in the locale of the user and sends it to the address, which isn't always the current
+// email of the user
+func (r *Repository) sendMail(userDB *User, to string, template string, data interface{}) error {
+	message, err := r.MailTemplates.Render(template, r.MailTemplates.Locale(userDB.Locale, userDB.Country), data)
+	if err != nil {
+		return err
+	}
+
+	err = r.Mail.Send(mailer.Message{
+		From:    message.From,
+		To:      to,
+		Subject: message.Subject,
+		HTML:    message.HTML,
+		Text:    message.Text,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to send email")
+	}
+
+	return nil
+}
+
+// SetLocale sets the preferred language of the emails of the user, an empty locale falls back to the one of the country
+func (r *Repository) SetLocale(userID int, locale string) error {
+	if locale != "" && !r.MailTemplates.IsSupported(locale) {
+		return ErrUnsupportedLocale
+	}
+
+	err := r.DB.Model(&User{}).Where(DBNamesUser.ID, userID).Update(DBNamesUser.Locale, locale).Error
+	if err != nil {
+		return errors.Wrap(err, "failed to set locale of user")
+	}
+
+	return nil
+}
@@ -0,0 +1,89 @@
+package user
+
+import (
+	"github.com/alicebob/miniredis/v2"
+	"github.com/gomodule/redigo/redis"
+	"github.com/stretchr/testify/suite"
+	"regexp"
+	"server/internal/constant"
+	"server/internal/mailer"
+	"server/internal/mailtemplate"
+	"server/internal/onetimekey"
+	"testing"
+	"time"
+)
+
+type MailSuite struct {
+	suite.Suite
+	redis      *miniredis.Miniredis
+	mail       *mailer.MemoryMailer
+	repository *Repository
+}
+
+func (s *MailSuite) SetupTest() {
+	s.redis = miniredis.RunT(s.T())
+	s.mail = mailer.NewMemoryMailer()
+	s.repository = &Repository{
+		Keys: onetimekey.NewStore(&redis.Pool{
+			Dial: func() (redis.Conn, error) {
+				return redis.Dial("tcp", s.redis.Addr())
+			},
+		}),
+		TTLs:          NewTTLs(),
+		Mail:          s.mail,
+		MailTemplates: mailtemplate.NewRegistry(),
+	}
+}
+
+func (s *MailSuite) TestGenerateAndSendConfirmationKey() {
+	userDB := User{ID: 7, PreferredName: "Ada", Email: "ada@example.com", Country: "FR"}
+	s.Require().NoError(s.repository.GenerateAndSendConfirmationKey(userDB))
+
+	messages := s.mail.SentTo(userDB.Email)
+	s.Require().Len(messages, 1)
+	s.Assert().Equal("Confirmez votre compte", messages[0].Subject)
+
+	// the key is a 32 bytes base64url token appended to the confirmation URL
+	keyPattern := regexp.MustCompile(regexp.QuoteMeta(constant.ConfirmEmailURL) + `([A-Za-z0-9_-]{43})`)
+	htmlMatch := keyPattern.FindStringSubmatch(messages[0].HTML)
+	textMatch := keyPattern.FindStringSubmatch(messages[0].Text)
+	s.Require().NotNil(htmlMatch)
+	s.Require().NotNil(textMatch)
+	s.Require().Equal(htmlMatch[1], textMatch[1])
+
+	userID, err := s.repository.ConsumeConfirmationKey(textMatch[1])
+	s.Require().NoError(err)
+	s.Assert().Equal(userDB.ID, *userID)
+
+	_, err = s.repository.ConsumeConfirmationKey(textMatch[1])
+	s.Assert().ErrorIs(err, ErrConfirmationKeyNoLongerValid)
+}
+
+func (s *MailSuite) TestConfirmationKey_ExpiresAfterConfiguredTTL() {
+	s.T().Setenv("CONFIRMATION_KEY_TTL", "10m")
+	s.repository.TTLs = NewTTLs()
+
+	key, err := s.repository.GenerateConfirmationKey(7)
+	s.Require().NoError(err)
+
+	s.redis.FastForward(10*time.Minute - time.Second)
+	s.Assert().True(s.redis.Exists(confirmationRedisKey(*key)))
+
+	s.redis.FastForward(2 * time.Second)
+	_, err = s.repository.ConsumeConfirmationKey(*key)
+	s.Assert().ErrorIs(err, ErrConfirmationKeyNoLongerValid)
+}
+
+func (s *MailSuite) TestSendMail_UsesPreferredLocale() {
+	userDB := User{ID: 7, PreferredName: "Ada", Email: "ada@example.com", Country: "FR", Locale: "en"}
+	s.Require().NoError(s.repository.GenerateAndSendPassResetKey(userDB))
+
+	messages := s.mail.SentTo(userDB.Email)
+	s.Require().Len(messages, 1)
+	s.Assert().Equal("Reset your password", messages[0].Subject)
+	s.Assert().Contains(messages[0].Text, "Hi Ada,")
+}
+
+func TestMailSuite(t *testing.T) {
+	suite.Run(t, new(MailSuite))
+}
@@ -0,0 +1,65 @@
+package user
+
+import (
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// MigratePasswordColumn widens the password column from the bcrypt only char(60) to varchar(255) so it fits argon2id
+// hashes, the padding char(60) added is dropped by the cast. It's a no-op once the column has been widened.
+func MigratePasswordColumn(db *gorm.DB) error {
+	columnTypes, err := db.Migrator().ColumnTypes(&User{})
+	if err != nil {
+		return errors.Wrap(err, "failed to get user column types")
+	}
+
+	for _, columnType := range columnTypes {
+		if columnType.Name() != DBNamesUser.Password {
+			continue
+		}
+
+		length, ok := columnType.Length()
+		if ok && length >= 255 {
+			return nil
+		}
+
+		err = db.Migrator().AlterColumn(&User{}, "Password")
+		if err != nil {
+			return errors.Wrap(err, "failed to widen password column")
+		}
+		return nil
+	}
+
+	return nil
+}
+
+// MigratePermissions creates the permission tables and the DefaultPermissions, the ones it creates are granted to the
+// admin role. Existing permissions are left alone so it doesn't undo grants made since.
+func MigratePermissions(db *gorm.DB) error {
+	err := db.AutoMigrate(&Permission{}, &RoleHasPermissions{})
+	if err != nil {
+		return errors.Wrap(err, "failed to migrate permission tables")
+	}
+
+	return db.Transaction(func(tx *gorm.DB) error {
+		for _, permission := range DefaultPermissions {
+			permission := permission
+			result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&permission)
+			if result.Error != nil {
+				return errors.Wrap(result.Error, "failed to create permission "+permission.Name)
+			} else if result.RowsAffected == 0 {
+				continue
+			}
+
+			err = tx.Create(&RoleHasPermissions{
+				RoleID:       RoleAdminID,
+				PermissionID: permission.ID,
+			}).Error
+			if err != nil {
+				return errors.Wrap(err, "failed to grant permission "+permission.Name+" to admins")
+			}
+		}
+		return nil
+	})
+}
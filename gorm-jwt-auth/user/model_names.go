@@ -1,5 +1,32 @@
 package user
 
+type defDBNamesAPIKey_ struct {
+	TableName string
+	ID string
+	UserID string
+	Name string
+	Prefix string
+	Hash string
+	Scopes string
+	CreationTime string
+	LastUsedTime string
+	User string
+	
+}
+
+var DBNamesAPIKey = &defDBNamesAPIKey_{
+	TableName: "api_keys",
+	ID: "id",
+	UserID: "user_id",
+	Name: "name",
+	Prefix: "prefix",
+	Hash: "hash",
+	Scopes: "scopes",
+	CreationTime: "creation_time",
+	LastUsedTime: "last_used_time",
+	User: "User",
+}
+
 type defDBNamesCheckout_ struct {
 	TableName string
 	ID string
@@ -68,6 +95,81 @@ var DBNamesDesignerApplication = &defDBNamesDesignerApplication_{
 	User: "User",
 }
 
+type defDBNamesIdentity_ struct {
+	TableName string
+	ID string
+	UserID string
+	Provider string
+	Subject string
+	Email string
+	CreationTime string
+	User string
+	
+}
+
+var DBNamesIdentity = &defDBNamesIdentity_{
+	TableName: "identities",
+	ID: "id",
+	UserID: "user_id",
+	Provider: "provider",
+	Subject: "subject",
+	Email: "email",
+	CreationTime: "creation_time",
+	User: "User",
+}
+
+type defDBNamesPasskey_ struct {
+	TableName string
+	ID string
+	UserID string
+	PublicKey string
+	AttestationType string
+	Transports string
+	AAGUID string
+	SignCount string
+	CloneWarning string
+	BackupEligible string
+	BackupState string
+	CreationTime string
+	LastUsedTime string
+	User string
+	
+}
+
+var DBNamesPasskey = &defDBNamesPasskey_{
+	TableName: "passkeys",
+	ID: "id",
+	UserID: "user_id",
+	PublicKey: "public_key",
+	AttestationType: "attestation_type",
+	Transports: "transports",
+	AAGUID: "aaguid",
+	SignCount: "sign_count",
+	CloneWarning: "clone_warning",
+	BackupEligible: "backup_eligible",
+	BackupState: "backup_state",
+	CreationTime: "creation_time",
+	LastUsedTime: "last_used_time",
+	User: "User",
+}
+
+type defDBNamesPermission_ struct {
+	TableName string
+	ID string
+	Name string
+	Description string
+	Roles string
+	
+}
+
+var DBNamesPermission = &defDBNamesPermission_{
+	TableName: "permissions",
+	ID: "id",
+	Name: "name",
+	Description: "description",
+	Roles: "roles",
+}
+
 type defDBNamesProfile_ struct {
 	TableName string
 	UserID string
@@ -88,6 +190,7 @@ type defDBNamesRole_ struct {
 	ID string
 	Name string
 	Users string
+	Permissions string
 	
 }
 
@@ -96,6 +199,20 @@ var DBNamesRole = &defDBNamesRole_{
 	ID: "id",
 	Name: "name",
 	Users: "users",
+	Permissions: "permissions",
+}
+
+type defDBNamesRoleHasPermissions_ struct {
+	TableName string
+	RoleID string
+	PermissionID string
+	
+}
+
+var DBNamesRoleHasPermissions = &defDBNamesRoleHasPermissions_{
+	TableName: "role_has_permissions",
+	RoleID: "role_id",
+	PermissionID: "permission_id",
 }
 
 type defDBNamesStripeID_ struct {
@@ -138,11 +255,17 @@ type defDBNamesUser_ struct {
 	Password string
 	Email string
 	Country string
+	Locale string
+	DeletionTime string
+	DeletedTime string
 	Roles string
 	UserHasAddresses string
 	Profile string
 	UserHasNftsOffchain string
 	StripeCustomer string
+	Passkeys string
+	Identities string
+	APIKeys string
 	HasCompleteProfile string
 	HasBankAccount string
 	HasUploadedOneNft string
@@ -160,11 +283,17 @@ var DBNamesUser = &defDBNamesUser_{
 	Password: "password",
 	Email: "email",
 	Country: "country",
+	Locale: "locale",
+	DeletionTime: "deletion_time",
+	DeletedTime: "deleted_time",
 	Roles: "roles",
 	UserHasAddresses: "UserHasAddresses",
 	Profile: "Profile",
 	UserHasNftsOffchain: "UserHasNftsOffchain",
 	StripeCustomer: "StripeCustomer",
+	Passkeys: "Passkeys",
+	Identities: "Identities",
+	APIKeys: "APIKeys",
 	HasCompleteProfile: "has_complete_profile",
 	HasBankAccount: "has_bank_account",
 	HasUploadedOneNft: "has_uploaded_one_nft",
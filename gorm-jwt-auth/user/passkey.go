@@ -0,0 +1,34 @@
+package user
+
+import (
+	"strconv"
+	"time"
+)
+
+// @GormDBNames
+type Passkey struct {
+	ID              []byte `gorm:"primaryKey"`
+	UserID          int    `gorm:"index"`
+	PublicKey       []byte
+	AttestationType string
+	Transports      string
+	AAGUID          []byte `gorm:"column:aaguid"`
+	SignCount       uint32
+	CloneWarning    bool
+	BackupEligible  bool
+	BackupState     bool
+	CreationTime    time.Time  `gorm:"type:timestamp without time zone;"`
+	LastUsedTime    *time.Time `gorm:"type:timestamp without time zone;"`
+
+	User User
+}
+
+// passkeyUserHandle is the opaque user handle sent to authenticators, it's resolved back to the user on discoverable
+// logins
+func passkeyUserHandle(userID int) []byte {
+	return []byte(strconv.Itoa(userID))
+}
+
+func userIDFromPasskeyUserHandle(userHandle []byte) (int, error) {
+	return strconv.Atoi(string(userHandle))
+}
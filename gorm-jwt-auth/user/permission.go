@@ -0,0 +1,69 @@
+package user
+
+// Permissions granted through roles, the resolvers check them with the @requires directive. Each one is created by
+// MigratePermissions, other permissions can be added to the DB and granted without a release.
+const (
+	PermissionUsersReadPII                = "users:read_pii"
+	PermissionUsersUnlock                 = "users:unlock"
+	PermissionUsersAssignRoles            = "users:assign_roles"
+	PermissionRolesManage                 = "roles:manage"
+	PermissionBlogWrite                   = "blog:write"
+	PermissionNftsAssignOffchain          = "nfts:assign_offchain"
+	PermissionDesignerApplicationsResolve = "designer_applications:resolve"
+	PermissionJobsManage                  = "jobs:manage"
+	PermissionEmailsPreview               = "emails:preview"
+	PermissionOmnisendSync                = "omnisend:sync"
+	PermissionGraphQLAdminBudget          = "graphql:admin_budget"
+)
+
+// DefaultPermissions are created by MigratePermissions and granted to the admin role
+var DefaultPermissions = []Permission{
+	{Name: PermissionUsersReadPII, Description: "Read the name and email of any user"},
+	{Name: PermissionUsersUnlock, Description: "Lift login lockouts"},
+	{Name: PermissionUsersAssignRoles, Description: "Give roles to users and take them away"},
+	{Name: PermissionRolesManage, Description: "Create and delete roles and permissions, and grant permissions to roles"},
+	{Name: PermissionBlogWrite, Description: "Create and delete blog posts"},
+	{Name: PermissionNftsAssignOffchain, Description: "Assign off-chain NFTs to users"},
+	{Name: PermissionDesignerApplicationsResolve, Description: "Accept or reject designer applications"},
+	{Name: PermissionJobsManage, Description: "List and retry dead background jobs"},
+	{Name: PermissionEmailsPreview, Description: "List and preview email templates"},
+	{Name: PermissionOmnisendSync, Description: "Push products and contacts to Omnisend"},
+	{Name: PermissionGraphQLAdminBudget, Description: "Run GraphQL operations up to the admin complexity budget"},
+}
+
+// @GormDBNames
+type Permission struct {
+	ID int
+	// Name is resource:action, it's what the @requires directive refers to
+	Name        string `gorm:"uniqueIndex"`
+	Description string
+
+	Roles []*Role `gorm:"many2many:role_has_permissions;" json:"-"`
+}
+
+// PermissionSet is every permission a user has through their roles
+type PermissionSet map[string]struct{}
+
+func NewPermissionSet(names []string) PermissionSet {
+	set := make(PermissionSet, len(names))
+	for _, name := range names {
+		set[name] = struct{}{}
+	}
+	return set
+}
+
+func (s PermissionSet) Has(name string) bool {
+	_, ok := s[name]
+	return ok
+}
+
+// Missing returns the permissions of names that aren't in the set, in the same order
+func (s PermissionSet) Missing(names []string) []string {
+	var missing []string
+	for _, name := range names {
+		if !s.Has(name) {
+			missing = append(missing, name)
+		}
+	}
+	return missing
+}
@@ -0,0 +1,44 @@
+package user
+
+import (
+	"github.com/stretchr/testify/suite"
+	"testing"
+)
+
+type PermissionSetSuite struct {
+	suite.Suite
+}
+
+func (s *PermissionSetSuite) TestHas() {
+	set := NewPermissionSet([]string{PermissionBlogWrite, PermissionUsersReadPII, PermissionBlogWrite})
+
+	s.Assert().Len(set, 2)
+	s.Assert().True(set.Has(PermissionBlogWrite))
+	s.Assert().True(set.Has(PermissionUsersReadPII))
+	s.Assert().False(set.Has(PermissionRolesManage))
+	s.Assert().False(NewPermissionSet(nil).Has(PermissionBlogWrite))
+}
+
+func (s *PermissionSetSuite) TestMissing() {
+	set := NewPermissionSet([]string{PermissionBlogWrite})
+
+	s.Assert().Empty(set.Missing(nil))
+	s.Assert().Empty(set.Missing([]string{PermissionBlogWrite}))
+	s.Assert().Equal(
+		[]string{PermissionUsersReadPII, PermissionRolesManage},
+		set.Missing([]string{PermissionUsersReadPII, PermissionBlogWrite, PermissionRolesManage}),
+	)
+}
+
+func (s *PermissionSetSuite) TestDefaultPermissions() {
+	names := map[string]bool{}
+	for _, permission := range DefaultPermissions {
+		s.Assert().NotEmpty(permission.Description, permission.Name)
+		s.Assert().False(names[permission.Name], "duplicate "+permission.Name)
+		names[permission.Name] = true
+	}
+}
+
+func TestPermissionSet(t *testing.T) {
+	suite.Run(t, new(PermissionSetSuite))
+}
@@ -1,21 +1,111 @@
 package user
 
 import (
+	"context"
+	"github.com/go-webauthn/webauthn/webauthn"
+	"github.com/gomodule/redigo/redis"
 	"github.com/pkg/errors"
 	"github.com/stripe/stripe-go/v72"
-	"golang.org/x/crypto/bcrypt"
+	"go.uber.org/zap"
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
 	"server/api/graphql/graph"
-	"server/internal/mail"
+	"server/internal/mailer"
+	"server/internal/mailtemplate"
+	"server/internal/oidclogin"
+	"server/internal/onetimekey"
+	"server/internal/pagination"
+	"server/internal/passwordhash"
+	"server/internal/passwordpolicy"
 	"server/internal/redisrepo"
+	"server/internal/siwe"
 	"strings"
 )
 
+// TokenRevoker ends the sessions of a user, it's implemented by auth.Auth
+type TokenRevoker interface {
+	RevokeUserTokens(userID int, except string) error
+}
+
 type Repository struct {
-	DB    *gorm.DB
-	Redis *redisrepo.RedisRepo
-	Mail  *mail.Mail
+	DB     *gorm.DB
+	Redis  *redisrepo.RedisRepo
+	Mail   mailer.Mailer
+	Keys   *onetimekey.Store
+	Logger *zap.Logger
+	TTLs   *TTLs
+	Tokens TokenRevoker
+
+	MailTemplates *mailtemplate.Registry
+
+	WebAuthn *webauthn.WebAuthn
+	SIWE     *siwe.Config
+
+	LoginProviders oidclogin.Providers
+	PasswordPolicy *passwordpolicy.Policy
+	PasswordHasher *passwordhash.Hasher
+	Cursors        *pagination.Cipher
+}
+
+// NewRepository builds the repository and its dependencies configured from env variables, it fails if the mailer, the
+// WebAuthn relying party, the sign in with ethereum config, one of the login providers or the cursor key is
+// misconfigured
+func NewRepository(
+	ctx context.Context,
+	db *gorm.DB,
+	redisRepo *redisrepo.RedisRepo,
+	pool *redis.Pool,
+	tokens TokenRevoker,
+	logger *zap.Logger,
+) (*Repository, error) {
+	mail, err := mailer.New()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to configure mailer")
+	}
+
+	webAuthn, err := NewWebAuthn()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to configure WebAuthn")
+	}
+
+	siweConfig, err := siwe.NewConfig()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to configure sign in with ethereum")
+	}
+
+	loginProviders, err := oidclogin.NewProvidersFromEnv(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to configure login providers")
+	}
+
+	cursors, err := pagination.NewCipherFromEnv()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to configure pagination cursors")
+	}
+
+	return &Repository{
+		DB:             db,
+		Redis:          redisRepo,
+		Mail:           mail,
+		Keys:           onetimekey.NewStore(pool),
+		Logger:         logger,
+		TTLs:           NewTTLs(),
+		Tokens:         tokens,
+		MailTemplates:  mailtemplate.NewRegistry(),
+		WebAuthn:       webAuthn,
+		SIWE:           siweConfig,
+		LoginProviders: loginProviders,
+		PasswordPolicy: passwordpolicy.NewPolicy(),
+		PasswordHasher: passwordhash.NewHasher(),
+		Cursors:        cursors,
+	}, nil
+}
+
+// WithTx returns a copy of the repository whose queries run in the transaction
+func (r *Repository) WithTx(tx *gorm.DB) *Repository {
+	repository := *r
+	repository.DB = tx
+	return &repository
 }
 
 func addUserFilters(filter graph.UsersFilter, query *gorm.DB) *gorm.DB {
@@ -25,10 +115,10 @@ func addUserFilters(filter graph.UsersFilter, query *gorm.DB) *gorm.DB {
 	}
 
 	if filter.Name != nil {
-		query = query.
-			Where(DBNamesUser.FirstName+" ILIKE ?", "%"+*filter.Name+"%").
-			Or(DBNamesUser.LastName+" ILIKE ?", "%"+*filter.Name+"%").
-			Or(DBNamesUser.PreferredName+" ILIKE ?", "%"+*filter.Name+"%")
+		// grouped so the other conditions apply to every name column
+		name := "%" + *filter.Name + "%"
+		query = query.Where("("+DBNamesUser.FirstName+" ILIKE ? OR "+DBNamesUser.LastName+" ILIKE ? OR "+
+			DBNamesUser.PreferredName+" ILIKE ?)", name, name, name)
 	}
 
 	if filter.Email != nil {
@@ -101,6 +191,68 @@ func (r *Repository) GetUsers(filter graph.UsersFilter) ([]*User, error) {
 	return usersDB, nil
 }
 
+// GetUsersPage returns the users of the page of args in the order of the filter, the pagination of the filter is
+// ignored
+func (r *Repository) GetUsersPage(filter graph.UsersFilter, args pagination.Args) ([]*User, *pagination.Page, error) {
+	keyset := usersKeyset(r.Cursors, filter.OrderBy)
+	filter.OrderBy = nil
+	filter.Pagination = nil
+
+	query, err := keyset.Apply(addUserFilters(filter, r.DB), args)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var usersDB []*User
+	err = query.Find(&usersDB).Error
+	if err != nil {
+		err = errors.Wrap(err, "failed to get users from DB")
+		return nil, nil, err
+	}
+
+	page, err := keyset.Page(&usersDB, args)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return usersDB, page, nil
+}
+
+// usersKeyset orders the users like addUserFilters, by id last to make the order total
+func usersKeyset(cursors *pagination.Cipher, orderBy *graph.UsersOrderBy) *pagination.Keyset {
+	idColumn := pagination.Column{
+		Name:  DBNamesUser.ID,
+		Value: func(row interface{}) interface{} { return row.(*User).ID },
+	}
+
+	var columns []pagination.Column
+	if orderBy != nil {
+		if orderBy.ID != nil {
+			idColumn.Desc = *orderBy.ID == graph.OrderDirectionDesc
+			// the id is unique, the next columns wouldn't change the order
+			return pagination.NewKeyset(cursors, idColumn)
+		}
+
+		if orderBy.PreferredName != nil {
+			columns = append(columns, pagination.Column{
+				Name:  DBNamesUser.PreferredName,
+				Desc:  *orderBy.PreferredName == graph.OrderDirectionDesc,
+				Value: func(row interface{}) interface{} { return row.(*User).PreferredName },
+			})
+		}
+
+		if orderBy.LastName != nil {
+			columns = append(columns, pagination.Column{
+				Name:  DBNamesUser.LastName,
+				Desc:  *orderBy.LastName == graph.OrderDirectionDesc,
+				Value: func(row interface{}) interface{} { return row.(*User).LastName },
+			})
+		}
+	}
+
+	return pagination.NewKeyset(cursors, append(columns, idColumn)...)
+}
+
 func (r *Repository) Count(filter graph.UsersFilter) (int, error) {
 	var count int64
 
@@ -132,6 +284,22 @@ func (r *Repository) GetProfile(userID int) (*Profile, error) {
 	return &profile, nil
 }
 
+// ProfilesOfUsers returns the profiles by user ID, users without a profile aren't in the map
+func (r *Repository) ProfilesOfUsers(userIDs []int) (map[int]*Profile, error) {
+	var profilesDB []*Profile
+	err := r.DB.Where(DBNamesProfile.UserID+" IN ?", userIDs).Find(&profilesDB).Error
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get profiles from DB")
+	}
+
+	profiles := make(map[int]*Profile, len(profilesDB))
+	for _, profileDB := range profilesDB {
+		profiles[profileDB.UserID] = profileDB
+	}
+
+	return profiles, nil
+}
+
 func (r *Repository) UpsertProfile(userID int, input *graph.ProfileInput) (*Profile, error) {
 	var toUpdate []string
 
@@ -164,10 +332,23 @@ func (r *Repository) Create(input graph.CreateUserInput) (*User, error) {
 	}
 
 	if len(usersDB) > 0 {
-		return nil, errors.New("email already registered")
+		return nil, ErrEmailAlreadyRegistered
 	}
 
-	passwordHash, err := hashPassword(input.Password)
+	err = r.validatePassword(input.Password, input.Email, input.FirstName, input.LastName, input.PreferredName)
+	if err != nil {
+		return nil, err
+	}
+
+	locale := ""
+	if input.Locale != nil {
+		locale = *input.Locale
+		if !r.MailTemplates.IsSupported(locale) {
+			return nil, ErrUnsupportedLocale
+		}
+	}
+
+	passwordHash, err := r.PasswordHasher.Hash(input.Password)
 	if err != nil {
 		err = errors.Wrap(err, "failed to hash password")
 		return nil, err
@@ -186,9 +367,13 @@ func (r *Repository) Create(input graph.CreateUserInput) (*User, error) {
 			},
 		},
 		Country: input.Country,
+		Locale:  locale,
 	}
 
-	r.DB.Create(&userDB)
+	err = r.DB.Create(&userDB).Error
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to save user to DB")
+	}
 
 	return userDB, nil
 }
@@ -202,31 +387,25 @@ func (r * Repository) Save(userDB *User) (*User, error) {
 	return userDB, nil
 }
 
+// Delete removes the user and everything linked to it, it's meant for accounts that never got used like registrations
+// that failed. Accounts with history are purged through PurgeAccount which keeps an anonymised row.
 func (r *Repository) Delete(userID int) error {
-	err := r.DB.Where(DBNamesUserHasRoles.UserID, userID).Delete(&UserHasRoles{}).Error
-	if err != nil {
-		return errors.Wrap(err, "failed to delete roles from user from DB")
-	}
-	err = r.DB.Where(DBNamesUserHasAddresses.UserID, userID).Delete(&UserHasAddresses{}).Error
-	if err != nil {
-		return errors.Wrap(err, "failed to delete addresses from user from DB")
-	}
-	err = r.DB.Delete(&User{}, userID).Error
-	if err != nil {
-		return errors.Wrap(err, "failed to delete user from DB")
-	}
-
-	return nil
-}
+	err := r.DB.Transaction(func(tx *gorm.DB) error {
+		err := deleteUserData(tx, userID)
+		if err != nil {
+			return err
+		}
 
-// Helpers
+		err = tx.Delete(&User{}, userID).Error
+		if err != nil {
+			return errors.Wrap(err, "failed to delete user from DB")
+		}
 
-func hashPassword(password string) (string, error) {
-	bytes, err := bcrypt.GenerateFromPassword([]byte(password), 14)
-	return string(bytes), err
-}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
 
-func validatePasswordHash(password, hash string) error {
-	err := bcrypt.CompareHashAndPassword([]byte(hash), []byte(password))
-	return err
+	return DeleteUserStorage(userID)
 }
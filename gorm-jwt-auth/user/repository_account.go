@@ -0,0 +1,307 @@
+package user
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"io"
+	"os"
+	"path/filepath"
+	"server/api/graphql/graph"
+	"strconv"
+	"time"
+)
+
+// RequestAccountDeletion re-authenticates the user and schedules the purge of the account after the grace period
+func (r *Repository) RequestAccountDeletion(userDB *User, reauthentication Reauthentication) (*time.Time, error) {
+	err := r.Reauthenticate(userDB, reauthentication)
+	if err != nil {
+		return nil, err
+	}
+
+	deletionTime := time.Now().Add(r.TTLs.AccountDeletionGracePeriod)
+	err = r.DB.Model(&User{}).Where(DBNamesUser.ID, userDB.ID).Update(DBNamesUser.DeletionTime, deletionTime).Error
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to schedule account deletion")
+	}
+
+	return &deletionTime, nil
+}
+
+func (r *Repository) CancelAccountDeletion(userID int) error {
+	err := r.DB.Model(&User{}).Where(DBNamesUser.ID, userID).Update(DBNamesUser.DeletionTime, nil).Error
+	if err != nil {
+		return errors.Wrap(err, "failed to cancel account deletion")
+	}
+	return nil
+}
+
+// PurgeAccount deletes everything linked to the user and anonymises the user row in one transaction, then revokes the
+// sessions of the user. The row is kept so checkouts and NFTs created by the user keep a valid reference.
+func (r *Repository) PurgeAccount(userID int) error {
+	err := r.DB.Transaction(func(tx *gorm.DB) error {
+		err := deleteUserData(tx, userID)
+		if err != nil {
+			return err
+		}
+
+		now := time.Now()
+		err = tx.Model(&User{}).Where(DBNamesUser.ID, userID).Updates(map[string]interface{}{
+			DBNamesUser.FirstName:                      "",
+			DBNamesUser.LastName:                       "",
+			DBNamesUser.PreferredName:                  "",
+			DBNamesUser.Email:                          "deleted-" + strconv.Itoa(userID) + "@deleted.invalid",
+			DBNamesUser.Password:                       "",
+			DBNamesUser.Country:                        "",
+			DBNamesUser.Locale:                         "",
+			DBNamesUser.RegisterTime:                   nil,
+			DBNamesUser.DeletionTime:                   nil,
+			DBNamesUser.DeletedTime:                    now,
+			DBNamesUser.HasCompleteProfile:             false,
+			DBNamesUser.HasBankAccount:                 false,
+			DBNamesUser.HasUploadedOneNft:              false,
+			DBNamesUser.StripeTransferCapabilityStatus: "inactive",
+		}).Error
+		if err != nil {
+			return errors.Wrap(err, "failed to anonymise user")
+		}
+
+		return nil
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to purge account of user with id "+strconv.Itoa(userID))
+	}
+
+	// sessions opened during the grace period end, no new one can start now that the credentials are gone
+	err = r.Tokens.RevokeUserTokens(userID, "")
+	if err != nil {
+		return errors.Wrap(err, "failed to revoke tokens of user with id "+strconv.Itoa(userID))
+	}
+
+	// files can't be part of the transaction, they're removed once the data is gone
+	return DeleteUserStorage(userID)
+}
+
+// PurgeScheduledAccounts purges the accounts whose grace period ended before now, an account that fails doesn't stop
+// the others from being purged and every failure is returned
+func (r *Repository) PurgeScheduledAccounts(now time.Time) error {
+	var userIDs []int
+	err := r.DB.Model(&User{}).
+		Where(DBNamesUser.DeletionTime+" <= ?", now).
+		Where(DBNamesUser.DeletedTime+" IS NULL").
+		Pluck(DBNamesUser.ID, &userIDs).Error
+	if err != nil {
+		return errors.Wrap(err, "failed to get accounts scheduled for deletion")
+	}
+
+	var errs error
+	for _, userID := range userIDs {
+		errs = multierr.Append(errs, r.PurgeAccount(userID))
+	}
+
+	return errs
+}
+
+// RunAccountPurger calls PurgeScheduledAccounts every interval until the context is done
+func (r *Repository) RunAccountPurger(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			err := r.PurgeScheduledAccounts(now)
+			for _, err := range multierr.Errors(err) {
+				r.Logger.Error("failed to purge scheduled account", zap.Error(err))
+			}
+		}
+	}
+}
+
+// deleteUserData deletes every row linked to the user but the user itself
+func deleteUserData(tx *gorm.DB, userID int) error {
+	for _, linked := range []struct {
+		name   string
+		column string
+		model  interface{}
+	}{
+		{"roles", DBNamesUserHasRoles.UserID, &UserHasRoles{}},
+		{"addresses", DBNamesUserHasAddresses.UserID, &UserHasAddresses{}},
+		{"profile", DBNamesProfile.UserID, &Profile{}},
+		{"offchain nfts", DBNamesUserHasOffchainNfts.UserID, &UserHasOffchainNfts{}},
+		{"stripe ids", DBNamesStripeID.UserID, &StripeID{}},
+		{"designer applications", DBNamesDesignerApplication.UserID, &DesignerApplication{}},
+		{"passkeys", DBNamesPasskey.UserID, &Passkey{}},
+		{"identities", DBNamesIdentity.UserID, &Identity{}},
+		{"API keys", DBNamesAPIKey.UserID, &APIKey{}},
+	} {
+		err := tx.Where(linked.column, userID).Delete(linked.model).Error
+		if err != nil {
+			return errors.Wrap(err, "failed to delete "+linked.name+" of user from DB")
+		}
+	}
+
+	return nil
+}
+
+// ExportData re-authenticates the user and returns a ZIP with a JSON file for everything linked to the user and the
+// uploaded files
+func (r *Repository) ExportData(userDB *User, reauthentication Reauthentication) ([]byte, error) {
+	err := r.Reauthenticate(userDB, reauthentication)
+	if err != nil {
+		return nil, err
+	}
+
+	roles, err := r.GetRoles(graph.RolesFilter{
+		User: &graph.RolesUserFilter{
+			ID: &userDB.ID,
+		},
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get roles of user from DB")
+	}
+
+	var (
+		profiles             []Profile
+		addresses            []UserHasAddresses
+		offchainNfts         []UserHasOffchainNfts
+		stripeIDs            []StripeID
+		designerApplications []DesignerApplication
+		checkouts            []Checkout
+		passkeys             []Passkey
+		identities           []Identity
+		apiKeys              []APIKey
+	)
+
+	for _, linked := range []struct {
+		name   string
+		column string
+		dest   interface{}
+	}{
+		{"profile", DBNamesProfile.UserID, &profiles},
+		{"addresses", DBNamesUserHasAddresses.UserID, &addresses},
+		{"offchain nfts", DBNamesUserHasOffchainNfts.UserID, &offchainNfts},
+		{"stripe ids", DBNamesStripeID.UserID, &stripeIDs},
+		{"designer applications", DBNamesDesignerApplication.UserID, &designerApplications},
+		{"checkouts", DBNamesCheckout.UserID, &checkouts},
+		{"passkeys", DBNamesPasskey.UserID, &passkeys},
+		{"identities", DBNamesIdentity.UserID, &identities},
+		{"API keys", DBNamesAPIKey.UserID, &apiKeys},
+	} {
+		err = r.DB.Where(linked.column, userDB.ID).Find(linked.dest).Error
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get "+linked.name+" of user from DB")
+		}
+	}
+
+	// passkeys are exported without their public keys, they're of no use outside of this relying party
+	type exportedPasskey struct {
+		CreationTime time.Time
+		LastUsedTime *time.Time
+		Transports   string
+	}
+	exportedPasskeys := make([]exportedPasskey, len(passkeys))
+	for i, passkey := range passkeys {
+		exportedPasskeys[i] = exportedPasskey{
+			CreationTime: passkey.CreationTime,
+			LastUsedTime: passkey.LastUsedTime,
+			Transports:   passkey.Transports,
+		}
+	}
+
+	buffer := new(bytes.Buffer)
+	archive := zip.NewWriter(buffer)
+
+	files := []struct {
+		name string
+		data interface{}
+	}{
+		{"user.json", map[string]interface{}{
+			"ID":            userDB.ID,
+			"FirstName":     userDB.FirstName,
+			"LastName":      userDB.LastName,
+			"PreferredName": userDB.PreferredName,
+			"Email":         userDB.Email,
+			"Country":       userDB.Country,
+			"Locale":        userDB.Locale,
+			"RegisterTime":  userDB.RegisterTime,
+			"DeletionTime":  userDB.DeletionTime,
+		}},
+		{"roles.json", roles},
+		{"profile.json", profiles},
+		{"addresses.json", addresses},
+		{"offchain_nfts.json", offchainNfts},
+		{"stripe_ids.json", stripeIDs},
+		{"designer_applications.json", designerApplications},
+		{"checkouts.json", checkouts},
+		{"passkeys.json", exportedPasskeys},
+		{"identities.json", identities},
+		{"api_keys.json", apiKeys},
+	}
+	for _, file := range files {
+		err = writeZipJSON(archive, file.name, file.data)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	profileImage, err := ProfileImagePath(userDB.ID)
+	if err != nil {
+		return nil, err
+	} else if profileImage != nil {
+		err = writeZipFile(archive, "files/"+filepath.Base(*profileImage), *profileImage)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = archive.Close()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to close export archive")
+	}
+
+	return buffer.Bytes(), nil
+}
+
+func writeZipJSON(archive *zip.Writer, name string, data interface{}) error {
+	writer, err := archive.Create(name)
+	if err != nil {
+		return errors.Wrap(err, "failed to add "+name+" to export archive")
+	}
+
+	encoder := json.NewEncoder(writer)
+	encoder.SetIndent("", "  ")
+	err = encoder.Encode(data)
+	if err != nil {
+		return errors.Wrap(err, "failed to encode "+name)
+	}
+
+	return nil
+}
+
+func writeZipFile(archive *zip.Writer, name string, path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return errors.Wrap(err, "failed to open "+path)
+	}
+	defer file.Close()
+
+	writer, err := archive.Create(name)
+	if err != nil {
+		return errors.Wrap(err, "failed to add "+name+" to export archive")
+	}
+
+	_, err = io.Copy(writer, file)
+	if err != nil {
+		return errors.Wrap(err, "failed to copy "+path+" to export archive")
+	}
+
+	return nil
+}
@@ -0,0 +1,192 @@
+package user
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/suite"
+	"io"
+	"strconv"
+	"testing"
+	"time"
+)
+
+// fakeTokenRevoker records the users whose tokens were revoked, it fails for the users of failFor
+type fakeTokenRevoker struct {
+	revoked []int
+	failFor map[int]bool
+}
+
+func (f *fakeTokenRevoker) RevokeUserTokens(userID int, except string) error {
+	if f.failFor[userID] {
+		return errors.New("redis is down")
+	}
+	f.revoked = append(f.revoked, userID)
+	return nil
+}
+
+type AccountSuite struct {
+	repositorySuite
+	tokens *fakeTokenRevoker
+	userDB *User
+}
+
+func (s *AccountSuite) SetupTest() {
+	s.repositorySuite.SetupTest()
+
+	// only the user ID matters to the purge and the export
+	for _, table := range []string{
+		DBNamesUserHasAddresses.TableName,
+		DBNamesUserHasOffchainNfts.TableName,
+		DBNamesStripeID.TableName,
+		DBNamesDesignerApplication.TableName,
+		DBNamesCheckout.TableName,
+	} {
+		s.Require().NoError(s.repository.DB.Exec("CREATE TABLE " + table + " (id INTEGER PRIMARY KEY, user_id INTEGER)").Error)
+	}
+	s.Require().NoError(s.repository.DB.Exec(
+		"CREATE TABLE " + DBNamesProfile.TableName + " (user_id INTEGER PRIMARY KEY, description TEXT, has_image BOOLEAN)",
+	).Error)
+
+	s.tokens = &fakeTokenRevoker{failFor: map[int]bool{}}
+	s.repository.Tokens = s.tokens
+	s.userDB = s.createUserWithHash(s.repository.PasswordHasher, testPassword)
+}
+
+func (s *AccountSuite) getUser(userID int) *User {
+	var userDB User
+	s.Require().NoError(s.repository.DB.First(&userDB, userID).Error)
+	return &userDB
+}
+
+func (s *AccountSuite) scheduleDeletion(userID int, deletionTime time.Time) {
+	s.Require().NoError(s.repository.DB.Model(&User{}).Where(DBNamesUser.ID, userID).Update(DBNamesUser.DeletionTime, deletionTime).Error)
+}
+
+func (s *AccountSuite) TestRequestAccountDeletion() {
+	s.repository.TTLs.AccountDeletionGracePeriod = 24 * time.Hour
+
+	deletionTime, err := s.repository.RequestAccountDeletion(s.userDB, Reauthentication{Password: testPassword})
+	s.Require().NoError(err)
+	s.Assert().WithinDuration(time.Now().Add(24*time.Hour), *deletionTime, time.Minute)
+	s.Require().NotNil(s.getUser(s.userDB.ID).DeletionTime)
+	s.Assert().WithinDuration(*deletionTime, *s.getUser(s.userDB.ID).DeletionTime, time.Second)
+}
+
+func (s *AccountSuite) TestRequestAccountDeletion_InvalidCredentials() {
+	_, err := s.repository.RequestAccountDeletion(s.userDB, Reauthentication{Password: "wrong password"})
+	s.Assert().ErrorIs(err, ErrInvalidCredentials)
+	s.Assert().Nil(s.getUser(s.userDB.ID).DeletionTime)
+}
+
+func (s *AccountSuite) TestCancelAccountDeletion() {
+	_, err := s.repository.RequestAccountDeletion(s.userDB, Reauthentication{Password: testPassword})
+	s.Require().NoError(err)
+
+	s.Require().NoError(s.repository.CancelAccountDeletion(s.userDB.ID))
+	s.Assert().Nil(s.getUser(s.userDB.ID).DeletionTime)
+
+	// a cancelled deletion isn't purged once the grace period is over
+	s.Require().NoError(s.repository.PurgeScheduledAccounts(time.Now().Add(s.repository.TTLs.AccountDeletionGracePeriod + time.Hour)))
+	s.Assert().Nil(s.getUser(s.userDB.ID).DeletedTime)
+	s.Assert().Empty(s.tokens.revoked)
+}
+
+func (s *AccountSuite) TestPurgeScheduledAccounts() {
+	now := time.Now()
+	s.scheduleDeletion(s.userDB.ID, now.Add(-time.Minute))
+	s.Require().NoError(s.repository.DB.Create(&Identity{UserID: s.userDB.ID, Provider: "google", Subject: "1"}).Error)
+	s.Require().NoError(s.repository.DB.Create(&Profile{UserID: s.userDB.ID, Description: "Designer"}).Error)
+	pending := s.createUser("grace@example.com")
+	s.scheduleDeletion(pending.ID, now.Add(time.Minute))
+
+	s.Require().NoError(s.repository.PurgeScheduledAccounts(now))
+
+	purged := s.getUser(s.userDB.ID)
+	s.Assert().NotNil(purged.DeletedTime)
+	s.Assert().Nil(purged.DeletionTime)
+	s.Assert().Equal("", purged.Password)
+	s.Assert().Equal("", purged.PreferredName)
+	s.Assert().Equal("deleted-"+strconv.Itoa(s.userDB.ID)+"@deleted.invalid", purged.Email)
+
+	var identities, profiles int64
+	s.Require().NoError(s.repository.DB.Model(&Identity{}).Where(DBNamesIdentity.UserID, s.userDB.ID).Count(&identities).Error)
+	s.Require().NoError(s.repository.DB.Model(&Profile{}).Where(DBNamesProfile.UserID, s.userDB.ID).Count(&profiles).Error)
+	s.Assert().Zero(identities)
+	s.Assert().Zero(profiles)
+	s.Assert().Equal([]int{s.userDB.ID}, s.tokens.revoked)
+
+	// the grace period of the other account isn't over
+	s.Assert().Nil(s.getUser(pending.ID).DeletedTime)
+
+	// purged accounts aren't purged again
+	s.Require().NoError(s.repository.PurgeScheduledAccounts(now.Add(time.Hour)))
+	s.Assert().Equal([]int{s.userDB.ID, pending.ID}, s.tokens.revoked)
+}
+
+func (s *AccountSuite) TestPurgeScheduledAccounts_ContinuesAfterFailure() {
+	now := time.Now()
+	failing := s.createUser("grace@example.com")
+	s.scheduleDeletion(failing.ID, now.Add(-time.Minute))
+	s.scheduleDeletion(s.userDB.ID, now.Add(-time.Minute))
+	s.tokens.failFor[failing.ID] = true
+
+	err := s.repository.PurgeScheduledAccounts(now)
+	s.Require().Error(err)
+	s.Assert().Contains(err.Error(), "failed to revoke tokens of user with id "+strconv.Itoa(failing.ID))
+
+	s.Assert().NotNil(s.getUser(s.userDB.ID).DeletedTime)
+	s.Assert().Equal([]int{s.userDB.ID}, s.tokens.revoked)
+}
+
+func (s *AccountSuite) TestExportData() {
+	s.Require().NoError(s.repository.DB.Create(&Profile{UserID: s.userDB.ID, Description: "Designer"}).Error)
+	s.Require().NoError(s.repository.DB.Create(&Passkey{
+		ID:           []byte("credential"),
+		UserID:       s.userDB.ID,
+		PublicKey:    []byte("public key"),
+		Transports:   "internal",
+		CreationTime: time.Now(),
+	}).Error)
+
+	export, err := s.repository.ExportData(s.userDB, Reauthentication{Password: testPassword})
+	s.Require().NoError(err)
+
+	archive, err := zip.NewReader(bytes.NewReader(export), int64(len(export)))
+	s.Require().NoError(err)
+	files := map[string][]byte{}
+	for _, file := range archive.File {
+		reader, err := file.Open()
+		s.Require().NoError(err)
+		files[file.Name], err = io.ReadAll(reader)
+		s.Require().NoError(err)
+		s.Require().NoError(reader.Close())
+	}
+	s.Assert().Len(files, 11)
+
+	var exportedUser map[string]interface{}
+	s.Require().NoError(json.Unmarshal(files["user.json"], &exportedUser))
+	s.Assert().Equal(s.userDB.Email, exportedUser["Email"])
+	s.Assert().NotContains(exportedUser, "Password")
+
+	var exportedProfiles []map[string]interface{}
+	s.Require().NoError(json.Unmarshal(files["profile.json"], &exportedProfiles))
+	s.Require().Len(exportedProfiles, 1)
+	s.Assert().Equal("Designer", exportedProfiles[0]["Description"])
+
+	var exportedPasskeys []map[string]interface{}
+	s.Require().NoError(json.Unmarshal(files["passkeys.json"], &exportedPasskeys))
+	s.Require().Len(exportedPasskeys, 1)
+	s.Assert().Equal("internal", exportedPasskeys[0]["Transports"])
+	s.Assert().NotContains(exportedPasskeys[0], "PublicKey")
+}
+
+func (s *AccountSuite) TestExportData_InvalidCredentials() {
+	_, err := s.repository.ExportData(s.userDB, Reauthentication{Password: "wrong password"})
+	s.Assert().ErrorIs(err, ErrInvalidCredentials)
+}
+
+func TestAccountSuite(t *testing.T) {
+	suite.Run(t, new(AccountSuite))
+}
@@ -53,3 +53,19 @@ func (r *Repository) AssociateAddress(userID int, address string) error {
 		return errors.New("that address is already associated to other account")
 	}
 }
+
+// AddressesOfUsers returns the addresses of every user by user ID, users without an address aren't in the map
+func (r *Repository) AddressesOfUsers(userIDs []int) (map[int][]*UserHasAddresses, error) {
+	var userHasAddressesDB []*UserHasAddresses
+	err := r.DB.Where(DBNamesUserHasAddresses.UserID+" IN ?", userIDs).Find(&userHasAddressesDB).Error
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get addresses of users from DB")
+	}
+
+	addresses := map[int][]*UserHasAddresses{}
+	for _, userHasAddressDB := range userHasAddressesDB {
+		addresses[userHasAddressDB.UserID] = append(addresses[userHasAddressDB.UserID], userHasAddressDB)
+	}
+
+	return addresses, nil
+}
@@ -0,0 +1,94 @@
+package user
+
+import (
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+	"server/internal/onetimekey"
+	"strings"
+	"time"
+)
+
+const (
+	// APIKeyPrefix tells API keys apart from JWTs in the Authorization header
+	APIKeyPrefix = "jvl_"
+
+	apiKeyDisplayLength = len(APIKeyPrefix) + 6
+
+	// apiKeyLastUsedPrecision bounds how often using a key writes its last use
+	apiKeyLastUsedPrecision = time.Minute
+)
+
+var ErrAPIKeyNotValid = errors.New("API key is not valid")
+
+// CreateAPIKey returns the key and its secret, only the hash of the secret is stored so it can't be shown again
+func (r *Repository) CreateAPIKey(userID int, name string, scopes []string) (*APIKey, string, error) {
+	if len(scopes) == 0 {
+		return nil, "", errors.New("an API key needs at least one scope")
+	}
+
+	token, err := onetimekey.NewToken()
+	if err != nil {
+		return nil, "", err
+	}
+	secret := APIKeyPrefix + token
+
+	apiKey := &APIKey{
+		UserID:       userID,
+		Name:         name,
+		Prefix:       secret[:apiKeyDisplayLength],
+		Hash:         onetimekey.Hash(secret),
+		Scopes:       strings.Join(scopes, ","),
+		CreationTime: time.Now(),
+	}
+	err = r.DB.Create(apiKey).Error
+	if err != nil {
+		return nil, "", errors.Wrap(err, "failed to save API key to DB")
+	}
+
+	return apiKey, secret, nil
+}
+
+func (r *Repository) APIKeys(userID int) ([]*APIKey, error) {
+	var apiKeys []*APIKey
+	err := r.DB.Where(DBNamesAPIKey.UserID, userID).Order(DBNamesAPIKey.CreationTime).Find(&apiKeys).Error
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get API keys from DB")
+	}
+	return apiKeys, nil
+}
+
+func (r *Repository) RevokeAPIKey(userID int, apiKeyID int) error {
+	result := r.DB.Where(DBNamesAPIKey.ID, apiKeyID).Where(DBNamesAPIKey.UserID, userID).Delete(&APIKey{})
+	if result.Error != nil {
+		return errors.Wrap(result.Error, "failed to delete API key from DB")
+	} else if result.RowsAffected == 0 {
+		return errors.New("API key doesn't exist")
+	}
+	return nil
+}
+
+// AuthenticateAPIKey returns the key matching the secret and records its use
+func (r *Repository) AuthenticateAPIKey(secret string) (*APIKey, error) {
+	if !strings.HasPrefix(secret, APIKeyPrefix) {
+		return nil, ErrAPIKeyNotValid
+	}
+
+	var apiKey APIKey
+	err := r.DB.Where(DBNamesAPIKey.Hash, onetimekey.Hash(secret)).First(&apiKey).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrAPIKeyNotValid
+	} else if err != nil {
+		return nil, errors.Wrap(err, "failed to get API key from DB")
+	}
+
+	now := time.Now()
+	if apiKey.LastUsedTime == nil || now.Sub(*apiKey.LastUsedTime) >= apiKeyLastUsedPrecision {
+		err = r.DB.Model(&APIKey{}).Where(DBNamesAPIKey.ID, apiKey.ID).Update(DBNamesAPIKey.LastUsedTime, now).Error
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to save last use of API key")
+		}
+		apiKey.LastUsedTime = &now
+	}
+
+	return &apiKey, nil
+}
@@ -0,0 +1,93 @@
+package user
+
+import (
+	"github.com/stretchr/testify/suite"
+	"strings"
+	"testing"
+	"time"
+)
+
+type APIKeySuite struct {
+	repositorySuite
+	userDB *User
+}
+
+func (s *APIKeySuite) SetupTest() {
+	s.repositorySuite.SetupTest()
+	s.userDB = s.createUser("ada@example.com")
+}
+
+func (s *APIKeySuite) lastUsedTime(apiKeyID int) *time.Time {
+	var apiKey APIKey
+	s.Require().NoError(s.repository.DB.First(&apiKey, apiKeyID).Error)
+	return apiKey.LastUsedTime
+}
+
+func (s *APIKeySuite) TestAuthenticateAPIKey() {
+	created, secret, err := s.repository.CreateAPIKey(s.userDB.ID, "CI", []string{"READ"})
+	s.Require().NoError(err)
+	s.Assert().True(strings.HasPrefix(secret, APIKeyPrefix))
+	s.Assert().True(strings.HasPrefix(secret, created.Prefix))
+	s.Assert().NotContains(created.Hash, secret)
+
+	apiKey, err := s.repository.AuthenticateAPIKey(secret)
+	s.Require().NoError(err)
+	s.Assert().Equal(created.ID, apiKey.ID)
+	s.Assert().Equal(s.userDB.ID, apiKey.UserID)
+	s.Assert().True(apiKey.HasScope("READ"))
+	s.Assert().False(apiKey.HasScope("WRITE"))
+	s.Require().NotNil(s.lastUsedTime(apiKey.ID))
+}
+
+func (s *APIKeySuite) TestAuthenticateAPIKey_LastUsedPrecision() {
+	_, secret, err := s.repository.CreateAPIKey(s.userDB.ID, "CI", []string{"READ"})
+	s.Require().NoError(err)
+
+	apiKey, err := s.repository.AuthenticateAPIKey(secret)
+	s.Require().NoError(err)
+	firstUse := *s.lastUsedTime(apiKey.ID)
+
+	// uses within the precision don't write
+	_, err = s.repository.AuthenticateAPIKey(secret)
+	s.Require().NoError(err)
+	s.Assert().True(firstUse.Equal(*s.lastUsedTime(apiKey.ID)))
+
+	earlier := firstUse.Add(-apiKeyLastUsedPrecision)
+	s.Require().NoError(s.repository.DB.Model(&APIKey{}).Where(DBNamesAPIKey.ID, apiKey.ID).Update(DBNamesAPIKey.LastUsedTime, earlier).Error)
+	_, err = s.repository.AuthenticateAPIKey(secret)
+	s.Require().NoError(err)
+	s.Assert().True(s.lastUsedTime(apiKey.ID).After(earlier))
+}
+
+func (s *APIKeySuite) TestAuthenticateAPIKey_NotValid() {
+	created, secret, err := s.repository.CreateAPIKey(s.userDB.ID, "CI", []string{"READ", "WRITE"})
+	s.Require().NoError(err)
+
+	for name, candidate := range map[string]string{
+		"without the prefix": strings.TrimPrefix(secret, APIKeyPrefix),
+		"unknown":            APIKeyPrefix + "unknown",
+		"display prefix":     created.Prefix,
+		"empty":              "",
+	} {
+		_, err = s.repository.AuthenticateAPIKey(candidate)
+		s.Assert().ErrorIs(err, ErrAPIKeyNotValid, name)
+	}
+
+	s.Require().NoError(s.repository.RevokeAPIKey(s.userDB.ID, created.ID))
+	_, err = s.repository.AuthenticateAPIKey(secret)
+	s.Assert().ErrorIs(err, ErrAPIKeyNotValid, "revoked")
+}
+
+func (s *APIKeySuite) TestRevokeAPIKey_OfAnotherUser() {
+	created, secret, err := s.repository.CreateAPIKey(s.userDB.ID, "CI", []string{"READ"})
+	s.Require().NoError(err)
+	other := s.createUser("grace@example.com")
+
+	s.Assert().Error(s.repository.RevokeAPIKey(other.ID, created.ID))
+	_, err = s.repository.AuthenticateAPIKey(secret)
+	s.Assert().NoError(err)
+}
+
+func TestAPIKeySuite(t *testing.T) {
+	suite.Run(t, new(APIKeySuite))
+}
@@ -2,41 +2,166 @@ package user
 
 import (
 	"github.com/pkg/errors"
-	"golang.org/x/crypto/bcrypt"
-	"server/api/graphql/graph"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"server/internal/onetimekey"
+	"server/internal/passwordhash"
+	"strconv"
 	"time"
 )
 
-func (r *Repository) ChangePassword(userID int, password string) error {
-	passwordHash, err := hashPassword(password)
+// CheckPasswordPolicy validates the password against the policy and the user's personal info
+func (r *Repository) CheckPasswordPolicy(userID int, password string) error {
+	var userDB User
+	err := r.DB.First(&userDB, userID).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return errors.New("user doesn't exist")
+	} else if err != nil {
+		return errors.Wrap(err, "failed to get user from DB")
+	}
+
+	return r.validatePassword(password, userDB.Email, userDB.FirstName, userDB.LastName, userDB.PreferredName)
+}
+
+// validatePassword checks the password against the policy and the limits of the hash algorithm
+func (r *Repository) validatePassword(password string, personalInfo ...string) error {
+	err := r.PasswordPolicy.Validate(password, personalInfo...)
+	if err != nil {
+		return err
+	}
+
+	return r.PasswordHasher.CheckLength(password)
+}
+
+// ChangePassword checks the password against the policy and stores its hash. consumeKey is called once the password
+// passed the policy, before it's stored, so a rejected password doesn't burn the key authorizing the change.
+func (r *Repository) ChangePassword(userID int, password string, consumeKey func() error) error {
+	err := r.CheckPasswordPolicy(userID, password)
+	if err != nil {
+		return err
+	}
+
+	passwordHash, err := r.PasswordHasher.Hash(password)
 	if err != nil {
 		err = errors.Wrap(err, "failed to hash password")
 		return err
 	}
 
-	return r.DB.Model(&User{}).Where(DBNamesUser.ID, userID).Update(DBNamesUser.Password, passwordHash).Error
+	err = consumeKey()
+	if err != nil {
+		return err
+	}
+
+	err = r.DB.Model(&User{}).Where(DBNamesUser.ID, userID).Update(DBNamesUser.Password, passwordHash).Error
+	if err != nil {
+		return errors.Wrap(err, "failed to change password")
+	}
+
+	return nil
 }
 
+// ErrInvalidCredentials is returned both for unregistered emails and wrong passwords so emails can't be enumerated
+var ErrInvalidCredentials = errors.New("invalid credentials")
+
+// Login verifies the password and, when the stored hash was made with an older algorithm or cost, transparently
+// replaces it with one made with the current parameters
 func (r *Repository) Login(email string, password string) (*User, error) {
-	usersDB, err := r.GetUsers(graph.UsersFilter{
-		Email: &email,
-	})
+	var userDB User
+	err := r.DB.Where(DBNamesUser.Email, email).First(&userDB).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		// verify against a dummy hash so both failures take the same time
+		r.PasswordHasher.VerifyDummy(password)
+		return nil, ErrInvalidCredentials
+	} else if err != nil {
+		return nil, errors.Wrap(err, "failed to login")
+	}
 
-	if err != nil {
+	needsRehash, err := r.PasswordHasher.Verify(password, userDB.Password)
+	if errors.Is(err, passwordhash.ErrMismatchedHashAndPassword) || errors.Is(err, passwordhash.ErrUnknownHashFormat) {
+		// users created through a social login have no password
+		return nil, ErrInvalidCredentials
+	} else if err != nil {
+		err = errors.Wrap(err, "failed to login")
 		return nil, err
-	} else if len(usersDB) == 0 {
-		return nil, errors.New("email is not registered")
 	}
 
-	err = validatePasswordHash(password, usersDB[0].Password)
-	if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
-		return nil, errors.New("incorrect password")
+	if needsRehash {
+		// the password is valid, a failed rehash is retried on the next login
+		err = r.rehashPassword(&userDB, password)
+		if err != nil {
+			r.Logger.Error("failed to rehash password on login", zap.Int("userID", userDB.ID), zap.Error(err))
+		}
+	}
+
+	return &userDB, nil
+}
+
+// Reauthentication proves the logged user is the one at the keyboard before a sensitive change, with the password or,
+// for users that have none, with the key issued by SocialReauthenticateEnd
+type Reauthentication struct {
+	Password string
+	Key      string
+}
+
+// Reauthenticate checks the reauthentication key when there is one, the password otherwise. Both failures return
+// ErrInvalidCredentials.
+func (r *Repository) Reauthenticate(userDB *User, reauthentication Reauthentication) error {
+	if reauthentication.Key == "" {
+		_, err := r.Login(userDB.Email, reauthentication.Password)
+		return err
+	}
+
+	rawUserID, err := r.Keys.Consume(reauthenticationRedisKey(reauthentication.Key), reauthenticationUserIDSetKey(userDB.ID))
+	if errors.Is(err, onetimekey.ErrNotFound) {
+		return ErrInvalidCredentials
 	} else if err != nil {
-		err = errors.Wrap(err, "failed to login")
+		return errors.Wrap(err, "failed to consume reauthentication key")
+	} else if rawUserID != strconv.Itoa(userDB.ID) {
+		return ErrInvalidCredentials
+	}
+
+	return nil
+}
+
+// GenerateReauthenticationKey returns a random key that replaces the previous ones of the user, only its hash is
+// stored
+func (r *Repository) GenerateReauthenticationKey(userID int) (*string, error) {
+	key, err := onetimekey.NewToken()
+	if err != nil {
 		return nil, err
 	}
 
-	return usersDB[0], nil
+	err = r.Keys.Save(
+		reauthenticationRedisKey(key),
+		strconv.Itoa(userID),
+		reauthenticationUserIDSetKey(userID),
+		reauthenticationKeyTTL,
+		true,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to save reauthentication key")
+	}
+
+	return &key, nil
+}
+
+func (r *Repository) rehashPassword(userDB *User, password string) error {
+	passwordHash, err := r.PasswordHasher.Hash(password)
+	if err != nil {
+		return errors.Wrap(err, "failed to rehash password")
+	}
+
+	// only replace the hash that was verified so a concurrent password change isn't overwritten
+	err = r.DB.Model(&User{}).
+		Where(DBNamesUser.ID, userDB.ID).
+		Where(DBNamesUser.Password, userDB.Password).
+		Update(DBNamesUser.Password, passwordHash).Error
+	if err != nil {
+		return errors.Wrap(err, "failed to save rehashed password")
+	}
+
+	userDB.Password = passwordHash
+	return nil
 }
 
 func (r * Repository) ConfirmUser(userID int) error {
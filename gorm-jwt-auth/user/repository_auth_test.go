@@ -0,0 +1,151 @@
+package user
+
+import (
+	"github.com/stretchr/testify/suite"
+	"golang.org/x/crypto/bcrypt"
+	"server/internal/passwordhash"
+	"server/internal/passwordpolicy"
+	"server/internal/siwe"
+	"strings"
+	"testing"
+)
+
+const testPassword = "Tr0ub4dor&3-xkcd"
+
+type AuthSuite struct {
+	repositorySuite
+}
+
+func (s *AuthSuite) storedPassword(userID int) string {
+	var userDB User
+	s.Require().NoError(s.repository.DB.First(&userDB, userID).Error)
+	return userDB.Password
+}
+
+func (s *AuthSuite) TestLogin_RehashesPassword() {
+	userDB := s.createUserWithHash(&passwordhash.Hasher{
+		Algorithm:  passwordhash.AlgorithmBcrypt,
+		BcryptCost: bcrypt.MinCost,
+	}, testPassword)
+
+	loginUser, err := s.repository.Login(userDB.Email, testPassword)
+	s.Require().NoError(err)
+	s.Assert().Equal(userDB.ID, loginUser.ID)
+
+	storedPassword := s.storedPassword(userDB.ID)
+	s.Assert().True(strings.HasPrefix(storedPassword, "$argon2id$"))
+	s.Assert().Equal(storedPassword, loginUser.Password)
+
+	needsRehash, err := s.repository.PasswordHasher.Verify(testPassword, storedPassword)
+	s.Assert().NoError(err)
+	s.Assert().False(needsRehash)
+}
+
+func (s *AuthSuite) TestLogin_RehashFailureIsLogged() {
+	// bcrypt can't hash the password of more than 72 bytes the argon2id hash was made from
+	password := testPassword + strings.Repeat("x", 72)
+	userDB := s.createUserWithHash(s.repository.PasswordHasher, password)
+	s.repository.PasswordHasher = &passwordhash.Hasher{
+		Algorithm:  passwordhash.AlgorithmBcrypt,
+		BcryptCost: bcrypt.MinCost,
+	}
+
+	loginUser, err := s.repository.Login(userDB.Email, password)
+	s.Require().NoError(err)
+	s.Assert().Equal(userDB.ID, loginUser.ID)
+	s.Assert().Equal(userDB.Password, s.storedPassword(userDB.ID))
+
+	logs := s.logs.FilterMessage("failed to rehash password on login").All()
+	s.Require().Len(logs, 1)
+	s.Assert().Equal(int64(userDB.ID), logs[0].ContextMap()["userID"])
+}
+
+func (s *AuthSuite) TestLogin_InvalidCredentials() {
+	userDB := s.createUserWithHash(s.repository.PasswordHasher, testPassword)
+
+	_, err := s.repository.Login(userDB.Email, "wrong password")
+	s.Assert().ErrorIs(err, ErrInvalidCredentials)
+
+	_, err = s.repository.Login("unknown@example.com", testPassword)
+	s.Assert().ErrorIs(err, ErrInvalidCredentials)
+}
+
+func (s *AuthSuite) TestMigratePasswordColumn() {
+	db := s.repository.DB
+	s.Require().NoError(db.Exec("DROP TABLE users").Error)
+	s.Require().NoError(db.Exec(strings.Replace(testTables[0], "password TEXT", "password CHAR(60)", 1)).Error)
+	userDB := s.createUserWithHash(&passwordhash.Hasher{
+		Algorithm:  passwordhash.AlgorithmBcrypt,
+		BcryptCost: bcrypt.MinCost,
+	}, testPassword)
+
+	s.Require().NoError(MigratePasswordColumn(db))
+
+	passwordLength := func() int64 {
+		columnTypes, err := db.Migrator().ColumnTypes(&User{})
+		s.Require().NoError(err)
+		for _, columnType := range columnTypes {
+			if columnType.Name() == DBNamesUser.Password {
+				length, _ := columnType.Length()
+				return length
+			}
+		}
+		s.FailNow("password column not found")
+		return 0
+	}
+	s.Assert().Equal(int64(255), passwordLength())
+	s.Assert().Equal(userDB.Password, s.storedPassword(userDB.ID))
+
+	// widened columns are left alone
+	s.Require().NoError(MigratePasswordColumn(db))
+	s.Assert().Equal(int64(255), passwordLength())
+
+	_, err := s.repository.Login(userDB.Email, testPassword)
+	s.Assert().NoError(err)
+}
+
+func (s *AuthSuite) TestChangePassword() {
+	s.repository.PasswordPolicy = &passwordpolicy.Policy{MinLength: 10, ForbidPersonalInfo: true}
+	userDB := s.createUserWithHash(s.repository.PasswordHasher, testPassword)
+	consumed := 0
+	consumeKey := func() error {
+		consumed++
+		return nil
+	}
+
+	// a rejected password doesn't consume the key
+	var violationErr *passwordpolicy.ViolationError
+	s.Assert().ErrorAs(s.repository.ChangePassword(userDB.ID, "short", consumeKey), &violationErr)
+	s.Assert().Zero(consumed)
+
+	s.Require().NoError(s.repository.ChangePassword(userDB.ID, "correct horse battery", consumeKey))
+	s.Assert().Equal(1, consumed)
+	_, err := s.repository.Login(userDB.Email, "correct horse battery")
+	s.Assert().NoError(err)
+
+	// the password isn't changed when the key was already consumed
+	err = s.repository.ChangePassword(userDB.ID, "another long password", func() error {
+		return ErrPassResetKeyNoLongerValid
+	})
+	s.Assert().ErrorIs(err, ErrPassResetKeyNoLongerValid)
+	_, err = s.repository.Login(userDB.Email, "correct horse battery")
+	s.Assert().NoError(err)
+}
+
+func (s *AuthSuite) TestLoginBlockchainMessage_SingleUse() {
+	s.repository.SIWE = &siwe.Config{Domain: "jevels.com", URI: "https://jevels.com/login", ChainID: 1}
+	message, err := s.repository.GenerateLoginBlockchainMessage("0x71C7656EC7ab88b098defB751B7401B5f6d8976F")
+	s.Require().NoError(err)
+
+	parsed, err := s.repository.LoginBlockchainMessage(*message)
+	s.Require().NoError(err)
+	s.Assert().Equal("0x71C7656EC7ab88b098defB751B7401B5f6d8976F", parsed.Address)
+
+	_, err = s.repository.LoginBlockchainMessage(*message)
+	s.Assert().EqualError(err, "invalid message")
+	s.Assert().Empty(s.redis.Keys())
+}
+
+func TestAuthSuite(t *testing.T) {
+	suite.Run(t, new(AuthSuite))
+}
@@ -0,0 +1,170 @@
+package user
+
+import (
+	"encoding/json"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+	"os"
+	"server/internal/mailtemplate"
+	"server/internal/onetimekey"
+	"server/internal/outbox"
+	"strconv"
+)
+
+var (
+	ErrEmailAlreadyRegistered      = errors.New("email already registered")
+	ErrEmailChangeKeyNoLongerValid = errors.New("email change key is no longer valid")
+)
+
+type EmailChangeHash struct {
+	UserID   int
+	OldEmail string
+	NewEmail string
+}
+
+// RequestEmailChange re-authenticates the user, saves the confirmation key and enqueues the emails with the key to the
+// new email and the notification to the current one. The email isn't changed until ConfirmEmailChange is called with
+// the key.
+func (r *Repository) RequestEmailChange(userDB *User, reauthentication Reauthentication, newEmail string) error {
+	err := r.Reauthenticate(userDB, reauthentication)
+	if err != nil {
+		return err
+	}
+
+	if newEmail == userDB.Email {
+		return errors.New("new email is the current email")
+	}
+
+	var count int64
+	err = r.DB.Model(&User{}).Where(DBNamesUser.Email, newEmail).Count(&count).Error
+	if err != nil {
+		return errors.Wrap(err, "failed to check if email exists")
+	} else if count > 0 {
+		return ErrEmailAlreadyRegistered
+	}
+
+	key, err := onetimekey.NewToken()
+	if err != nil {
+		return err
+	}
+
+	hashBytes, err := json.Marshal(EmailChangeHash{
+		UserID:   userDB.ID,
+		OldEmail: userDB.Email,
+		NewEmail: newEmail,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to encode email change")
+	}
+
+	// a new request replaces the pending one
+	err = r.Keys.Save(emailChangeRedisKey(key), string(hashBytes), emailChangeUserIDSetKey(userDB.ID), r.TTLs.EmailChangeKey, true)
+	if err != nil {
+		return errors.Wrap(err, "failed to save email change key")
+	}
+
+	payload := EmailChangeJobPayload{
+		UserID:   userDB.ID,
+		OldEmail: userDB.Email,
+		NewEmail: newEmail,
+	}
+	return r.DB.Transaction(func(tx *gorm.DB) error {
+		// the key is part of the payload so a retry sends the link already sent instead of revoking it with a new key
+		confirmationPayload := payload
+		confirmationPayload.Key = key
+		err := outbox.Enqueue(tx, JobSendEmailChangeConfirmation, confirmationPayload)
+		if err != nil {
+			return err
+		}
+
+		return outbox.Enqueue(tx, JobSendEmailChangeNotice, payload)
+	})
+}
+
+// sendEmailChangeConfirmation sends the confirmation key to the new email
+func (r *Repository) sendEmailChangeConfirmation(userDB *User, payload EmailChangeJobPayload) error {
+	if userDB.Email != payload.OldEmail {
+		// the email changed since the request
+		return nil
+	}
+
+	err := r.sendMail(userDB, payload.NewEmail, mailtemplate.TemplateEmailChangeConfirm, mailtemplate.EmailChangeConfirmData{
+		PreferredName: userDB.PreferredName,
+		URL:           os.Getenv("CONFIRM_EMAIL_CHANGE_URL") + payload.Key,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to send email change confirmation email")
+	}
+
+	return nil
+}
+
+// sendEmailChangeNotice tells the current email that a change was requested, it's sent even if the change was
+// confirmed in the meantime
+func (r *Repository) sendEmailChangeNotice(userDB *User, payload EmailChangeJobPayload) error {
+	err := r.sendMail(userDB, payload.OldEmail, mailtemplate.TemplateEmailChangeNotice, mailtemplate.EmailChangeNoticeData{
+		PreferredName: userDB.PreferredName,
+		NewEmail:      payload.NewEmail,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to send email change notification email")
+	}
+
+	return nil
+}
+
+// ConfirmEmailChange consumes the key, swaps the email and enqueues the update of the Omnisend contact. It fails if the
+// email was changed since the request or the new email was registered in the meantime.
+func (r *Repository) ConfirmEmailChange(key string) (*EmailChangeHash, error) {
+	rawHash, err := r.Keys.Get(emailChangeRedisKey(key))
+	if errors.Is(err, onetimekey.ErrNotFound) {
+		return nil, ErrEmailChangeKeyNoLongerValid
+	} else if err != nil {
+		return nil, errors.Wrap(err, "failed to get email change from Redis")
+	}
+
+	hash := EmailChangeHash{}
+	err = json.Unmarshal([]byte(rawHash), &hash)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode email change")
+	}
+
+	_, err = r.Keys.Consume(emailChangeRedisKey(key), emailChangeUserIDSetKey(hash.UserID))
+	if errors.Is(err, onetimekey.ErrNotFound) {
+		return nil, ErrEmailChangeKeyNoLongerValid
+	} else if err != nil {
+		return nil, errors.Wrap(err, "failed to revoke email change key")
+	}
+
+	err = r.DB.Transaction(func(tx *gorm.DB) error {
+		var count int64
+		err := tx.Model(&User{}).Where(DBNamesUser.Email, hash.NewEmail).Count(&count).Error
+		if err != nil {
+			return errors.Wrap(err, "failed to check if email exists")
+		} else if count > 0 {
+			return ErrEmailAlreadyRegistered
+		}
+
+		// the unique index on email still rejects a registration that races this update
+		result := tx.Model(&User{}).
+			Where(DBNamesUser.ID, hash.UserID).
+			Where(DBNamesUser.Email, hash.OldEmail).
+			Update(DBNamesUser.Email, hash.NewEmail)
+		if result.Error != nil {
+			return errors.Wrap(result.Error, "failed to update email of user with id "+strconv.Itoa(hash.UserID))
+		} else if result.RowsAffected == 0 {
+			return ErrEmailChangeKeyNoLongerValid
+		}
+
+		return outbox.EnqueueOnce(tx, JobMoveOmnisendContact, JobMoveOmnisendContact+":"+onetimekey.Hash(key), EmailChangeJobPayload{
+			UserID:   hash.UserID,
+			OldEmail: hash.OldEmail,
+			NewEmail: hash.NewEmail,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &hash, nil
+}
@@ -0,0 +1,141 @@
+package user
+
+import (
+	"github.com/stretchr/testify/suite"
+	"regexp"
+	"server/internal/outbox"
+	"testing"
+	"time"
+)
+
+const confirmEmailChangeURL = "https://example.com/confirm-email-change?key="
+
+var emailChangeKeyPattern = regexp.MustCompile(regexp.QuoteMeta(confirmEmailChangeURL) + `([A-Za-z0-9_-]{43})`)
+
+type EmailChangeSuite struct {
+	repositorySuite
+	userDB *User
+}
+
+func (s *EmailChangeSuite) SetupTest() {
+	s.repositorySuite.SetupTest()
+	s.T().Setenv("CONFIRM_EMAIL_CHANGE_URL", confirmEmailChangeURL)
+	s.userDB = s.createUserWithHash(s.repository.PasswordHasher, testPassword)
+}
+
+// requestEmailChange requests the change, sends the emails and returns the key sent to the new email
+func (s *EmailChangeSuite) requestEmailChange(newEmail string) string {
+	err := s.repository.RequestEmailChange(s.userDB, Reauthentication{Password: testPassword}, newEmail)
+	s.Require().NoError(err)
+	s.Require().Equal(2, s.processJobs(), "confirmation and notice")
+
+	messages := s.mail.SentTo(newEmail)
+	s.Require().NotEmpty(messages)
+	match := emailChangeKeyPattern.FindStringSubmatch(messages[len(messages)-1].Text)
+	s.Require().NotNil(match)
+	return match[1]
+}
+
+func (s *EmailChangeSuite) email() string {
+	var userDB User
+	s.Require().NoError(s.repository.DB.First(&userDB, s.userDB.ID).Error)
+	return userDB.Email
+}
+
+func (s *EmailChangeSuite) TestConfirmEmailChange() {
+	key := s.requestEmailChange("grace@example.com")
+	s.Assert().Len(s.mail.SentTo("ada@example.com"), 1, "notice to the current email")
+	s.Assert().Equal("ada@example.com", s.email(), "changed before the confirmation")
+
+	emailChange, err := s.repository.ConfirmEmailChange(key)
+	s.Require().NoError(err)
+	s.Assert().Equal(EmailChangeHash{UserID: s.userDB.ID, OldEmail: "ada@example.com", NewEmail: "grace@example.com"}, *emailChange)
+	s.Assert().Equal("grace@example.com", s.email())
+
+	var count int64
+	s.Require().NoError(s.repository.DB.Model(&outbox.Job{}).Where(outbox.DBNamesJob.Type, JobMoveOmnisendContact).Count(&count).Error)
+	s.Assert().Equal(int64(1), count)
+}
+
+func (s *EmailChangeSuite) TestConfirmEmailChange_KeyIsSingleUse() {
+	key := s.requestEmailChange("grace@example.com")
+
+	_, err := s.repository.ConfirmEmailChange(key)
+	s.Require().NoError(err)
+
+	_, err = s.repository.ConfirmEmailChange(key)
+	s.Assert().ErrorIs(err, ErrEmailChangeKeyNoLongerValid)
+	s.Assert().Empty(s.redis.Keys())
+}
+
+func (s *EmailChangeSuite) TestConfirmEmailChange_KeyExpires() {
+	key := s.requestEmailChange("grace@example.com")
+
+	s.redis.FastForward(s.repository.TTLs.EmailChangeKey + time.Second)
+
+	_, err := s.repository.ConfirmEmailChange(key)
+	s.Assert().ErrorIs(err, ErrEmailChangeKeyNoLongerValid)
+	s.Assert().Equal("ada@example.com", s.email())
+}
+
+func (s *EmailChangeSuite) TestRequestEmailChange_RetrySendsSameKey() {
+	key := s.requestEmailChange("grace@example.com")
+
+	// jobs run at least once, the confirmation can be sent again after it succeeded
+	s.Require().NoError(s.repository.DB.Model(&outbox.Job{}).
+		Where(outbox.DBNamesJob.Type, JobSendEmailChangeConfirmation).
+		Updates(map[string]interface{}{
+			outbox.DBNamesJob.Status:          outbox.StatusPending,
+			outbox.DBNamesJob.NextAttemptTime: time.Now(),
+		}).Error)
+	s.Require().Equal(1, s.processJobs())
+
+	messages := s.mail.SentTo("grace@example.com")
+	s.Require().Len(messages, 2)
+	s.Assert().Equal(key, emailChangeKeyPattern.FindStringSubmatch(messages[1].Text)[1])
+
+	_, err := s.repository.ConfirmEmailChange(key)
+	s.Require().NoError(err)
+	s.Assert().Equal("grace@example.com", s.email())
+}
+
+func (s *EmailChangeSuite) TestRequestEmailChange_RevokesPreviousKey() {
+	previousKey := s.requestEmailChange("grace@example.com")
+	key := s.requestEmailChange("hopper@example.com")
+
+	_, err := s.repository.ConfirmEmailChange(previousKey)
+	s.Assert().ErrorIs(err, ErrEmailChangeKeyNoLongerValid)
+
+	_, err = s.repository.ConfirmEmailChange(key)
+	s.Require().NoError(err)
+	s.Assert().Equal("hopper@example.com", s.email())
+}
+
+func (s *EmailChangeSuite) TestConfirmEmailChange_EmailRegisteredSince() {
+	key := s.requestEmailChange("grace@example.com")
+	s.createUser("grace@example.com")
+
+	_, err := s.repository.ConfirmEmailChange(key)
+	s.Assert().ErrorIs(err, ErrEmailAlreadyRegistered)
+	s.Assert().Equal("ada@example.com", s.email())
+
+	// the key was revoked with the failed attempt
+	_, err = s.repository.ConfirmEmailChange(key)
+	s.Assert().ErrorIs(err, ErrEmailChangeKeyNoLongerValid)
+}
+
+func (s *EmailChangeSuite) TestRequestEmailChange_Rejected() {
+	err := s.repository.RequestEmailChange(s.userDB, Reauthentication{Password: "wrong password"}, "grace@example.com")
+	s.Assert().ErrorIs(err, ErrInvalidCredentials)
+
+	s.createUser("grace@example.com")
+	err = s.repository.RequestEmailChange(s.userDB, Reauthentication{Password: testPassword}, "grace@example.com")
+	s.Assert().ErrorIs(err, ErrEmailAlreadyRegistered)
+
+	s.Assert().Zero(s.processJobs())
+	s.Assert().Empty(s.mail.Messages())
+}
+
+func TestEmailChangeSuite(t *testing.T) {
+	suite.Run(t, new(EmailChangeSuite))
+}
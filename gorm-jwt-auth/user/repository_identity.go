@@ -0,0 +1,272 @@
+package user
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"github.com/pkg/errors"
+	"golang.org/x/oauth2"
+	"gorm.io/gorm"
+	"server/internal/oidclogin"
+	"server/internal/onetimekey"
+	"strings"
+	"time"
+)
+
+// SocialLoginHash is the state of an authorization code flow, UserID is set when it re-authenticates a logged user
+// instead of logging in
+type SocialLoginHash struct {
+	Provider     string
+	Nonce        string
+	CodeVerifier string
+	UserID       int
+}
+
+// SocialLoginStart is the URL of the provider the user must be redirected to, and the hash of the state of the flow the
+// browser that started it holds until Expiry, so the flow can't be finished by another browser
+type SocialLoginStart struct {
+	AuthCodeURL string
+	StateHash   string
+	Expiry      time.Time
+}
+
+func (r *Repository) Identities(userID int) ([]*Identity, error) {
+	var identitiesDB []*Identity
+	err := r.DB.Where(DBNamesIdentity.UserID, userID).Find(&identitiesDB).Error
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get identities from DB")
+	}
+
+	return identitiesDB, nil
+}
+
+// SocialLoginInitialize starts the authorization code flow
+func (r *Repository) SocialLoginInitialize(providerName string) (*SocialLoginStart, error) {
+	authCodeURL, state, err := r.startSocialLogin(providerName, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SocialLoginStart{
+		AuthCodeURL: authCodeURL,
+		StateHash:   onetimekey.Hash(state),
+		Expiry:      time.Now().Add(socialLoginKeyTTL),
+	}, nil
+}
+
+// SocialLoginEnd finishes the authorization code flow and returns the user linked to the external identity, stateHash
+// is the one held by the browser since SocialLoginInitialize. Unknown identities are linked to the account with the
+// same confirmed email, or to a new account when there is none.
+func (r *Repository) SocialLoginEnd(ctx context.Context, state string, stateHash string, code string) (*User, error) {
+	// checked before consuming the state, a callback URL sent to another browser can't log it into the account that
+	// signed in at the provider
+	if subtle.ConstantTimeCompare([]byte(onetimekey.Hash(state)), []byte(stateHash)) != 1 {
+		return nil, errors.New("social login was started by another browser")
+	}
+
+	hash, provider, claims, err := r.endSocialLogin(ctx, state, code)
+	if err != nil {
+		return nil, err
+	} else if hash.UserID != 0 {
+		return nil, errors.New("social login state was issued for a re-authentication")
+	}
+
+	// already linked identity
+	var identityDB Identity
+	err = r.DB.Preload(DBNamesIdentity.User).
+		Where(DBNamesIdentity.Provider, provider.Name()).
+		Where(DBNamesIdentity.Subject, claims.Subject).
+		First(&identityDB).Error
+	if err == nil {
+		return &identityDB.User, nil
+	} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errors.Wrap(err, "failed to get identity from DB")
+	}
+
+	if claims.Email == "" || !claims.EmailVerified {
+		return nil, errors.New("the " + provider.Name() + " account has no verified email")
+	}
+	email := strings.ToLower(claims.Email)
+
+	var userDB *User
+	err = r.DB.Transaction(func(tx *gorm.DB) error {
+		// emails are stored as they were typed at registration
+		var existingUserDB User
+		err := tx.Where("LOWER("+DBNamesUser.Email+") = ?", email).First(&existingUserDB).Error
+		if err == nil {
+			if existingUserDB.RegisterTime == nil {
+				return errors.New("confirm your email before logging in with " + provider.Name())
+			}
+			userDB = &existingUserDB
+		} else if errors.Is(err, gorm.ErrRecordNotFound) {
+			userDB, err = createSocialUser(tx, email, claims)
+			if err != nil {
+				return err
+			}
+		} else {
+			return errors.Wrap(err, "failed to get user from DB")
+		}
+
+		err = tx.Create(&Identity{
+			UserID:       userDB.ID,
+			Provider:     provider.Name(),
+			Subject:      claims.Subject,
+			Email:        email,
+			CreationTime: time.Now(),
+		}).Error
+		if err != nil {
+			return errors.Wrap(err, "failed to link identity to user")
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return userDB, nil
+}
+
+// SocialReauthenticateInitialize starts an authorization code flow with a provider linked to the user, users without a
+// password re-authenticate this way before sensitive changes
+func (r *Repository) SocialReauthenticateInitialize(userDB *User, providerName string) (*string, error) {
+	authCodeURL, _, err := r.startSocialLogin(providerName, userDB.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &authCodeURL, nil
+}
+
+// SocialReauthenticateEnd finishes the authorization code flow started by SocialReauthenticateInitialize, the external
+// identity must be linked to the user. It returns a single use key accepted instead of the password by Reauthenticate.
+func (r *Repository) SocialReauthenticateEnd(ctx context.Context, userDB *User, state string, code string) (*string, error) {
+	hash, provider, claims, err := r.endSocialLogin(ctx, state, code)
+	if err != nil {
+		return nil, err
+	} else if hash.UserID != userDB.ID {
+		return nil, errors.New("social login state was issued for another user")
+	}
+
+	var count int64
+	err = r.DB.Model(&Identity{}).
+		Where(DBNamesIdentity.UserID, userDB.ID).
+		Where(DBNamesIdentity.Provider, provider.Name()).
+		Where(DBNamesIdentity.Subject, claims.Subject).
+		Count(&count).Error
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get identity from DB")
+	} else if count == 0 {
+		return nil, errors.New("the " + provider.Name() + " account isn't linked to this user")
+	}
+
+	return r.GenerateReauthenticationKey(userDB.ID)
+}
+
+func (r *Repository) UnlinkIdentity(userID int, providerName string) error {
+	result := r.DB.
+		Where(DBNamesIdentity.UserID, userID).
+		Where(DBNamesIdentity.Provider, strings.ToLower(providerName)).
+		Delete(&Identity{})
+	if result.Error != nil {
+		return errors.Wrap(result.Error, "failed to unlink identity")
+	} else if result.RowsAffected == 0 {
+		return errors.New("no " + providerName + " account is linked")
+	}
+
+	return nil
+}
+
+// startSocialLogin saves the state of the flow, nonce and PKCE code verifier under a random state and returns the URL
+// of the provider and the state
+func (r *Repository) startSocialLogin(providerName string, userID int) (string, string, error) {
+	provider, err := r.LoginProviders.Get(providerName)
+	if err != nil {
+		return "", "", err
+	}
+
+	state, err := onetimekey.NewToken()
+	if err != nil {
+		return "", "", err
+	}
+	nonce, err := onetimekey.NewToken()
+	if err != nil {
+		return "", "", err
+	}
+	codeVerifier := oauth2.GenerateVerifier()
+
+	hashBytes, err := json.Marshal(SocialLoginHash{
+		Provider:     provider.Name(),
+		Nonce:        nonce,
+		CodeVerifier: codeVerifier,
+		UserID:       userID,
+	})
+	if err != nil {
+		return "", "", errors.Wrap(err, "failed to encode social login state")
+	}
+
+	err = r.Keys.Save(socialLoginRedisKey(state), string(hashBytes), "", socialLoginKeyTTL, false)
+	if err != nil {
+		return "", "", errors.Wrap(err, "failed to save social login state")
+	}
+
+	return provider.AuthCodeURL(state, nonce, codeVerifier), state, nil
+}
+
+// endSocialLogin consumes the state, so it can't be replayed, and trades the code for the claims of the user
+func (r *Repository) endSocialLogin(ctx context.Context, state string, code string) (*SocialLoginHash, oidclogin.Provider, *oidclogin.Claims, error) {
+	rawHash, err := r.Keys.Consume(socialLoginRedisKey(state), "")
+	if errors.Is(err, onetimekey.ErrNotFound) {
+		return nil, nil, nil, errors.New("social login state is no longer valid")
+	} else if err != nil {
+		return nil, nil, nil, errors.Wrap(err, "failed to consume social login state")
+	}
+
+	hash := SocialLoginHash{}
+	err = json.Unmarshal([]byte(rawHash), &hash)
+	if err != nil {
+		return nil, nil, nil, errors.Wrap(err, "failed to decode social login state")
+	}
+
+	provider, err := r.LoginProviders.Get(hash.Provider)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	claims, err := provider.Exchange(ctx, code, hash.Nonce, hash.CodeVerifier)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	return &hash, provider, claims, nil
+}
+
+// createSocialUser registers a user whose email was verified by the login provider, it has no password until one is
+// set through forgotPassword
+func createSocialUser(tx *gorm.DB, email string, claims *oidclogin.Claims) (*User, error) {
+	registerTime := time.Now()
+	preferredName := claims.GivenName
+	if preferredName == "" {
+		preferredName = claims.Name
+	}
+
+	userDB := &User{
+		FirstName:     claims.GivenName,
+		LastName:      claims.FamilyName,
+		PreferredName: preferredName,
+		Email:         email,
+		RegisterTime:  &registerTime,
+		Roles: []*Role{
+			{
+				ID: RoleUserID,
+			},
+		},
+	}
+
+	err := tx.Create(&userDB).Error
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create user")
+	}
+
+	return userDB, nil
+}
@@ -0,0 +1,321 @@
+package user
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"github.com/stretchr/testify/suite"
+	"golang.org/x/oauth2"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"server/internal/oidclogin"
+	"server/internal/onetimekey"
+	"sync"
+	"testing"
+	"time"
+)
+
+const (
+	mockProviderName     = "mock"
+	mockProviderClientID = "jevels"
+)
+
+// mockOIDCProvider serves the discovery document, the JWKS and the token endpoint of an OpenID Connect provider. The
+// authorization endpoint isn't served, authorize stands for the user logging in and returns the code it redirects
+// back with.
+type mockOIDCProvider struct {
+	server *httptest.Server
+	key    *rsa.PrivateKey
+
+	mutex          sync.Mutex
+	authorizations map[string]mockAuthorization
+}
+
+type mockAuthorization struct {
+	codeChallenge string
+	claims        map[string]interface{}
+}
+
+func newMockOIDCProvider(s *suite.Suite) *mockOIDCProvider {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	s.Require().NoError(err)
+
+	provider := &mockOIDCProvider{key: key, authorizations: map[string]mockAuthorization{}}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", provider.discovery)
+	mux.HandleFunc("/jwks", provider.jwks)
+	mux.HandleFunc("/token", provider.token)
+	provider.server = httptest.NewServer(mux)
+	s.T().Cleanup(provider.server.Close)
+
+	return provider
+}
+
+func (p *mockOIDCProvider) discovery(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, map[string]interface{}{
+		"issuer":                                p.server.URL,
+		"authorization_endpoint":                p.server.URL + "/authorize",
+		"token_endpoint":                        p.server.URL + "/token",
+		"jwks_uri":                              p.server.URL + "/jwks",
+		"id_token_signing_alg_values_supported": []string{"RS256"},
+	})
+}
+
+func (p *mockOIDCProvider) jwks(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, map[string]interface{}{
+		"keys": []map[string]interface{}{{
+			"kty": "RSA",
+			"kid": "1",
+			"alg": "RS256",
+			"use": "sig",
+			"n":   base64.RawURLEncoding.EncodeToString(p.key.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(p.key.E)).Bytes()),
+		}},
+	})
+}
+
+// token trades a code for an ID token once, after checking the PKCE code verifier
+func (p *mockOIDCProvider) token(w http.ResponseWriter, r *http.Request) {
+	p.mutex.Lock()
+	authorization, ok := p.authorizations[r.PostFormValue("code")]
+	delete(p.authorizations, r.PostFormValue("code"))
+	p.mutex.Unlock()
+
+	challenge := sha256.Sum256([]byte(r.PostFormValue("code_verifier")))
+	if !ok || base64.RawURLEncoding.EncodeToString(challenge[:]) != authorization.codeChallenge {
+		w.WriteHeader(http.StatusBadRequest)
+		writeJSON(w, map[string]string{"error": "invalid_grant"})
+		return
+	}
+
+	writeJSON(w, map[string]interface{}{
+		"access_token": "access",
+		"token_type":   "Bearer",
+		"expires_in":   3600,
+		"id_token":     p.sign(authorization.claims),
+	})
+}
+
+func (p *mockOIDCProvider) sign(claims map[string]interface{}) string {
+	header, _ := json.Marshal(map[string]string{"alg": "RS256", "kid": "1", "typ": "JWT"})
+	payload, _ := json.Marshal(claims)
+	signingInput := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+
+	digest := sha256.Sum256([]byte(signingInput))
+	signature, _ := rsa.SignPKCS1v15(rand.Reader, p.key, crypto.SHA256, digest[:])
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+// authorize logs the subject in on the URL returned by SocialLoginInitialize and returns the state and code the
+// provider redirects back with
+func (p *mockOIDCProvider) authorize(s *suite.Suite, authCodeURL string, subject string, email string) (string, string) {
+	parsedURL, err := url.Parse(authCodeURL)
+	s.Require().NoError(err)
+	query := parsedURL.Query()
+	s.Require().Equal(mockProviderClientID, query.Get("client_id"))
+	s.Require().Equal("S256", query.Get("code_challenge_method"))
+
+	code := subject + ":" + query.Get("state")
+	p.mutex.Lock()
+	p.authorizations[code] = mockAuthorization{
+		codeChallenge: query.Get("code_challenge"),
+		claims: map[string]interface{}{
+			"iss":            p.server.URL,
+			"aud":            mockProviderClientID,
+			"sub":            subject,
+			"iat":            time.Now().Unix(),
+			"exp":            time.Now().Add(time.Hour).Unix(),
+			"nonce":          query.Get("nonce"),
+			"email":          email,
+			"email_verified": true,
+			"given_name":     "Ada",
+			"family_name":    "Lovelace",
+		},
+	}
+	p.mutex.Unlock()
+
+	return query.Get("state"), code
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+type IdentitySuite struct {
+	repositorySuite
+	provider *mockOIDCProvider
+}
+
+func (s *IdentitySuite) SetupTest() {
+	s.repositorySuite.SetupTest()
+
+	s.provider = newMockOIDCProvider(&s.Suite)
+	discoveryProvider, err := oidclogin.NewDiscoveryProvider(context.Background(), mockProviderName, s.provider.server.URL, oauth2.Config{
+		ClientID:     mockProviderClientID,
+		ClientSecret: "secret",
+		RedirectURL:  "https://jevels.com/login/social",
+	})
+	s.Require().NoError(err)
+	s.repository.LoginProviders = oidclogin.Providers{mockProviderName: discoveryProvider}
+}
+
+func (s *IdentitySuite) socialLogin(subject string, email string) (*User, error) {
+	start, err := s.repository.SocialLoginInitialize(mockProviderName)
+	s.Require().NoError(err)
+
+	state, code := s.provider.authorize(&s.Suite, start.AuthCodeURL, subject, email)
+	return s.repository.SocialLoginEnd(context.Background(), state, start.StateHash, code)
+}
+
+func (s *IdentitySuite) TestSocialLogin_CreatesAndLinksUser() {
+	userDB, err := s.socialLogin("subject-1", "Ada@Example.com")
+	s.Require().NoError(err)
+	s.Assert().Equal("ada@example.com", userDB.Email)
+	s.Assert().Equal("Ada", userDB.PreferredName)
+	s.Assert().NotNil(userDB.RegisterTime)
+
+	identitiesDB, err := s.repository.Identities(userDB.ID)
+	s.Require().NoError(err)
+	s.Require().Len(identitiesDB, 1)
+	s.Assert().Equal("subject-1", identitiesDB[0].Subject)
+
+	loginUserDB, err := s.socialLogin("subject-1", "ada@example.com")
+	s.Require().NoError(err)
+	s.Assert().Equal(userDB.ID, loginUserDB.ID)
+}
+
+func (s *IdentitySuite) TestSocialLogin_LinksConfirmedUser() {
+	userDB := s.createUser("ada@example.com")
+	s.Require().NoError(s.repository.ConfirmUser(userDB.ID))
+
+	loginUserDB, err := s.socialLogin("subject-1", "ada@example.com")
+	s.Require().NoError(err)
+	s.Assert().Equal(userDB.ID, loginUserDB.ID)
+}
+
+func (s *IdentitySuite) TestSocialLogin_LinksConfirmedUserOfAnotherCase() {
+	userDB := s.createUser("Ada@Example.com")
+	s.Require().NoError(s.repository.ConfirmUser(userDB.ID))
+
+	loginUserDB, err := s.socialLogin("subject-1", "ada@EXAMPLE.com")
+	s.Require().NoError(err)
+	s.Assert().Equal(userDB.ID, loginUserDB.ID)
+	s.Assert().Equal("Ada@Example.com", loginUserDB.Email)
+
+	var count int64
+	s.Require().NoError(s.repository.DB.Model(&User{}).Count(&count).Error)
+	s.Assert().Equal(int64(1), count)
+}
+
+func (s *IdentitySuite) TestSocialLogin_StateIsSingleUse() {
+	start, err := s.repository.SocialLoginInitialize(mockProviderName)
+	s.Require().NoError(err)
+	state, code := s.provider.authorize(&s.Suite, start.AuthCodeURL, "subject-1", "ada@example.com")
+
+	_, err = s.repository.SocialLoginEnd(context.Background(), state, start.StateHash, code)
+	s.Require().NoError(err)
+
+	_, err = s.repository.SocialLoginEnd(context.Background(), state, start.StateHash, code)
+	s.Assert().EqualError(err, "social login state is no longer valid")
+}
+
+func (s *IdentitySuite) TestSocialLogin_StateExpires() {
+	start, err := s.repository.SocialLoginInitialize(mockProviderName)
+	s.Require().NoError(err)
+	s.Assert().WithinDuration(time.Now().Add(socialLoginKeyTTL), start.Expiry, time.Minute)
+	state, code := s.provider.authorize(&s.Suite, start.AuthCodeURL, "subject-1", "ada@example.com")
+	s.redis.FastForward(socialLoginKeyTTL + time.Second)
+
+	_, err = s.repository.SocialLoginEnd(context.Background(), state, start.StateHash, code)
+	s.Assert().EqualError(err, "social login state is no longer valid")
+}
+
+func (s *IdentitySuite) TestSocialLogin_StartedByAnotherBrowser() {
+	// the attacker starts the flow and signs in at the provider with their account
+	attackerStart, err := s.repository.SocialLoginInitialize(mockProviderName)
+	s.Require().NoError(err)
+	state, code := s.provider.authorize(&s.Suite, attackerStart.AuthCodeURL, "attacker", "mallory@example.com")
+
+	// the victim opens the callback URL, holding the cookie of their own flow or none
+	victimStart, err := s.repository.SocialLoginInitialize(mockProviderName)
+	s.Require().NoError(err)
+	for _, stateHash := range []string{victimStart.StateHash, ""} {
+		_, err = s.repository.SocialLoginEnd(context.Background(), state, stateHash, code)
+		s.Assert().EqualError(err, "social login was started by another browser")
+	}
+
+	// the state wasn't consumed by the rejected attempts
+	_, err = s.repository.SocialLoginEnd(context.Background(), state, attackerStart.StateHash, code)
+	s.Assert().NoError(err)
+}
+
+func (s *IdentitySuite) TestSocialReauthenticate() {
+	userDB, err := s.socialLogin("subject-1", "ada@example.com")
+	s.Require().NoError(err)
+
+	// social users have no password
+	s.Assert().ErrorIs(s.repository.Reauthenticate(userDB, Reauthentication{}), ErrInvalidCredentials)
+
+	authCodeURL, err := s.repository.SocialReauthenticateInitialize(userDB, mockProviderName)
+	s.Require().NoError(err)
+	state, code := s.provider.authorize(&s.Suite, *authCodeURL, "subject-1", "ada@example.com")
+
+	key, err := s.repository.SocialReauthenticateEnd(context.Background(), userDB, state, code)
+	s.Require().NoError(err)
+
+	s.Assert().NoError(s.repository.Reauthenticate(userDB, Reauthentication{Key: *key}))
+	s.Assert().ErrorIs(s.repository.Reauthenticate(userDB, Reauthentication{Key: *key}), ErrInvalidCredentials)
+}
+
+func (s *IdentitySuite) TestReauthenticationKey_OfAnotherUser() {
+	userDB := s.createUser("ada@example.com")
+	otherUserDB := s.createUser("grace@example.com")
+
+	key, err := s.repository.GenerateReauthenticationKey(userDB.ID)
+	s.Require().NoError(err)
+	s.Assert().ErrorIs(s.repository.Reauthenticate(otherUserDB, Reauthentication{Key: *key}), ErrInvalidCredentials)
+}
+
+func (s *IdentitySuite) TestSocialReauthenticate_RequiresLinkedIdentity() {
+	userDB, err := s.socialLogin("subject-1", "ada@example.com")
+	s.Require().NoError(err)
+	_, err = s.socialLogin("subject-2", "grace@example.com")
+	s.Require().NoError(err)
+
+	authCodeURL, err := s.repository.SocialReauthenticateInitialize(userDB, mockProviderName)
+	s.Require().NoError(err)
+	state, code := s.provider.authorize(&s.Suite, *authCodeURL, "subject-2", "grace@example.com")
+
+	_, err = s.repository.SocialReauthenticateEnd(context.Background(), userDB, state, code)
+	s.Assert().EqualError(err, "the mock account isn't linked to this user")
+}
+
+func (s *IdentitySuite) TestSocialReauthenticate_StateOfAnotherFlow() {
+	userDB, err := s.socialLogin("subject-1", "ada@example.com")
+	s.Require().NoError(err)
+
+	// a login state can't re-authenticate
+	start, err := s.repository.SocialLoginInitialize(mockProviderName)
+	s.Require().NoError(err)
+	state, code := s.provider.authorize(&s.Suite, start.AuthCodeURL, "subject-1", "ada@example.com")
+	_, err = s.repository.SocialReauthenticateEnd(context.Background(), userDB, state, code)
+	s.Assert().EqualError(err, "social login state was issued for another user")
+
+	// and a re-authentication state can't log in
+	authCodeURL, err := s.repository.SocialReauthenticateInitialize(userDB, mockProviderName)
+	s.Require().NoError(err)
+	state, code = s.provider.authorize(&s.Suite, *authCodeURL, "subject-1", "ada@example.com")
+	_, err = s.repository.SocialLoginEnd(context.Background(), state, onetimekey.Hash(state), code)
+	s.Assert().EqualError(err, "social login state was issued for a re-authentication")
+}
+
+func TestIdentitySuite(t *testing.T) {
+	suite.Run(t, new(IdentitySuite))
+}
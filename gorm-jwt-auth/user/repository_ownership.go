@@ -0,0 +1,62 @@
+package user
+
+import (
+	"github.com/pkg/errors"
+	"server/internal/thegraph"
+	"strconv"
+)
+
+// OwnsNft tells if the user holds at least one copy of the NFT, off-chain or on-chain with one of their addresses.
+// The off-chain copies are checked first, they don't need a call to the subgraph.
+func (r *Repository) OwnsNft(userID int, nftID int) (bool, error) {
+	offchainNfts, err := r.OffchainNfts(OffchainNftsFilter{UserID: userID, NftId: nftID})
+	if err != nil {
+		return false, errors.Wrap(err, "failed to get offchain nfts of user "+strconv.Itoa(userID))
+	}
+	for _, offchainNft := range offchainNfts {
+		if offchainNft.Amount > 0 {
+			return true, nil
+		}
+	}
+
+	userHasAddressesDB, err := r.Addresses(userID)
+	if err != nil {
+		return false, err
+	}
+	for _, userHasAddressDB := range userHasAddressesDB {
+		addressHasNftsDB, err := r.AddressNfts(AddressNftsFilter{Address: userHasAddressDB.AddressID, NftID: nftID})
+		if err != nil {
+			return false, errors.Wrap(err, "failed to get nfts of address "+userHasAddressDB.AddressID)
+		} else if holdsCopy(addressHasNftsDB) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// holdsCopy tells if one of the balances is positive, the subgraph keeps the rows of addresses that sold every copy
+func holdsCopy(addressHasNftsDB []*thegraph.AddressHasNfts) bool {
+	for _, addressHasNftDB := range addressHasNftsDB {
+		if addressHasNftDB.Amount > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// OffchainNftsOfUsers returns the off-chain NFTs of every user by user ID, users without any aren't in the map
+func (r *Repository) OffchainNftsOfUsers(userIDs []int) (map[int][]*UserHasOffchainNfts, error) {
+	var offchainNftsDB []*UserHasOffchainNfts
+	err := r.DB.Where(DBNamesUserHasOffchainNfts.UserID+" IN ?", userIDs).Find(&offchainNftsDB).Error
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get offchain nfts of users from DB")
+	}
+
+	offchainNfts := map[int][]*UserHasOffchainNfts{}
+	for _, offchainNftDB := range offchainNftsDB {
+		offchainNfts[offchainNftDB.UserID] = append(offchainNfts[offchainNftDB.UserID], offchainNftDB)
+	}
+
+	return offchainNfts, nil
+}
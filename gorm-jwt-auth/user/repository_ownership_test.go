@@ -0,0 +1,24 @@
+package user
+
+import (
+	"github.com/stretchr/testify/suite"
+	"server/internal/thegraph"
+	"testing"
+)
+
+type OwnershipSuite struct {
+	suite.Suite
+}
+
+func (s *OwnershipSuite) TestHoldsCopy() {
+	s.Assert().False(holdsCopy(nil))
+	s.Assert().False(holdsCopy([]*thegraph.AddressHasNfts{{Address: "0xa", Nft: "20", Amount: 0}}))
+	s.Assert().True(holdsCopy([]*thegraph.AddressHasNfts{
+		{Address: "0xa", Nft: "20", Amount: 0},
+		{Address: "0xa", Nft: "20", Amount: 2},
+	}))
+}
+
+func TestOwnershipSuite(t *testing.T) {
+	suite.Run(t, new(OwnershipSuite))
+}
@@ -0,0 +1,290 @@
+package user
+
+import (
+	"encoding/json"
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/go-webauthn/webauthn/webauthn"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+	"os"
+	"server/internal/onetimekey"
+	"strings"
+	"time"
+)
+
+// NewWebAuthn builds the relying party used for passkey ceremonies from the WEBAUTHN_RP_ID, WEBAUTHN_RP_NAME and
+// WEBAUTHN_RP_ORIGINS (comma separated) env variables
+func NewWebAuthn() (*webauthn.WebAuthn, error) {
+	return webauthn.New(&webauthn.Config{
+		RPID:          os.Getenv("WEBAUTHN_RP_ID"),
+		RPDisplayName: os.Getenv("WEBAUTHN_RP_NAME"),
+		RPOrigins:     strings.Split(os.Getenv("WEBAUTHN_RP_ORIGINS"), ","),
+		AuthenticatorSelection: protocol.AuthenticatorSelection{
+			ResidentKey:      protocol.ResidentKeyRequirementRequired,
+			UserVerification: protocol.VerificationPreferred,
+		},
+	})
+}
+
+// passkeyUser adapts User to the webauthn.User interface
+type passkeyUser struct {
+	*User
+	passkeys []*Passkey
+}
+
+func (u *passkeyUser) WebAuthnID() []byte {
+	return passkeyUserHandle(u.ID)
+}
+
+func (u *passkeyUser) WebAuthnName() string {
+	return u.Email
+}
+
+func (u *passkeyUser) WebAuthnDisplayName() string {
+	return u.PreferredName
+}
+
+func (u *passkeyUser) WebAuthnIcon() string {
+	return ""
+}
+
+func (u *passkeyUser) WebAuthnCredentials() []webauthn.Credential {
+	credentials := make([]webauthn.Credential, len(u.passkeys))
+	for i, passkey := range u.passkeys {
+		credentials[i] = passkey.toCredential()
+	}
+	return credentials
+}
+
+func (p *Passkey) toCredential() webauthn.Credential {
+	var transports []protocol.AuthenticatorTransport
+	if p.Transports != "" {
+		for _, transport := range strings.Split(p.Transports, ",") {
+			transports = append(transports, protocol.AuthenticatorTransport(transport))
+		}
+	}
+
+	return webauthn.Credential{
+		ID:              p.ID,
+		PublicKey:       p.PublicKey,
+		AttestationType: p.AttestationType,
+		Transport:       transports,
+		Flags: webauthn.CredentialFlags{
+			BackupEligible: p.BackupEligible,
+			BackupState:    p.BackupState,
+		},
+		Authenticator: webauthn.Authenticator{
+			AAGUID:       p.AAGUID,
+			SignCount:    p.SignCount,
+			CloneWarning: p.CloneWarning,
+		},
+	}
+}
+
+func (r *Repository) Passkeys(userID int) ([]*Passkey, error) {
+	var passkeysDB []*Passkey
+	err := r.DB.Where(DBNamesPasskey.UserID, userID).Find(&passkeysDB).Error
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get passkeys from DB")
+	}
+
+	return passkeysDB, nil
+}
+
+func (r *Repository) passkeyUser(userDB *User) (*passkeyUser, error) {
+	passkeysDB, err := r.Passkeys(userDB.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &passkeyUser{User: userDB, passkeys: passkeysDB}, nil
+}
+
+// RegisterPasskeyInitialize starts the registration ceremony, the returned options must be passed to
+// navigator.credentials.create
+func (r *Repository) RegisterPasskeyInitialize(userDB *User) (*protocol.CredentialCreation, error) {
+	user, err := r.passkeyUser(userDB)
+	if err != nil {
+		return nil, err
+	}
+
+	var exclusions []protocol.CredentialDescriptor
+	for _, credential := range user.WebAuthnCredentials() {
+		exclusions = append(exclusions, credential.Descriptor())
+	}
+
+	options, session, err := r.WebAuthn.BeginRegistration(user, webauthn.WithExclusions(exclusions))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to begin passkey registration")
+	}
+
+	err = r.savePasskeySession(registerPasskeyRedisKey(userDB.ID), session, registerPasskeyKeyTTL)
+	if err != nil {
+		return nil, err
+	}
+
+	return options, nil
+}
+
+// RegisterPasskeyEnd validates the attestation returned by the authenticator and stores the new credential
+func (r *Repository) RegisterPasskeyEnd(userDB *User, credential string) (*Passkey, error) {
+	session, err := r.consumePasskeySession(registerPasskeyRedisKey(userDB.ID))
+	if err != nil {
+		return nil, err
+	}
+
+	parsedCredential, err := protocol.ParseCredentialCreationResponseBody(strings.NewReader(credential))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse passkey credential")
+	}
+
+	user, err := r.passkeyUser(userDB)
+	if err != nil {
+		return nil, err
+	}
+
+	credentialCreated, err := r.WebAuthn.CreateCredential(user, *session, parsedCredential)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid passkey credential")
+	}
+
+	var transports []string
+	for _, transport := range credentialCreated.Transport {
+		transports = append(transports, string(transport))
+	}
+
+	passkeyDB := &Passkey{
+		ID:              credentialCreated.ID,
+		UserID:          userDB.ID,
+		PublicKey:       credentialCreated.PublicKey,
+		AttestationType: credentialCreated.AttestationType,
+		Transports:      strings.Join(transports, ","),
+		AAGUID:          credentialCreated.Authenticator.AAGUID,
+		SignCount:       credentialCreated.Authenticator.SignCount,
+		BackupEligible:  credentialCreated.Flags.BackupEligible,
+		BackupState:     credentialCreated.Flags.BackupState,
+		CreationTime:    time.Now(),
+	}
+	err = r.DB.Create(passkeyDB).Error
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to save passkey to DB")
+	}
+
+	return passkeyDB, nil
+}
+
+// LoginPasskeyInitialize starts a discoverable login ceremony, the returned options must be passed to
+// navigator.credentials.get
+func (r *Repository) LoginPasskeyInitialize() (*protocol.CredentialAssertion, error) {
+	options, session, err := r.WebAuthn.BeginDiscoverableLogin()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to begin passkey login")
+	}
+
+	err = r.savePasskeySession(loginPasskeyRedisKey(session.Challenge), session, loginPasskeyKeyTTL)
+	if err != nil {
+		return nil, err
+	}
+
+	return options, nil
+}
+
+// LoginPasskeyEnd validates the assertion returned by the authenticator and returns the user that owns the passkey
+func (r *Repository) LoginPasskeyEnd(credential string) (*User, error) {
+	parsedCredential, err := protocol.ParseCredentialRequestResponseBody(strings.NewReader(credential))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse passkey credential")
+	}
+
+	session, err := r.consumePasskeySession(loginPasskeyRedisKey(parsedCredential.Response.CollectedClientData.Challenge))
+	if err != nil {
+		return nil, err
+	}
+
+	var loginUser *passkeyUser
+	handler := func(rawID, userHandle []byte) (webauthn.User, error) {
+		userID, err := userIDFromPasskeyUserHandle(userHandle)
+		if err != nil {
+			return nil, errors.New("invalid user handle")
+		}
+
+		var userDB User
+		err = r.DB.First(&userDB, userID).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("user doesn't exist")
+		} else if err != nil {
+			return nil, errors.Wrap(err, "failed to get user from DB")
+		}
+
+		loginUser, err = r.passkeyUser(&userDB)
+		if err != nil {
+			return nil, err
+		}
+		return loginUser, nil
+	}
+
+	credentialValidated, err := r.WebAuthn.ValidateDiscoverableLogin(handler, *session, parsedCredential)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid passkey assertion")
+	}
+
+	now := time.Now()
+	err = r.DB.Model(&Passkey{}).Where(DBNamesPasskey.ID, credentialValidated.ID).Updates(map[string]interface{}{
+		DBNamesPasskey.SignCount:    credentialValidated.Authenticator.SignCount,
+		DBNamesPasskey.CloneWarning: credentialValidated.Authenticator.CloneWarning,
+		DBNamesPasskey.BackupState:  credentialValidated.Flags.BackupState,
+		DBNamesPasskey.LastUsedTime: now,
+	}).Error
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to update passkey")
+	}
+
+	if credentialValidated.Authenticator.CloneWarning {
+		return nil, errors.New("passkey may have been cloned")
+	}
+
+	return loginUser.User, nil
+}
+
+func (r *Repository) DeletePasskey(userID int, passkeyID []byte) error {
+	result := r.DB.Where(DBNamesPasskey.UserID, userID).Where(DBNamesPasskey.ID, passkeyID).Delete(&Passkey{})
+	if result.Error != nil {
+		return errors.Wrap(result.Error, "failed to delete passkey from DB")
+	} else if result.RowsAffected == 0 {
+		return errors.New("passkey doesn't exist")
+	}
+
+	return nil
+}
+
+func (r *Repository) savePasskeySession(key string, session *webauthn.SessionData, ttl time.Duration) error {
+	sessionBytes, err := json.Marshal(session)
+	if err != nil {
+		return errors.Wrap(err, "failed to encode passkey session")
+	}
+
+	err = r.Keys.Save(key, string(sessionBytes), "", ttl, false)
+	if err != nil {
+		return errors.Wrap(err, "failed to save passkey session")
+	}
+
+	return nil
+}
+
+// consumePasskeySession gets the ceremony session and revokes it in the same step so it can't be replayed
+func (r *Repository) consumePasskeySession(key string) (*webauthn.SessionData, error) {
+	sessionString, err := r.Keys.Consume(key, "")
+	if errors.Is(err, onetimekey.ErrNotFound) {
+		return nil, errors.New("passkey challenge is no longer valid")
+	} else if err != nil {
+		return nil, errors.Wrap(err, "failed to consume passkey session")
+	}
+
+	var session webauthn.SessionData
+	err = json.Unmarshal([]byte(sessionString), &session)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode passkey session")
+	}
+
+	return &session, nil
+}
@@ -0,0 +1,124 @@
+package user
+
+import (
+	"encoding/json"
+	"github.com/descope/virtualwebauthn"
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/go-webauthn/webauthn/webauthn"
+	"github.com/stretchr/testify/suite"
+	"testing"
+)
+
+type PasskeySuite struct {
+	repositorySuite
+	relyingParty  virtualwebauthn.RelyingParty
+	authenticator virtualwebauthn.Authenticator
+	credential    virtualwebauthn.Credential
+}
+
+func (s *PasskeySuite) SetupTest() {
+	s.repositorySuite.SetupTest()
+
+	s.relyingParty = virtualwebauthn.RelyingParty{ID: "example.com", Name: "Jevels", Origin: "https://example.com"}
+	webAuthn, err := webauthn.New(&webauthn.Config{
+		RPID:          s.relyingParty.ID,
+		RPDisplayName: s.relyingParty.Name,
+		RPOrigins:     []string{s.relyingParty.Origin},
+		AuthenticatorSelection: protocol.AuthenticatorSelection{
+			ResidentKey:      protocol.ResidentKeyRequirementRequired,
+			UserVerification: protocol.VerificationPreferred,
+		},
+	})
+	s.Require().NoError(err)
+	s.repository.WebAuthn = webAuthn
+
+	s.authenticator = virtualwebauthn.NewAuthenticator()
+	s.credential = virtualwebauthn.NewCredential(virtualwebauthn.KeyTypeEC2)
+}
+
+// register runs the registration ceremony with the software authenticator and returns the attestation
+func (s *PasskeySuite) register(userDB *User) string {
+	creation, err := s.repository.RegisterPasskeyInitialize(userDB)
+	s.Require().NoError(err)
+
+	creationJSON, err := json.Marshal(creation)
+	s.Require().NoError(err)
+	attestationOptions, err := virtualwebauthn.ParseAttestationOptions(string(creationJSON))
+	s.Require().NoError(err)
+
+	return virtualwebauthn.CreateAttestationResponse(s.relyingParty, s.authenticator, s.credential, *attestationOptions)
+}
+
+// login runs the discoverable login ceremony with the software authenticator and returns the assertion
+func (s *PasskeySuite) login() string {
+	assertion, err := s.repository.LoginPasskeyInitialize()
+	s.Require().NoError(err)
+
+	assertionJSON, err := json.Marshal(assertion)
+	s.Require().NoError(err)
+	assertionOptions, err := virtualwebauthn.ParseAssertionOptions(string(assertionJSON))
+	s.Require().NoError(err)
+
+	return virtualwebauthn.CreateAssertionResponse(s.relyingParty, s.authenticator, s.credential, *assertionOptions)
+}
+
+func (s *PasskeySuite) TestRegisterAndLogin() {
+	userDB := s.createUser("ada@example.com")
+
+	passkeyDB, err := s.repository.RegisterPasskeyEnd(userDB, s.register(userDB))
+	s.Require().NoError(err)
+	s.Assert().Equal(userDB.ID, passkeyDB.UserID)
+	s.authenticator.Options.UserHandle = passkeyUserHandle(userDB.ID)
+	s.authenticator.AddCredential(s.credential)
+
+	passkeysDB, err := s.repository.Passkeys(userDB.ID)
+	s.Require().NoError(err)
+	s.Require().Len(passkeysDB, 1)
+
+	loginUser, err := s.repository.LoginPasskeyEnd(s.login())
+	s.Require().NoError(err)
+	s.Assert().Equal(userDB.ID, loginUser.ID)
+
+	passkeysDB, err = s.repository.Passkeys(userDB.ID)
+	s.Require().NoError(err)
+	s.Assert().NotNil(passkeysDB[0].LastUsedTime)
+}
+
+func (s *PasskeySuite) TestRegister_SessionIsSingleUse() {
+	userDB := s.createUser("ada@example.com")
+	attestation := s.register(userDB)
+
+	_, err := s.repository.RegisterPasskeyEnd(userDB, attestation)
+	s.Require().NoError(err)
+
+	_, err = s.repository.RegisterPasskeyEnd(userDB, attestation)
+	s.Assert().EqualError(err, "passkey challenge is no longer valid")
+}
+
+func (s *PasskeySuite) TestLogin_SessionIsSingleUse() {
+	userDB := s.createUser("ada@example.com")
+	_, err := s.repository.RegisterPasskeyEnd(userDB, s.register(userDB))
+	s.Require().NoError(err)
+	s.authenticator.Options.UserHandle = passkeyUserHandle(userDB.ID)
+	s.authenticator.AddCredential(s.credential)
+
+	assertion := s.login()
+	_, err = s.repository.LoginPasskeyEnd(assertion)
+	s.Require().NoError(err)
+
+	_, err = s.repository.LoginPasskeyEnd(assertion)
+	s.Assert().EqualError(err, "passkey challenge is no longer valid")
+}
+
+func (s *PasskeySuite) TestLogin_UnknownCredential() {
+	userDB := s.createUser("ada@example.com")
+	s.authenticator.Options.UserHandle = passkeyUserHandle(userDB.ID)
+	s.authenticator.AddCredential(s.credential)
+
+	_, err := s.repository.LoginPasskeyEnd(s.login())
+	s.Assert().Error(err)
+}
+
+func TestPasskeySuite(t *testing.T) {
+	suite.Run(t, new(PasskeySuite))
+}
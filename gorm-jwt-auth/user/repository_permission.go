@@ -0,0 +1,178 @@
+package user
+
+import (
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"strconv"
+)
+
+var (
+	ErrRoleNotFound       = errors.New("role doesn't exist")
+	ErrPermissionNotFound = errors.New("permission doesn't exist")
+	ErrBuiltInRole        = errors.New("built-in roles can't be deleted")
+)
+
+// GetPermissionSet returns the permissions granted to the user by all of their roles
+func (r *Repository) GetPermissionSet(userID int) (PermissionSet, error) {
+	var names []string
+	err := r.DB.Model(&Permission{}).
+		Joins("INNER JOIN "+DBNamesRoleHasPermissions.TableName+" ON "+
+			DBNamesRoleHasPermissions.TableName+"."+DBNamesRoleHasPermissions.PermissionID+" = "+
+			DBNamesPermission.TableName+"."+DBNamesPermission.ID).
+		Joins("INNER JOIN "+DBNamesUserHasRoles.TableName+" ON "+
+			DBNamesUserHasRoles.TableName+"."+DBNamesUserHasRoles.RoleID+" = "+
+			DBNamesRoleHasPermissions.TableName+"."+DBNamesRoleHasPermissions.RoleID).
+		Where(DBNamesUserHasRoles.TableName+"."+DBNamesUserHasRoles.UserID, userID).
+		Pluck(DBNamesPermission.TableName+"."+DBNamesPermission.Name, &names).Error
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get permissions of user from DB")
+	}
+
+	return NewPermissionSet(names), nil
+}
+
+// GetPermissions returns every permission, or the ones granted to the role if roleID isn't nil
+func (r *Repository) GetPermissions(roleID *int) ([]*Permission, error) {
+	var permissionsDB []*Permission
+
+	query := r.DB
+	if roleID != nil {
+		query = query.Joins("INNER JOIN "+DBNamesRoleHasPermissions.TableName+" ON "+
+			DBNamesRoleHasPermissions.TableName+"."+DBNamesRoleHasPermissions.PermissionID+" = "+
+			DBNamesPermission.TableName+"."+DBNamesPermission.ID+" AND "+
+			DBNamesRoleHasPermissions.TableName+"."+DBNamesRoleHasPermissions.RoleID+" = ?", *roleID)
+	}
+
+	err := query.Order(DBNamesPermission.TableName + "." + DBNamesPermission.Name).Find(&permissionsDB).Error
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get permissions from DB")
+	}
+
+	return permissionsDB, nil
+}
+
+func (r *Repository) CreatePermission(name string, description string) (*Permission, error) {
+	if name == "" {
+		return nil, errors.New("permission name can't be empty")
+	}
+
+	permission := &Permission{
+		Name:        name,
+		Description: description,
+	}
+	err := r.DB.Create(permission).Error
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to save permission "+name+" to DB")
+	}
+
+	return permission, nil
+}
+
+// DeletePermission revokes the permission from every role before deleting it
+func (r *Repository) DeletePermission(permissionID int) error {
+	return r.DB.Transaction(func(tx *gorm.DB) error {
+		err := tx.Where(DBNamesRoleHasPermissions.PermissionID, permissionID).Delete(&RoleHasPermissions{}).Error
+		if err != nil {
+			return errors.Wrap(err, "failed to revoke permission from roles")
+		}
+
+		result := tx.Delete(&Permission{}, permissionID)
+		if result.Error != nil {
+			return errors.Wrap(result.Error, "failed to delete permission from DB")
+		} else if result.RowsAffected == 0 {
+			return ErrPermissionNotFound
+		}
+
+		return nil
+	})
+}
+
+func (r *Repository) CreateRole(name string) (*Role, error) {
+	if name == "" {
+		return nil, errors.New("role name can't be empty")
+	}
+
+	var count int64
+	err := r.DB.Model(&Role{}).Where(DBNamesRole.Name, name).Count(&count).Error
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to check role name")
+	} else if count > 0 {
+		return nil, errors.New("role " + name + " already exists")
+	}
+
+	role := &Role{
+		Name: name,
+	}
+	err = r.DB.Create(role).Error
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to save role "+name+" to DB")
+	}
+
+	return role, nil
+}
+
+// DeleteRole takes the role away from its users and deletes it, the built-in roles are referred to by ID and are kept
+func (r *Repository) DeleteRole(roleID int) error {
+	if roleID == RoleAdminID || roleID == RoleUserID || roleID == RoleDesignerID {
+		return ErrBuiltInRole
+	}
+
+	return r.DB.Transaction(func(tx *gorm.DB) error {
+		for _, linked := range []struct {
+			name   string
+			column string
+			model  interface{}
+		}{
+			{"users", DBNamesUserHasRoles.RoleID, &UserHasRoles{}},
+			{"permissions", DBNamesRoleHasPermissions.RoleID, &RoleHasPermissions{}},
+		} {
+			err := tx.Where(linked.column, roleID).Delete(linked.model).Error
+			if err != nil {
+				return errors.Wrap(err, "failed to delete "+linked.name+" of role from DB")
+			}
+		}
+
+		result := tx.Delete(&Role{}, roleID)
+		if result.Error != nil {
+			return errors.Wrap(result.Error, "failed to delete role from DB")
+		} else if result.RowsAffected == 0 {
+			return ErrRoleNotFound
+		}
+
+		return nil
+	})
+}
+
+// SetRolePermission grants the permission to the role if activate is true and revokes it otherwise
+func (r *Repository) SetRolePermission(roleID int, permissionID int, activate bool) error {
+	err := r.DB.First(&Role{}, roleID).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return ErrRoleNotFound
+	} else if err != nil {
+		return errors.Wrap(err, "failed to get role from DB")
+	}
+
+	err = r.DB.First(&Permission{}, permissionID).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return ErrPermissionNotFound
+	} else if err != nil {
+		return errors.Wrap(err, "failed to get permission from DB")
+	}
+
+	roleHasPermission := &RoleHasPermissions{
+		RoleID:       roleID,
+		PermissionID: permissionID,
+	}
+
+	if activate {
+		err = r.DB.Clauses(clause.OnConflict{DoNothing: true}).Create(roleHasPermission).Error
+	} else {
+		err = r.DB.Where(roleHasPermission).Delete(&RoleHasPermissions{}).Error
+	}
+	if err != nil {
+		return errors.Wrap(err, "failed to set permission "+strconv.Itoa(permissionID)+" of role "+strconv.Itoa(roleID))
+	}
+
+	return nil
+}
@@ -1,41 +1,75 @@
 package user
 
 import (
-	"github.com/gomodule/redigo/redis"
-	"github.com/google/uuid"
+	"encoding/json"
 	"github.com/pkg/errors"
 	"server/internal/constant"
-	"server/internal/email"
-	"server/internal/mail"
-	"server/pkg/mailparser"
+	"server/internal/mailtemplate"
+	"server/internal/onetimekey"
+	"server/internal/siwe"
+	"os"
 	"strconv"
 	"time"
 )
 
 const (
-	confirmationKeyPrefix    = "Confirm:"
+	confirmationKeyPrefix       = "Confirm:"
+	confirmationUserIDSetPrefix = confirmationKeyPrefix + "UserID:"
 
 	passResetKeyPrefix       = "Reset:"
 	passResetUserIDSetPrefix = passResetKeyPrefix + "UserID:"
 
 	associateAddressKeyPrefix = "Associate:"
 
+	emailChangeKeyPrefix       = "EmailChange:"
+	emailChangeUserIDSetPrefix = emailChangeKeyPrefix + "UserID:"
+
 	loginBlockchainKeyPrefix = "Login:"
+
+	registerPasskeyKeyPrefix = "Passkey:Register:"
+	loginPasskeyKeyPrefix    = "Passkey:Login:"
+
+	socialLoginKeyPrefix = "Social:"
+
+	reauthenticationKeyPrefix       = "Reauth:"
+	reauthenticationUserIDSetPrefix = reauthenticationKeyPrefix + "UserID:"
+
+	associateAddressStatement = "Link this wallet to your Jevels account."
+	loginBlockchainStatement  = "Sign in to Jevels with this wallet."
 )
 
 var (
-	confirmationKeyTTL *time.Duration = nil
-	passResetKeyTTL = 1 * time.Hour
-	associateAddressKeyTTL = 2 * time.Minute
 	loginBlockchainKeyTTL = 2 * time.Minute
+	registerPasskeyKeyTTL = 5 * time.Minute
+	loginPasskeyKeyTTL = 5 * time.Minute
+	socialLoginKeyTTL = 10 * time.Minute
+	reauthenticationKeyTTL = 5 * time.Minute
 )
 
-type ResetHash struct {
-	UserID int
+// TTLs are the configurable lifetimes of the single use keys and of the account deletion grace period
+type TTLs struct {
+	ConfirmationKey     time.Duration
+	PassResetKey        time.Duration
+	AssociateAddressKey time.Duration
+	EmailChangeKey      time.Duration
+	// AccountDeletionGracePeriod is how long a deletion request can be cancelled before the account is purged
+	AccountDeletionGracePeriod time.Duration
+}
+
+func NewTTLs() *TTLs {
+	ttls := &TTLs{}
+	ttls.Init()
+	return ttls
 }
 
-type ConfirmationHash struct {
-	UserID int
+// Init loads the TTLs from the CONFIRMATION_KEY_TTL, PASS_RESET_KEY_TTL, ASSOCIATE_ADDRESS_KEY_TTL,
+// EMAIL_CHANGE_KEY_TTL and ACCOUNT_DELETION_GRACE_PERIOD env variables in time.ParseDuration format
+func (t *TTLs) Init() {
+	t.ConfirmationKey = envDuration("CONFIRMATION_KEY_TTL", 48*time.Hour)
+	t.PassResetKey = envDuration("PASS_RESET_KEY_TTL", 1*time.Hour)
+	t.AssociateAddressKey = envDuration("ASSOCIATE_ADDRESS_KEY_TTL", 2*time.Minute)
+	t.EmailChangeKey = envDuration("EMAIL_CHANGE_KEY_TTL", 24*time.Hour)
+	t.AccountDeletionGracePeriod = envDuration("ACCOUNT_DELETION_GRACE_PERIOD", 30*24*time.Hour)
 }
 
 type AssociateAddressHash struct {
@@ -43,10 +77,20 @@ type AssociateAddressHash struct {
 	Address string
 }
 
+// GenerateConfirmationKey returns a random key that replaces the previous ones of the user, only its hash is stored
 func (r *Repository) GenerateConfirmationKey(userID int) (*string, error) {
-	// generate confirmation key
-	confirmationKey := strconv.Itoa(int(time.Now().UnixNano())) + "-" + strconv.Itoa(userID)
-	err := r.Redis.SaveHash(confirmationRedisKey(confirmationKey), ConfirmationHash{UserID: userID}, nil, confirmationKeyTTL)
+	confirmationKey, err := onetimekey.NewToken()
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.Keys.Save(
+		confirmationRedisKey(confirmationKey),
+		strconv.Itoa(userID),
+		confirmationUserIDSetKey(userID),
+		r.TTLs.ConfirmationKey,
+		true,
+	)
 	if err != nil {
 		err = errors.Wrap(err, "failed to save confirmation key")
 		return nil, err
@@ -59,64 +103,41 @@ func (r *Repository) GenerateAndSendConfirmationKey(userDB User) (err error) {
 	// generate confirmation key
 	confirmKey, err := r.GenerateConfirmationKey(userDB.ID)
 	if err != nil {
-		_ = r.Delete(userDB.ID)
 		return errors.Wrap(err, "failed to generate confirmation key")
 	}
 
 	// send confirmation email
-	emailTemplate := &email.Confirm{
-		PreferredName:   userDB.PreferredName,
-		ConfirmEmailURL: constant.ConfirmEmailURL + *confirmKey,
-	}
-	emailTemplate.Prepare()
-	emailBytes, err := mailparser.Parse(emailTemplate)
-	if err != nil {
-		_ = r.Delete(userDB.ID)
-		return errors.Wrap(err, "failed to parse confirmation email")
-	}
-
-	err = r.Mail.Send(mail.Email{
-		From:    "no-reply@jevels.com",
-		To:      userDB.Email,
-		Subject: "Confirm your account",
-		Message: string(emailBytes),
+	err = r.sendMail(&userDB, userDB.Email, mailtemplate.TemplateConfirmEmail, mailtemplate.ConfirmEmailData{
+		PreferredName: userDB.PreferredName,
+		URL:           constant.ConfirmEmailURL + *confirmKey,
 	})
 	if err != nil {
-		_ = r.Delete(userDB.ID)
 		return errors.Wrap(err, "failed to send confirmation email")
 	}
 
 	return
 }
 
+// GeneratePassResetKey returns a random key that replaces the previous ones of the user, only its hash is stored
 func (r *Repository) GeneratePassResetKey(userID int) (*string, error) {
-	// look for and delete previous keys
-	passResetKeys, err := r.Redis.GetMembers(passResetUserIDSetKey(userID))
+	passResetKey, err := onetimekey.NewToken()
 	if err != nil {
 		return nil, err
-	} else if len(passResetKeys)>0 {
-		for _, passResetKey := range passResetKeys {
-			err = r.RevokePassResetKey(userID, string(passResetKey.([]uint8)))
-			if err != nil {
-				return nil, err
-			}
-		}
 	}
 
-	// generate confirmation key
-	confirmationKey := strconv.Itoa(int(time.Now().UnixNano())) + "-" + strconv.Itoa(userID)
-	err = r.Redis.SaveHash(
-		passResetRedisKey(confirmationKey),
-		ResetHash{UserID: userID},
-		[]string{passResetUserIDSetKey(userID)},
-		&passResetKeyTTL,
+	err = r.Keys.Save(
+		passResetRedisKey(passResetKey),
+		strconv.Itoa(userID),
+		passResetUserIDSetKey(userID),
+		r.TTLs.PassResetKey,
+		true,
 	)
 	if err != nil {
-		err = errors.Wrap(err, "failed to save confirmation key")
+		err = errors.Wrap(err, "failed to save password reset key")
 		return nil, err
 	}
 
-	return &confirmationKey, nil
+	return &passResetKey, nil
 }
 
 func (r *Repository) GenerateAndSendPassResetKey(userDB User) (err error) {
@@ -127,55 +148,36 @@ func (r *Repository) GenerateAndSendPassResetKey(userDB User) (err error) {
 	}
 
 
-	emailTemplate := &email.ForgotPassword{
-		PreferredName:    userDB.PreferredName,
-		Key:     *key,
-		URLBase: constant.ResetPasswordURL,
-	}
-	emailTemplate.Prepare()
-	emailBytes, err := mailparser.Parse(emailTemplate)
-	if err != nil {
-		_ = r.RevokePassResetKey(userDB.ID, *key)
-		return errors.Wrap(err, "failed to parse confirmation email")
-	}
-
-	err = r.Mail.Send(mail.Email{
-		From:    "no-reply@jevels.com",
-		To:      userDB.Email,
-		Subject: "Forgot your password",
-		Message: string(emailBytes),
+	err = r.sendMail(&userDB, userDB.Email, mailtemplate.TemplatePassReset, mailtemplate.PassResetData{
+		PreferredName: userDB.PreferredName,
+		URL:           constant.ResetPasswordURL + *key,
 	})
 	if err != nil {
 		_ = r.RevokePassResetKey(userDB.ID, *key)
-		return errors.Wrap(err, "failed to send confirmation email")
+		return errors.Wrap(err, "failed to send password reset email")
 	}
 
 	return nil
 }
 
+// GenerateAssociateAddressMessage replaces the previous message of the user
 func (r *Repository) GenerateAssociateAddressMessage(userID int, address string) (*string, error) {
-	// look for and delete previous keys
-	rawHash, err := r.Redis.GetHash(associateAddressRedisKey(userID))
+	// generate sign in with ethereum message
+	message, err := r.SIWE.NewMessage(address, associateAddressStatement, r.TTLs.AssociateAddressKey)
 	if err != nil {
-		return nil, err
-	} else if len(rawHash) == 0 {
-		err = r.RevokeAssociateMessageKey(userID)
-		if err != nil {
-			return nil, err
-		}
+		return nil, errors.Wrap(err, "failed to generate associate address message")
 	}
+	associateAddressMessage := message.String()
 
-	// generate confirmation key
-	associateAddressMessage := strconv.Itoa(int(time.Now().UnixNano())) + "-" + strconv.Itoa(userID)
-	err = r.Redis.SaveHash(
-		associateAddressRedisKey(userID),
-		AssociateAddressHash{
-			Message: associateAddressMessage,
-			Address: address,
-		},
-		nil,
-		&associateAddressKeyTTL,
-	)
+	hashBytes, err := json.Marshal(AssociateAddressHash{
+		Message: associateAddressMessage,
+		Address: message.Address,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to encode associate address message")
+	}
+
+	err = r.Keys.Save(associateAddressRedisKey(userID), string(hashBytes), "", r.TTLs.AssociateAddressKey, false)
 	if err != nil {
 		err = errors.Wrap(err, "failed to save associate address message")
 		return nil, err
@@ -184,16 +186,15 @@ func (r *Repository) GenerateAssociateAddressMessage(userID int, address string)
 	return &associateAddressMessage, nil
 }
 
-func (r *Repository) GenerateLoginBlockchainMessage() (*string, error) {
-	// generate confirmation key
-	loginBlockchainMessage := uuid.NewString()
+func (r *Repository) GenerateLoginBlockchainMessage(address string) (*string, error) {
+	// generate sign in with ethereum message, the nonce identifies it
+	message, err := r.SIWE.NewMessage(address, loginBlockchainStatement, loginBlockchainKeyTTL)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to generate login blockchain message")
+	}
+	loginBlockchainMessage := message.String()
 
-	err := r.Redis.Save(
-		loginBlockchainRedisKey(loginBlockchainMessage),
-		loginBlockchainMessage,
-		nil,
-		&loginBlockchainKeyTTL,
-	)
+	err = r.Keys.Save(loginBlockchainRedisKey(message.Nonce), loginBlockchainMessage, "", loginBlockchainKeyTTL, false)
 	if err != nil {
 		err = errors.Wrap(err, "failed to save login blockchain message")
 		return nil, err
@@ -202,86 +203,138 @@ func (r *Repository) GenerateLoginBlockchainMessage() (*string, error) {
 	return &loginBlockchainMessage, nil
 }
 
-func (r *Repository) LoginBlockchainMessage(key string) (*string, error) {
-	loginBlockchainMessage, err := redis.String(r.Redis.Get(loginBlockchainRedisKey(key)))
-	if errors.Is(err, redis.ErrNil) {
+// LoginBlockchainMessage parses and validates a sign in with ethereum message, it must be the exact message issued by
+// GenerateLoginBlockchainMessage. Its nonce is consumed in the same step, so two concurrent logins with the same
+// signed message can't both pass.
+func (r *Repository) LoginBlockchainMessage(message string) (*siwe.Message, error) {
+	messageParsed, err := siwe.Parse(message)
+	if err != nil {
+		return nil, err
+	}
+
+	loginBlockchainMessage, err := r.Keys.Consume(loginBlockchainRedisKey(messageParsed.Nonce), "")
+	if errors.Is(err, onetimekey.ErrNotFound) {
 		return nil, errors.New("invalid message")
 	} else if err != nil {
 		return nil, err
+	} else if loginBlockchainMessage != message {
+		return nil, errors.New("invalid message")
 	}
-	return &loginBlockchainMessage, nil
-}
 
-func (r *Repository) AssociateAddressMessage(userID int) (*AssociateAddressHash, error) {
-	rawHash, err := r.Redis.GetHash(associateAddressRedisKey(userID))
+	err = r.SIWE.Validate(messageParsed, time.Now())
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to get associate address message from Redis")
-	} else if len(rawHash) == 0 {
+		return nil, err
+	}
+
+	return messageParsed, nil
+}
+
+// ConsumeAssociateAddressMessage returns the pending message of the user and revokes it so it can only be used once
+func (r *Repository) ConsumeAssociateAddressMessage(userID int) (*AssociateAddressHash, error) {
+	rawHash, err := r.Keys.Consume(associateAddressRedisKey(userID), "")
+	if errors.Is(err, onetimekey.ErrNotFound) {
 		return nil, errors.New("associate address message no longer valid")
+	} else if err != nil {
+		return nil, errors.Wrap(err, "failed to get associate address message from Redis")
 	}
 	hash := AssociateAddressHash{}
-	err = redis.ScanStruct(rawHash, &hash)
+	err = json.Unmarshal([]byte(rawHash), &hash)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode associate address message")
+	}
+
+	messageParsed, err := siwe.Parse(hash.Message)
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.SIWE.Validate(messageParsed, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
 	return &hash, nil
 }
 
 var ErrConfirmationKeyNoLongerValid = errors.New("confirmation key is no longer valid")
 
-func (r *Repository) UserIdFromConfirmationKey(key string) (*int, error) {
-	rawHash, err := r.Redis.GetHash(confirmationRedisKey(key))
-	if err != nil {
-		return nil, errors.Wrap(err, "failed to get user id from confirmation key")
-	} else if len(rawHash) == 0 {
+// ConsumeConfirmationKey returns the user of the key and revokes it, concurrent calls with the same key can't both
+// succeed
+func (r *Repository) ConsumeConfirmationKey(key string) (*int, error) {
+	rawUserID, err := r.Keys.Get(confirmationRedisKey(key))
+	if errors.Is(err, onetimekey.ErrNotFound) {
 		return nil, ErrConfirmationKeyNoLongerValid
+	} else if err != nil {
+		return nil, errors.Wrap(err, "failed to get user id from confirmation key")
 	}
-	hash := ConfirmationHash{}
-	err = redis.ScanStruct(rawHash, &hash)
+
+	userID, err := strconv.Atoi(rawUserID)
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to parse struct from Redis hash")
+		return nil, errors.Wrap(err, "failed to parse user id from confirmation key")
+	}
+
+	_, err = r.Keys.Consume(confirmationRedisKey(key), confirmationUserIDSetKey(userID))
+	if errors.Is(err, onetimekey.ErrNotFound) {
+		return nil, ErrConfirmationKeyNoLongerValid
+	} else if err != nil {
+		return nil, errors.Wrap(err, "failed to revoke confirmation key")
 	}
 
-	return &hash.UserID, nil
+	return &userID, nil
 }
 
+var ErrPassResetKeyNoLongerValid = errors.New("password reset key is no longer valid")
+
+// UserIDFromPassResetKey returns the user of the key without revoking it
 func (r *Repository) UserIDFromPassResetKey(key string) (*int, error) {
-	rawHash, err := r.Redis.GetHash(passResetRedisKey(key))
-	if err != nil {
+	rawUserID, err := r.Keys.Get(passResetRedisKey(key))
+	if errors.Is(err, onetimekey.ErrNotFound) {
+		return nil, ErrPassResetKeyNoLongerValid
+	} else if err != nil {
 		return nil, errors.Wrap(err, "failed to get user id from password reset key")
-	} else if len(rawHash) == 0 {
-		return nil, errors.New("password reset key is no longer valid")
 	}
 
-	hash := ResetHash{}
-	err = redis.ScanStruct(rawHash, &hash)
+	userID, err := strconv.Atoi(rawUserID)
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to parse struct from Redis hash")
+		return nil, errors.Wrap(err, "failed to parse user id from password reset key")
 	}
 
-	userID := hash.UserID
 	return &userID, nil
 }
 
-func (r *Repository) RevokeConfirmationKey(key string) error {
-	return r.Redis.DeleteKey(confirmationRedisKey(key))
-}
+// ConsumePassResetKey revokes the key of the user, it fails if the key was already used so concurrent password resets
+// with the same key can't both succeed
+func (r *Repository) ConsumePassResetKey(userID int, key string) error {
+	rawUserID, err := r.Keys.Consume(passResetRedisKey(key), passResetUserIDSetKey(userID))
+	if errors.Is(err, onetimekey.ErrNotFound) {
+		return ErrPassResetKeyNoLongerValid
+	} else if err != nil {
+		return errors.Wrap(err, "failed to revoke password reset key")
+	} else if rawUserID != strconv.Itoa(userID) {
+		return ErrPassResetKeyNoLongerValid
+	}
 
-func (r *Repository) RevokePassResetKey(userID int, key string) error {
-	return r.Redis.DeleteKeyAndSetMembership(passResetUserIDSetKey(userID), passResetRedisKey(key))
+	return nil
 }
 
-func (r *Repository) RevokeAssociateMessageKey(userID int) error {
-	return r.Redis.DeleteKey(associateAddressRedisKey(userID))
+func (r *Repository) RevokePassResetKey(userID int, key string) error {
+	_, err := r.Keys.Consume(passResetRedisKey(key), passResetUserIDSetKey(userID))
+	if err != nil && !errors.Is(err, onetimekey.ErrNotFound) {
+		return err
+	}
+	return nil
 }
 
-func (r *Repository) RevokeLoginBlockchainMessageKey(key string) error {
-	return r.Redis.DeleteKey(loginBlockchainRedisKey(key))
+func confirmationRedisKey(key string) string {
+	return confirmationKeyPrefix + onetimekey.Hash(key)
 }
 
-func confirmationRedisKey(key string) string {
-	return confirmationKeyPrefix + key
+func confirmationUserIDSetKey(userID int) string {
+	return confirmationUserIDSetPrefix + strconv.Itoa(userID)
 }
 
 func passResetRedisKey(key string) string {
-	return passResetKeyPrefix + key
+	return passResetKeyPrefix + onetimekey.Hash(key)
 }
 
 func passResetUserIDSetKey(userID int) string {
@@ -292,6 +345,42 @@ func associateAddressRedisKey(userID int) string {
 	return associateAddressKeyPrefix + strconv.Itoa(userID)
 }
 
-func loginBlockchainRedisKey(key string) string {
-	return loginBlockchainKeyPrefix + key
+func emailChangeRedisKey(key string) string {
+	return emailChangeKeyPrefix + onetimekey.Hash(key)
+}
+
+func emailChangeUserIDSetKey(userID int) string {
+	return emailChangeUserIDSetPrefix + strconv.Itoa(userID)
+}
+
+func loginBlockchainRedisKey(nonce string) string {
+	return loginBlockchainKeyPrefix + nonce
+}
+
+func registerPasskeyRedisKey(userID int) string {
+	return registerPasskeyKeyPrefix + strconv.Itoa(userID)
+}
+
+func loginPasskeyRedisKey(challenge string) string {
+	return loginPasskeyKeyPrefix + challenge
+}
+
+func socialLoginRedisKey(state string) string {
+	return socialLoginKeyPrefix + state
+}
+
+func reauthenticationRedisKey(key string) string {
+	return reauthenticationKeyPrefix + onetimekey.Hash(key)
+}
+
+func reauthenticationUserIDSetKey(userID int) string {
+	return reauthenticationUserIDSetPrefix + strconv.Itoa(userID)
+}
+
+func envDuration(key string, defaultValue time.Duration) time.Duration {
+	value, err := time.ParseDuration(os.Getenv(key))
+	if err != nil || value <= 0 {
+		return defaultValue
+	}
+	return value
 }
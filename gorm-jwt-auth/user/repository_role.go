@@ -83,3 +83,40 @@ func (r Repository) SetRole(userID int, roleID int, activate bool) error {
 
 	return err
 }
+
+// RolesOfUsers returns the roles of every user by user ID in two queries, users without a role aren't in the map
+func (r *Repository) RolesOfUsers(userIDs []int) (map[int][]*Role, error) {
+	var userHasRolesDB []*UserHasRoles
+	err := r.DB.Where(DBNamesUserHasRoles.UserID+" IN ?", userIDs).Find(&userHasRolesDB).Error
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get roles of users from DB")
+	}
+
+	var roleIDs []int
+	for _, userHasRoleDB := range userHasRolesDB {
+		roleIDs = append(roleIDs, userHasRoleDB.RoleID)
+	}
+
+	roles := map[int][]*Role{}
+	if len(roleIDs) == 0 {
+		return roles, nil
+	}
+
+	var rolesDB []*Role
+	err = r.DB.Where(DBNamesRole.ID+" IN ?", roleIDs).Find(&rolesDB).Error
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get roles from DB")
+	}
+
+	rolesByID := make(map[int]*Role, len(rolesDB))
+	for _, roleDB := range rolesDB {
+		rolesByID[roleDB.ID] = roleDB
+	}
+	for _, userHasRoleDB := range userHasRolesDB {
+		if roleDB, ok := rolesByID[userHasRoleDB.RoleID]; ok {
+			roles[userHasRoleDB.UserID] = append(roles[userHasRoleDB.UserID], roleDB)
+		}
+	}
+
+	return roles, nil
+}
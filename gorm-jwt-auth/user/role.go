@@ -1,5 +1,6 @@
 package user
 
+// IDs of the built-in roles, what they're allowed to do is given by their permissions
 const (
 	RoleAdminID = 1
 	RoleUserID = 2
@@ -12,4 +13,5 @@ type Role struct {
 	Name string
 
 	Users []*User `gorm:"many2many:user_has_roles;"`
+	Permissions []*Permission `gorm:"many2many:role_has_permissions;"`
 }
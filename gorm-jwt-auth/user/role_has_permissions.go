@@ -0,0 +1,7 @@
+package user
+
+// @GormDBNames
+type RoleHasPermissions struct {
+	RoleID       int `gorm:"primaryKey"`
+	PermissionID int `gorm:"primaryKey"`
+}
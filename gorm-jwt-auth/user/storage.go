@@ -69,3 +69,12 @@ func ProfileImageURL(userID int) (*string, error) {
 
 	return profileImage, nil
 }
+
+// DeleteUserStorage removes every file uploaded by the user
+func DeleteUserStorage(userID int) error {
+	err := os.RemoveAll(filepath.Join(constant.StorageUserPath, strconv.Itoa(userID)))
+	if err != nil {
+		return errors.Wrap(err, "failed to delete user storage")
+	}
+	return nil
+}
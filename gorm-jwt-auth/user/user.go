@@ -11,15 +11,24 @@ type User struct {
 	LastName      string
 	PreferredName string
 	RegisterTime  *time.Time `gorm:"type:timestamp without time zone;"`
-	Password      string     `gorm:"type:char(60)"`
+	Password      string     `gorm:"type:varchar(255)"`
 	Email         string     `gorm:"unique;"`
 	Country       string
+	// Locale is the preferred language of the emails, the one of the country is used when it's empty
+	Locale string
+	// DeletionTime is when the account will be purged, nil unless the user requested its deletion
+	DeletionTime *time.Time `gorm:"type:timestamp without time zone;"`
+	// DeletedTime is set once the account has been purged and the row anonymised
+	DeletedTime *time.Time `gorm:"type:timestamp without time zone;"`
 
 	Roles               []*Role `gorm:"many2many:user_has_roles;"`
 	UserHasAddresses    []UserHasAddresses
 	Profile             Profile
 	UserHasNftsOffchain []UserHasOffchainNfts
 	StripeCustomer      StripeID
+	Passkeys            []Passkey
+	Identities          []Identity
+	APIKeys             []APIKey
 
 	HasCompleteProfile    bool
 	HasBankAccount        bool
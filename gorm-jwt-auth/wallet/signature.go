@@ -0,0 +1,100 @@
+package wallet
+
+import (
+	"bytes"
+	"context"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"server/pkg/blockchain"
+	"server/pkg/chainconfig"
+	"strings"
+)
+
+// eip1271MagicValue is returned by isValidSignature when the signature is valid for the contract wallet
+var eip1271MagicValue = [4]byte{0x16, 0x26, 0xba, 0x7e}
+
+const eip1271ABIJSON = `[{"inputs":[{"name":"hash","type":"bytes32"},{"name":"signature","type":"bytes"}],"name":"isValidSignature","outputs":[{"name":"magicValue","type":"bytes4"}],"stateMutability":"view","type":"function"}]`
+
+var (
+	eip1271ABI, _ = abi.JSON(strings.NewReader(eip1271ABIJSON))
+
+	ErrInvalidSignature = errors.New("invalid signature")
+)
+
+// SignatureVerifier checks personal_sign signatures of EOAs through ecrecover and of smart contract wallets
+// (Safe, Argent...) through EIP-1271
+type SignatureVerifier struct {
+	Caller bind.ContractCaller
+}
+
+func NewSignatureVerifier(caller bind.ContractCaller) *SignatureVerifier {
+	return &SignatureVerifier{
+		Caller: caller,
+	}
+}
+
+// NewSignatureVerifierFromChainConfig uses the chain's provider as the client to query contract wallets
+func NewSignatureVerifierFromChainConfig(ctx context.Context, chainConfig *chainconfig.ChainConfig) (*SignatureVerifier, error) {
+	client, _, err := chainConfig.GenerateProviderClients(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to generate provider clients for signature verifier")
+	}
+
+	return NewSignatureVerifier(client), nil
+}
+
+// Verify checks that address signed the message with personal_sign, it first tries to recover an EOA and falls back to
+// calling isValidSignature on address
+func (v *SignatureVerifier) Verify(ctx context.Context, address common.Address, message []byte, signature []byte) error {
+	recovered, err := blockchain.AddressFromSignature(message, signature, true)
+	if err == nil && recovered.String() == address.String() {
+		return nil
+	}
+
+	valid, err := v.isValidSignature(ctx, address, accounts.TextHash(message), signature)
+	if err != nil {
+		return errors.Wrap(err, "failed to verify contract wallet signature")
+	} else if !valid {
+		return ErrInvalidSignature
+	}
+
+	return nil
+}
+
+func (v *SignatureVerifier) isValidSignature(ctx context.Context, address common.Address, hash []byte, signature []byte) (bool, error) {
+	if v.Caller == nil {
+		return false, nil
+	}
+
+	code, err := v.Caller.CodeAt(ctx, address, nil)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to get wallet code")
+	} else if len(code) == 0 {
+		// an EOA whose signature didn't recover
+		return false, nil
+	}
+
+	var hash32 [32]byte
+	copy(hash32[:], hash)
+	input, err := eip1271ABI.Pack("isValidSignature", hash32, signature)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to encode isValidSignature call")
+	}
+
+	output, err := v.Caller.CallContract(ctx, ethereum.CallMsg{
+		To:   &address,
+		Data: input,
+	}, nil)
+	if err != nil {
+		// wallets revert on invalid signatures
+		return false, nil
+	} else if len(output) < len(eip1271MagicValue) {
+		return false, nil
+	}
+
+	return bytes.Equal(output[:len(eip1271MagicValue)], eip1271MagicValue[:]), nil
+}
@@ -0,0 +1,85 @@
+package wallet
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/suite"
+	"math/big"
+	"testing"
+)
+
+var (
+	// mockWalletCode always returns the EIP-1271 magic value from isValidSignature
+	mockWalletCode = hexutil.MustDecode("0x631626ba7e60e01b60005260206000f3")
+	// rejectingWalletCode always returns 0xffffffff from isValidSignature
+	rejectingWalletCode = hexutil.MustDecode("0x63ffffffff60e01b60005260206000f3")
+
+	mockWalletAddress      = common.HexToAddress("0x00000000000000000000000000000000000012a1")
+	rejectingWalletAddress = common.HexToAddress("0x00000000000000000000000000000000000012a2")
+)
+
+type SignatureVerifierSuite struct {
+	suite.Suite
+	backend  *backends.SimulatedBackend
+	verifier *SignatureVerifier
+	key      *ecdsa.PrivateKey
+	address  common.Address
+	message  []byte
+}
+
+func (s *SignatureVerifierSuite) SetupTest() {
+	var err error
+	s.key, err = crypto.GenerateKey()
+	s.Require().NoError(err)
+	s.address = crypto.PubkeyToAddress(s.key.PublicKey)
+	s.message = []byte("jevels.com wants you to sign in with your Ethereum account")
+
+	// the mock wallets are deployed at genesis
+	s.backend = backends.NewSimulatedBackend(core.GenesisAlloc{
+		s.address:              {Balance: big.NewInt(1e18)},
+		mockWalletAddress:      {Balance: big.NewInt(0), Code: mockWalletCode},
+		rejectingWalletAddress: {Balance: big.NewInt(0), Code: rejectingWalletCode},
+	}, 8000000)
+	s.verifier = NewSignatureVerifier(s.backend)
+}
+
+func (s *SignatureVerifierSuite) TearDownTest() {
+	s.Require().NoError(s.backend.Close())
+}
+
+func (s *SignatureVerifierSuite) sign(message []byte) []byte {
+	signature, err := crypto.Sign(accounts.TextHash(message), s.key)
+	s.Require().NoError(err)
+	signature[crypto.RecoveryIDOffset] += 27
+	return signature
+}
+
+func (s *SignatureVerifierSuite) TestVerify_EOA() {
+	err := s.verifier.Verify(context.Background(), s.address, s.message, s.sign(s.message))
+	s.Assert().NoError(err)
+}
+
+func (s *SignatureVerifierSuite) TestVerify_EOAWrongMessage() {
+	err := s.verifier.Verify(context.Background(), s.address, s.message, s.sign([]byte("other message")))
+	s.Assert().ErrorIs(err, ErrInvalidSignature)
+}
+
+func (s *SignatureVerifierSuite) TestVerify_ContractWallet() {
+	err := s.verifier.Verify(context.Background(), mockWalletAddress, s.message, s.sign(s.message))
+	s.Assert().NoError(err)
+}
+
+func (s *SignatureVerifierSuite) TestVerify_ContractWalletRejects() {
+	err := s.verifier.Verify(context.Background(), rejectingWalletAddress, s.message, s.sign(s.message))
+	s.Assert().ErrorIs(err, ErrInvalidSignature)
+}
+
+func TestSignatureVerifier(t *testing.T) {
+	suite.Run(t, new(SignatureVerifierSuite))
+}
@@ -0,0 +1,199 @@
+package complexity
+
+import (
+	"encoding/json"
+	"github.com/vektah/gqlparser/v2/ast"
+	"math"
+	"strings"
+)
+
+const (
+	costDirective     = "cost"
+	listSizeDirective = "listSize"
+
+	// maxCost keeps the cost of deeply nested lists from overflowing, it's over any budget
+	maxCost = math.MaxInt32
+)
+
+// Depth is the most nested field of the selection set, the fields of the operation have a depth of 1. Fragments
+// count as the fields they select, the introspection fields count like the others.
+func Depth(selectionSet ast.SelectionSet) int {
+	depth := 0
+	for _, field := range fieldsOf(selectionSet) {
+		fieldDepth := 1 + Depth(field.SelectionSet)
+		if fieldDepth > depth {
+			depth = fieldDepth
+		}
+	}
+	return depth
+}
+
+// Cost adds the costs of the fields of the selection set, the one of a field is its weight plus the costs of its
+// fields, times the size of the list it returns.
+//
+// The weight is given by @cost, it's 1 for objects and 0 for scalars by default. The size of a list is the first of
+// the slicingArguments of @listSize set in the query, or its assumedSize, or defaultListSize, like the lists of the
+// introspection types. A field whose @listSize has sizedFields returns an object holding the lists: its size applies to
+// these fields instead, like the edges of a connection.
+func Cost(selectionSet ast.SelectionSet, variables map[string]interface{}, defaultListSize int) int {
+	return selectionCost(selectionSet, variables, defaultListSize, nil)
+}
+
+// selectionCost adds the costs of the fields, sizes are the sizes given by the @listSize of the parent field
+func selectionCost(selectionSet ast.SelectionSet, variables map[string]interface{}, defaultListSize int, sizes map[string]int) int {
+	cost := 0
+	for _, field := range fieldsOf(selectionSet) {
+		cost = addCost(cost, fieldCost(field, variables, defaultListSize, sizes))
+	}
+	return cost
+}
+
+func fieldCost(field *ast.Field, variables map[string]interface{}, defaultListSize int, sizes map[string]int) int {
+	weight := 0
+	if len(field.SelectionSet) > 0 {
+		weight = 1
+	}
+
+	multiplier := 1
+	var childSizes map[string]int
+	if field.Definition != nil {
+		if cost := field.Definition.Directives.ForName(costDirective); cost != nil {
+			if value, ok := intValue(argumentValue(cost, "weight")); ok {
+				weight = value
+			}
+		}
+
+		size := listSize(field, variables, defaultListSize)
+		if sizedFields := sizedFieldsOf(field.Definition); len(sizedFields) > 0 {
+			childSizes = make(map[string]int, len(sizedFields))
+			for _, name := range sizedFields {
+				childSizes[name] = size
+			}
+		} else if field.Definition.Type.Elem != nil {
+			multiplier = size
+		}
+	}
+	if size, ok := sizes[field.Name]; ok {
+		multiplier = size
+	}
+
+	cost := addCost(weight, selectionCost(field.SelectionSet, variables, defaultListSize, childSizes))
+	return multiplyCost(cost, multiplier)
+}
+
+// listSize is the size of the list returned by the field, or of its sized fields
+func listSize(field *ast.Field, variables map[string]interface{}, defaultListSize int) int {
+	directive := field.Definition.Directives.ForName(listSizeDirective)
+	if directive == nil {
+		return defaultListSize
+	}
+
+	if slicingArguments, ok := argumentValue(directive, "slicingArguments").([]interface{}); ok {
+		args := field.ArgumentMap(variables)
+		for _, path := range slicingArguments {
+			path, _ := path.(string)
+			if size, ok := intValue(argumentAt(args, path)); ok {
+				// negative sizes are rejected by the resolvers, they mustn't lower the cost before
+				if size < 0 {
+					return 0
+				}
+				return size
+			}
+		}
+	}
+
+	if size, ok := intValue(argumentValue(directive, "assumedSize")); ok {
+		return size
+	}
+	return defaultListSize
+}
+
+func sizedFieldsOf(definition *ast.FieldDefinition) []string {
+	directive := definition.Directives.ForName(listSizeDirective)
+	if directive == nil {
+		return nil
+	}
+
+	values, _ := argumentValue(directive, "sizedFields").([]interface{})
+	sizedFields := make([]string, 0, len(values))
+	for _, value := range values {
+		if name, ok := value.(string); ok {
+			sizedFields = append(sizedFields, name)
+		}
+	}
+	return sizedFields
+}
+
+// fieldsOf returns the fields of the selection set and of its fragments
+func fieldsOf(selectionSet ast.SelectionSet) []*ast.Field {
+	var fields []*ast.Field
+	for _, selection := range selectionSet {
+		switch selection := selection.(type) {
+		case *ast.Field:
+			fields = append(fields, selection)
+		case *ast.InlineFragment:
+			fields = append(fields, fieldsOf(selection.SelectionSet)...)
+		case *ast.FragmentSpread:
+			if selection.Definition != nil {
+				fields = append(fields, fieldsOf(selection.Definition.SelectionSet)...)
+			}
+		}
+	}
+	return fields
+}
+
+// argumentValue is the value of an argument of a directive of the schema, they're constants
+func argumentValue(directive *ast.Directive, name string) interface{} {
+	argument := directive.Arguments.ForName(name)
+	if argument == nil || argument.Value == nil {
+		return nil
+	}
+	value, err := argument.Value.Value(nil)
+	if err != nil {
+		return nil
+	}
+	return value
+}
+
+// argumentAt returns the value at the dot separated path in the arguments of a field, like filter.pagination.limit
+func argumentAt(args map[string]interface{}, path string) interface{} {
+	var value interface{} = args
+	for _, name := range strings.Split(path, ".") {
+		fields, ok := value.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		value = fields[name]
+	}
+	return value
+}
+
+// intValue reads the ints of the query, literals are int64 and variables are decoded as json.Number
+func intValue(value interface{}) (int, bool) {
+	switch value := value.(type) {
+	case int:
+		return value, true
+	case int64:
+		return int(value), true
+	case float64:
+		return int(value), true
+	case json.Number:
+		number, err := value.Int64()
+		return int(number), err == nil
+	}
+	return 0, false
+}
+
+func addCost(a int, b int) int {
+	if a+b > maxCost {
+		return maxCost
+	}
+	return a + b
+}
+
+func multiplyCost(cost int, multiplier int) int {
+	if multiplier > 0 && cost > maxCost/multiplier {
+		return maxCost
+	}
+	return cost * multiplier
+}
@@ -0,0 +1,133 @@
+package complexity
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/suite"
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+	"server/internal/user"
+	"testing"
+)
+
+const testSchema = `
+directive @cost(weight: Int!) on FIELD_DEFINITION
+directive @listSize(assumedSize: Int, slicingArguments: [String!], sizedFields: [String!]) on FIELD_DEFINITION
+
+type Query {
+    users(filter: UsersFilter!): UsersResult! @listSize(slicingArguments: ["filter.pagination.limit"], sizedFields: ["users"])
+    usersConnection(first: Int, last: Int): UserConnection! @listSize(slicingArguments: ["first", "last"], sizedFields: ["edges"])
+    roles: [Role!] @listSize(assumedSize: 3)
+}
+
+input UsersFilter {
+    pagination: Pagination
+}
+
+input Pagination {
+    limit: Int!
+    page: Int!
+}
+
+type UsersResult {
+    users: [User!]
+    count: Int!
+}
+
+type UserConnection {
+    edges: [UserEdge!]!
+    pageInfo: PageInfo!
+}
+
+type UserEdge {
+    cursor: String!
+    node: User!
+}
+
+type PageInfo {
+    hasNextPage: Boolean!
+}
+
+type User {
+    id: Int!
+    roles: [Role!]
+    owned: [UserHasNfts!] @cost(weight: 5)
+}
+
+type UserHasNfts {
+    nft: Nft!
+}
+
+type Nft {
+    id: Int!
+}
+
+type Role {
+    name: String!
+}
+`
+
+type CostSuite struct {
+	suite.Suite
+	schema *ast.Schema
+}
+
+func (s *CostSuite) SetupSuite() {
+	s.schema = gqlparser.MustLoadSchema(&ast.Source{Name: "schema.graphql", Input: testSchema})
+}
+
+func (s *CostSuite) operation(query string) ast.SelectionSet {
+	return gqlparser.MustLoadQuery(s.schema, query).Operations[0].SelectionSet
+}
+
+func (s *CostSuite) TestDepth() {
+	s.Assert().Equal(5, Depth(s.operation(`{ users(filter: {}) { users { owned { nft { id } } } } }`)))
+	s.Assert().Equal(4, Depth(s.operation(`{ users(filter: {}) { count ...Owned } } fragment Owned on UsersResult { users { owned { __typename } } }`)))
+	s.Assert().Equal(4, Depth(s.operation(`{ __schema { types { name fields { name } } } }`)))
+}
+
+func (s *CostSuite) TestCost() {
+	for _, test := range []struct {
+		name      string
+		query     string
+		variables map[string]interface{}
+		expected  int
+	}{
+		// users: 1, its users: 20 * (1 + roles: 10 * 1)
+		{name: "sized fields", query: `{ users(filter: {pagination: {limit: 20, page: 1}}) { count users { id roles { name } } } }`, expected: 221},
+		// usersConnection: 1, edges: 5 * (1 + node: 1), pageInfo: 1
+		{name: "variable size", query: `query($first: Int) { usersConnection(first: $first) { edges { cursor node { id } } pageInfo { hasNextPage } } }`, variables: map[string]interface{}{"first": json.Number("5")}, expected: 12},
+		// users: 1, its users: 10 * (1 + owned: 10 * (5 + nft: 1))
+		{name: "default size and weight", query: `{ users(filter: {}) { users { owned { nft { id } } } } }`, expected: 611},
+		{name: "assumed size", query: `{ roles { name } }`, expected: 3},
+		{name: "negative size", query: `{ usersConnection(last: -10) { edges { node { id } } } }`, expected: 1},
+		{name: "fragments", query: `{ roles { ...Role ... on Role { name } } } fragment Role on Role { name }`, expected: 3},
+		// __schema: 1, types: 10 * 1, fields: 10 * 10 * 1
+		{name: "introspection", query: `{ __schema { types { name fields { name } } } }`, expected: 111},
+	} {
+		s.Run(test.name, func() {
+			s.Assert().Equal(test.expected, Cost(s.operation(test.query), test.variables, 10))
+		})
+	}
+}
+
+func (s *CostSuite) TestCost_Saturates() {
+	query := `{ users(filter: {pagination: {limit: 100000, page: 1}}) { users { owned { nft { id } } roles { name } } } }`
+	s.Assert().Equal(maxCost, Cost(s.operation(query), nil, 100000))
+}
+
+func (s *CostSuite) TestBudget() {
+	limit := &Limit{AnonymousBudget: 1, AuthenticatedBudget: 2, AdminBudget: 3}
+
+	adminBudget := user.NewPermissionSet([]string{user.PermissionGraphQLAdminBudget})
+	userDB := &user.User{Roles: []*user.Role{{ID: user.RoleUserID}}}
+	admin := &user.User{Roles: []*user.Role{{ID: user.RoleUserID}, {ID: user.RoleAdminID}}}
+
+	s.Assert().Equal(1, limit.budget(nil, nil))
+	s.Assert().Equal(2, limit.budget(userDB, nil))
+	s.Assert().Equal(2, limit.budget(admin, user.NewPermissionSet([]string{user.PermissionUsersReadPII})), "admin role without the permission")
+	s.Assert().Equal(3, limit.budget(userDB, adminBudget))
+}
+
+func TestCost(t *testing.T) {
+	suite.Run(t, new(CostSuite))
+}
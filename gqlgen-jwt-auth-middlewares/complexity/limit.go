@@ -0,0 +1,107 @@
+package complexity
+
+import (
+	"context"
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/pkg/errors"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+	"os"
+	"server/api/graphql/directives"
+	"server/api/graphql/grapherrors"
+	"server/internal/user"
+	"strconv"
+)
+
+var (
+	ErrQueryTooDeep    = grapherrors.NewError("QUERY_TOO_DEEP")
+	ErrQueryTooComplex = grapherrors.NewError("QUERY_TOO_COMPLEX")
+)
+
+// Limit rejects the operations nesting fields deeper than MaxDepth or costing more than the budget of their caller,
+// before any resolver runs. The costs are declared in the schema with @cost and @listSize, see Cost.
+type Limit struct {
+	MaxDepth int
+	// DefaultListSize is the number of items counted for the lists whose size isn't given by @listSize
+	DefaultListSize     int
+	AnonymousBudget     int
+	AuthenticatedBudget int
+	AdminBudget         int
+}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.OperationContextMutator
+} = &Limit{}
+
+func NewLimit() *Limit {
+	limit := &Limit{}
+	limit.Init()
+	return limit
+}
+
+// Init loads the limits from the GRAPHQL_* env variables
+func (l *Limit) Init() {
+	l.MaxDepth = envInt("GRAPHQL_MAX_DEPTH", 10)
+	l.DefaultListSize = envInt("GRAPHQL_DEFAULT_LIST_SIZE", 10)
+	l.AnonymousBudget = envInt("GRAPHQL_ANONYMOUS_BUDGET", 2000)
+	l.AuthenticatedBudget = envInt("GRAPHQL_AUTHENTICATED_BUDGET", 5000)
+	l.AdminBudget = envInt("GRAPHQL_ADMIN_BUDGET", 50000)
+}
+
+func (l *Limit) ExtensionName() string {
+	return "ComplexityLimit"
+}
+
+func (l *Limit) Validate(schema graphql.ExecutableSchema) error {
+	return nil
+}
+
+// MutateOperationContext checks the operation once it's parsed and validated. The depth is checked first as it
+// doesn't need to authenticate the caller.
+func (l *Limit) MutateOperationContext(ctx context.Context, rc *graphql.OperationContext) *gqlerror.Error {
+	depth := Depth(rc.Operation.SelectionSet)
+	if depth > l.MaxDepth {
+		gqlErr := ErrQueryTooDeep.CompleteError(ctx, errors.New("operation has a depth of "+strconv.Itoa(depth)+
+			", the limit is "+strconv.Itoa(l.MaxDepth)))
+		gqlErr.Extensions["depth"] = depth
+		gqlErr.Extensions["maxDepth"] = l.MaxDepth
+		return gqlErr
+	}
+
+	userDB := directives.RequestUser(ctx, rc)
+	var permissionSet user.PermissionSet
+	if userDB != nil {
+		// a caller whose permissions fail to load gets the budget of the authenticated users
+		permissionSet, _ = directives.GetPermissions(ctx, userDB.ID)
+	}
+	budget := l.budget(userDB, permissionSet)
+	cost := Cost(rc.Operation.SelectionSet, rc.Variables, l.DefaultListSize)
+	if cost > budget {
+		gqlErr := ErrQueryTooComplex.CompleteError(ctx, errors.New("operation has a cost of "+strconv.Itoa(cost)+
+			", the budget is "+strconv.Itoa(budget)))
+		gqlErr.Extensions["cost"] = cost
+		gqlErr.Extensions["budget"] = budget
+		return gqlErr
+	}
+
+	return nil
+}
+
+// budget is the cost the caller can spend on one operation, userDB is nil for anonymous callers. The admin budget is
+// for the users with the graphql:admin_budget permission.
+func (l *Limit) budget(userDB *user.User, permissionSet user.PermissionSet) int {
+	if userDB == nil {
+		return l.AnonymousBudget
+	} else if permissionSet.Has(user.PermissionGraphQLAdminBudget) {
+		return l.AdminBudget
+	}
+	return l.AuthenticatedBudget
+}
+
+func envInt(key string, defaultValue int) int {
+	value, err := strconv.Atoi(os.Getenv(key))
+	if err != nil {
+		return defaultValue
+	}
+	return value
+}
@@ -0,0 +1,143 @@
+package dataloader
+
+import (
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultWait is how long a batch waits for more keys before being fetched, the resolvers of the fields of a list
+	// run concurrently so they all ask for their key within this delay
+	DefaultWait = 2 * time.Millisecond
+	// DefaultMaxBatch caps the number of keys fetched at once, it keeps the IN clauses of the queries reasonable
+	DefaultMaxBatch = 100
+)
+
+// FetchFunc loads the values of the keys at once, keys without a value are left out of the map
+type FetchFunc func(keys []int) (map[int]interface{}, error)
+
+// Loader batches the keys asked for concurrently into one fetch and caches the values by key. It lives for one request,
+// the values are never refreshed.
+type Loader struct {
+	fetch    FetchFunc
+	wait     time.Duration
+	maxBatch int
+
+	mutex   sync.Mutex
+	results map[int]*loaderResult
+	batch   *loaderBatch
+}
+
+type loaderResult struct {
+	done  chan struct{}
+	value interface{}
+	err   error
+}
+
+type loaderBatch struct {
+	keys       []int
+	results    []*loaderResult
+	dispatched bool
+}
+
+func NewLoader(fetch FetchFunc, wait time.Duration, maxBatch int) *Loader {
+	return &Loader{
+		fetch:    fetch,
+		wait:     wait,
+		maxBatch: maxBatch,
+		results:  map[int]*loaderResult{},
+	}
+}
+
+// Load returns the value of key, nil if it has none. The error of a failing fetch is returned for all its keys and
+// cached like the values.
+func (l *Loader) Load(key int) (interface{}, error) {
+	l.mutex.Lock()
+	result, ok := l.results[key]
+	if !ok {
+		result = &loaderResult{done: make(chan struct{})}
+		l.results[key] = result
+		l.enqueue(key, result)
+	}
+	l.mutex.Unlock()
+
+	<-result.done
+	return result.value, result.err
+}
+
+// LoadMany returns the values of keys in the same order, their missing keys are fetched in the same batches
+func (l *Loader) LoadMany(keys []int) ([]interface{}, error) {
+	values := make([]interface{}, len(keys))
+	errs := make([]error, len(keys))
+
+	var wg sync.WaitGroup
+	for i, key := range keys {
+		wg.Add(1)
+		go func(i int, key int) {
+			defer wg.Done()
+			values[i], errs[i] = l.Load(key)
+		}(i, key)
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+	return values, nil
+}
+
+// Prime caches the value of key if it isn't loaded yet, like the users of a list already fetched by their resolver
+func (l *Loader) Prime(key int, value interface{}) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	if _, ok := l.results[key]; ok {
+		return
+	}
+	result := &loaderResult{done: make(chan struct{}), value: value}
+	close(result.done)
+	l.results[key] = result
+}
+
+// enqueue adds the key to the pending batch, starting one if needed. REQUIRES the mutex to be locked.
+func (l *Loader) enqueue(key int, result *loaderResult) {
+	if l.batch == nil {
+		batch := &loaderBatch{}
+		l.batch = batch
+		time.AfterFunc(l.wait, func() { l.dispatch(batch) })
+	}
+
+	l.batch.keys = append(l.batch.keys, key)
+	l.batch.results = append(l.batch.results, result)
+
+	if len(l.batch.keys) >= l.maxBatch {
+		go l.dispatch(l.batch)
+		l.batch = nil
+	}
+}
+
+// dispatch fetches the keys of the batch, once: a full batch is dispatched before its wait is over
+func (l *Loader) dispatch(batch *loaderBatch) {
+	l.mutex.Lock()
+	if batch.dispatched {
+		l.mutex.Unlock()
+		return
+	}
+	batch.dispatched = true
+	if l.batch == batch {
+		l.batch = nil
+	}
+	l.mutex.Unlock()
+
+	values, err := l.fetch(batch.keys)
+	for i, result := range batch.results {
+		if err != nil {
+			result.err = err
+		} else {
+			result.value = values[batch.keys[i]]
+		}
+		close(result.done)
+	}
+}
@@ -0,0 +1,106 @@
+package dataloader
+
+import (
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/suite"
+	"sync"
+	"testing"
+	"time"
+)
+
+type LoaderSuite struct {
+	suite.Suite
+	mutex   sync.Mutex
+	batches [][]int
+}
+
+func (s *LoaderSuite) SetupTest() {
+	s.batches = nil
+}
+
+// fetch returns the double of the even keys and records the batches
+func (s *LoaderSuite) fetch(keys []int) (map[int]interface{}, error) {
+	s.mutex.Lock()
+	s.batches = append(s.batches, keys)
+	s.mutex.Unlock()
+
+	values := map[int]interface{}{}
+	for _, key := range keys {
+		if key%2 == 0 {
+			values[key] = key * 2
+		}
+	}
+	return values, nil
+}
+
+func (s *LoaderSuite) TestLoad_Batches() {
+	loader := NewLoader(s.fetch, 10*time.Millisecond, DefaultMaxBatch)
+
+	values, err := loader.LoadMany([]int{2, 4, 5, 2})
+	s.Require().NoError(err)
+	s.Assert().Equal([]interface{}{4, 8, nil, 4}, values)
+	s.Require().Len(s.batches, 1)
+	s.Assert().ElementsMatch([]int{2, 4, 5}, s.batches[0])
+}
+
+func (s *LoaderSuite) TestLoad_Caches() {
+	loader := NewLoader(s.fetch, time.Millisecond, DefaultMaxBatch)
+
+	value, err := loader.Load(2)
+	s.Require().NoError(err)
+	s.Assert().Equal(4, value)
+
+	value, err = loader.Load(2)
+	s.Require().NoError(err)
+	s.Assert().Equal(4, value)
+	s.Assert().Len(s.batches, 1)
+}
+
+func (s *LoaderSuite) TestLoad_MaxBatch() {
+	loader := NewLoader(s.fetch, time.Hour, 2)
+
+	values, err := loader.LoadMany([]int{2, 4, 6, 8})
+	s.Require().NoError(err)
+	s.Assert().Equal([]interface{}{4, 8, 12, 16}, values)
+	s.Require().Len(s.batches, 2)
+	s.Assert().Len(s.batches[0], 2)
+	s.Assert().Len(s.batches[1], 2)
+}
+
+func (s *LoaderSuite) TestLoad_Error() {
+	fetchErr := errors.New("fetch failed")
+	calls := 0
+	loader := NewLoader(func(keys []int) (map[int]interface{}, error) {
+		calls++
+		return nil, fetchErr
+	}, time.Millisecond, DefaultMaxBatch)
+
+	_, err := loader.LoadMany([]int{1, 2})
+	s.Assert().ErrorIs(err, fetchErr)
+
+	_, err = loader.Load(1)
+	s.Assert().ErrorIs(err, fetchErr)
+	s.Assert().Equal(1, calls)
+}
+
+func (s *LoaderSuite) TestPrime() {
+	loader := NewLoader(s.fetch, time.Millisecond, DefaultMaxBatch)
+	loader.Prime(3, 9)
+
+	value, err := loader.Load(3)
+	s.Require().NoError(err)
+	s.Assert().Equal(9, value)
+	s.Assert().Empty(s.batches)
+
+	// a loaded value isn't replaced
+	_, err = loader.Load(2)
+	s.Require().NoError(err)
+	loader.Prime(2, 0)
+	value, err = loader.Load(2)
+	s.Require().NoError(err)
+	s.Assert().Equal(4, value)
+}
+
+func TestLoader(t *testing.T) {
+	suite.Run(t, new(LoaderSuite))
+}
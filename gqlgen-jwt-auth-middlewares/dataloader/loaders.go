@@ -0,0 +1,130 @@
+package dataloader
+
+import (
+	"server/api/graphql/graph"
+	"server/internal/user"
+)
+
+// Loaders batch and cache by user ID what the fields of the users of a request load, so a list of users costs one
+// query per field instead of one per user
+type Loaders struct {
+	users        *Loader
+	roles        *Loader
+	addresses    *Loader
+	profiles     *Loader
+	offchainNfts *Loader
+}
+
+func NewLoaders(userRepository *user.Repository) *Loaders {
+	return &Loaders{
+		users: NewLoader(func(userIDs []int) (map[int]interface{}, error) {
+			usersDB, err := userRepository.GetUsers(graph.UsersFilter{Ids: userIDs})
+			if err != nil {
+				return nil, err
+			}
+
+			values := make(map[int]interface{}, len(usersDB))
+			for _, userDB := range usersDB {
+				values[userDB.ID] = userDB
+			}
+			return values, nil
+		}, DefaultWait, DefaultMaxBatch),
+		roles: NewLoader(func(userIDs []int) (map[int]interface{}, error) {
+			rolesDB, err := userRepository.RolesOfUsers(userIDs)
+			if err != nil {
+				return nil, err
+			}
+
+			values := make(map[int]interface{}, len(rolesDB))
+			for userID, userRolesDB := range rolesDB {
+				values[userID] = userRolesDB
+			}
+			return values, nil
+		}, DefaultWait, DefaultMaxBatch),
+		addresses: NewLoader(func(userIDs []int) (map[int]interface{}, error) {
+			addressesDB, err := userRepository.AddressesOfUsers(userIDs)
+			if err != nil {
+				return nil, err
+			}
+
+			values := make(map[int]interface{}, len(addressesDB))
+			for userID, userHasAddressesDB := range addressesDB {
+				values[userID] = userHasAddressesDB
+			}
+			return values, nil
+		}, DefaultWait, DefaultMaxBatch),
+		profiles: NewLoader(func(userIDs []int) (map[int]interface{}, error) {
+			profilesDB, err := userRepository.ProfilesOfUsers(userIDs)
+			if err != nil {
+				return nil, err
+			}
+
+			values := make(map[int]interface{}, len(profilesDB))
+			for userID, profileDB := range profilesDB {
+				values[userID] = profileDB
+			}
+			return values, nil
+		}, DefaultWait, DefaultMaxBatch),
+		offchainNfts: NewLoader(func(userIDs []int) (map[int]interface{}, error) {
+			offchainNftsDB, err := userRepository.OffchainNftsOfUsers(userIDs)
+			if err != nil {
+				return nil, err
+			}
+
+			values := make(map[int]interface{}, len(offchainNftsDB))
+			for userID, userHasOffchainNftsDB := range offchainNftsDB {
+				values[userID] = userHasOffchainNftsDB
+			}
+			return values, nil
+		}, DefaultWait, DefaultMaxBatch),
+	}
+}
+
+// User returns the user, nil if it doesn't exist. Its roles aren't loaded, see Roles.
+func (l *Loaders) User(userID int) (*user.User, error) {
+	value, err := l.users.Load(userID)
+	if err != nil || value == nil {
+		return nil, err
+	}
+	return value.(*user.User), nil
+}
+
+// PrimeUsers caches users already fetched, like the ones of a list, for their fields asking for them again
+func (l *Loaders) PrimeUsers(usersDB []*user.User) {
+	for _, userDB := range usersDB {
+		l.users.Prime(userDB.ID, userDB)
+	}
+}
+
+func (l *Loaders) Roles(userID int) ([]*user.Role, error) {
+	value, err := l.roles.Load(userID)
+	if err != nil || value == nil {
+		return nil, err
+	}
+	return value.([]*user.Role), nil
+}
+
+func (l *Loaders) Addresses(userID int) ([]*user.UserHasAddresses, error) {
+	value, err := l.addresses.Load(userID)
+	if err != nil || value == nil {
+		return nil, err
+	}
+	return value.([]*user.UserHasAddresses), nil
+}
+
+// Profile returns the profile of the user, nil if they haven't filled it
+func (l *Loaders) Profile(userID int) (*user.Profile, error) {
+	value, err := l.profiles.Load(userID)
+	if err != nil || value == nil {
+		return nil, err
+	}
+	return value.(*user.Profile), nil
+}
+
+func (l *Loaders) OffchainNfts(userID int) ([]*user.UserHasOffchainNfts, error) {
+	value, err := l.offchainNfts.Load(userID)
+	if err != nil || value == nil {
+		return nil, err
+	}
+	return value.([]*user.UserHasOffchainNfts), nil
+}
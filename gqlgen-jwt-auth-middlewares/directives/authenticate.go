@@ -3,8 +3,8 @@ package directives
 import (
 	"context"
 	"github.com/99designs/gqlgen/graphql"
-	"github.com/mitchellh/mapstructure"
 	"github.com/pkg/errors"
+	"github.com/vektah/gqlparser/v2/ast"
 	"net/http"
 	"server/api/graphql/graph"
 	"server/api/graphql/grapherrors"
@@ -16,13 +16,24 @@ import (
 )
 
 const (
-	authCookieName   = "Authorization"
-	authCookiePrefix = "Bearer "
+	authHeaderName   = "Authorization"
+	authHeaderScheme = "Bearer "
+)
+
+// CredentialSource is where the credential of the logged user was read from
+type CredentialSource string
+
+const (
+	CredentialCookie CredentialSource = "COOKIE"
+	CredentialBearer CredentialSource = "BEARER"
+	CredentialAPIKey CredentialSource = "API_KEY"
 )
 
 var (
 	ctxKeyLoggedUser = &middleware.ContextKey{Name: "LoggedUser"}
 	ctxKeyJWT        = &middleware.ContextKey{Name: "JWT"}
+	ctxKeyCredentialSource = &middleware.ContextKey{Name: "CredentialSource"}
+	ctxKeyAPIKey = &middleware.ContextKey{Name: "APIKey"}
 	ErrAuthorizationNotValid = grapherrors.NewError("AUTHORIZATION_INVALID")
 	ErrAuthorizationFailed = grapherrors.NewError("AUTHORIZATION_FAILED")
 	ErrAuthorizationNoPermission = grapherrors.NewError("AUTHORIZATION_NO_PERMISSION")
@@ -31,102 +42,201 @@ var (
 
 
 
-func Authenticate(ctx context.Context, obj interface{}, next graphql.Resolver, rules []graph.Rule, enforce *bool) (res interface{}, err error) {
-	dependencies := middleware.GetDependencies(ctx)
+// authentication is the result of reading the credential of the request, it's resolved once per request and shared
+// by every directive
+type authentication struct {
+	user       *user.User
+	source     CredentialSource
+	credential *string
+	apiKey     *user.APIKey
+}
+
+// authenticationError keeps the code the failure is reported with, anonymous tells if the fields that don't enforce
+// authentication can still resolve without a logged user
+type authenticationError struct {
+	code      *grapherrors.Error
+	err       error
+	anonymous bool
+}
+
+func (e *authenticationError) Error() string {
+	return e.err.Error()
+}
+
+const cacheKeyAuthentication = "authentication"
+
+// errNotBearerToken is returned for the Authorization headers of other schemes, like the basic auth of a proxy in
+// front of the API
+var errNotBearerToken = errors.New("authorization header isn't a bearer token")
+
+func Authenticate(ctx context.Context, obj interface{}, next graphql.Resolver, rules []graph.Rule, enforce *bool, match *graph.RuleMatch) (res interface{}, err error) {
 	enforceAsserted := true
 	if enforce != nil && *enforce == false {
 		enforceAsserted = false
 	}
 
-	jwt, err := jwtFromCookie(ctx)
-	if err != nil {
-		if !errors.Is(err, ErrNoJWT) {
-			return nil, ErrAuthorizationFailed.CompleteError(ctx, err)
-		} else {
-			if enforceAsserted {
-				return nil, ErrNoJWT.CompleteError(ctx, errors.New("failed to get authorization cookie"))
-			} else {
-				return next(ctx)
-			}
+	authn, authErr := authenticateRequest(ctx)
+	if authErr != nil {
+		if !enforceAsserted && authErr.anonymous {
+			return next(ctx)
 		}
+		return nil, authErr.code.CompleteError(ctx, authErr.err)
 	}
+	userDB := authn.user
 
-	claims, err := dependencies.Auth.ValidateAndGetClaims(*jwt)
+	ruleMatch := graph.RuleMatchAll
+	if match != nil {
+		ruleMatch = *match
+	}
+	passed, err := Rules.Check(ctx, rules, ruleMatch, userDB, graphql.GetFieldContext(ctx).Args)
 	if err != nil {
-		if !enforceAsserted {
-			return next(ctx)
-		}
-		return nil, ErrAuthorizationNotValid.CompleteError(ctx, err)
+		return nil, ErrAuthorizationFailed.CompleteError(ctx, err)
+	} else if !passed {
+		return nil, ErrAuthorizationNoPermission.CompleteError(ctx, errors.New("user doesn't pass the rules "+rulesListString(rules, ruleMatch)))
 	}
 
-	userDB, err := retrieveUser(claims.UserID, dependencies)
+	return next(authn.withContext(ctx))
+}
+
+// authenticateRequest reads the credential of the request and the user it belongs to, the first directive to ask for
+// it does the lookups and the other fields of the request reuse them
+func authenticateRequest(ctx context.Context) (*authentication, *authenticationError) {
+	dependencies := middleware.GetDependencies(ctx)
+	authn, err := dependencies.Cache.Load(cacheKeyAuthentication, func() (interface{}, error) {
+		authn, authErr := loadAuthentication(ctx, dependencies)
+		if authErr != nil {
+			return nil, authErr
+		}
+		return authn, nil
+	})
 	if err != nil {
-		if !enforceAsserted {
-			return next(ctx)
+		return nil, err.(*authenticationError)
+	}
+	return authn.(*authentication), nil
+}
+
+func loadAuthentication(ctx context.Context, dependencies *middleware.Dependencies) (*authentication, *authenticationError) {
+	credential, source, err := credentialFromRequest(ctx)
+	if errors.Is(err, ErrNoJWT) {
+		return nil, &authenticationError{code: ErrNoJWT, err: errors.New("failed to get authorization header or cookie"), anonymous: true}
+	} else if errors.Is(err, errNotBearerToken) {
+		// the header isn't meant for this API, the fields that don't enforce authentication resolve anonymously
+		return nil, &authenticationError{code: ErrAuthorizationNotValid, err: err, anonymous: true}
+	} else if err != nil {
+		return nil, &authenticationError{code: ErrAuthorizationFailed, err: err}
+	}
+
+	var (
+		userID int
+		claims *auth.Claims
+		apiKey *user.APIKey
+	)
+	if source == CredentialAPIKey {
+		apiKey, err = dependencies.UserRepository.AuthenticateAPIKey(*credential)
+		if err == nil {
+			userID = apiKey.UserID
+			err = checkAPIKeyScope(ctx, apiKey)
 		}
-		return nil, ErrAuthorizationFailed.CompleteError(ctx, err)
+	} else {
+		claims, err = dependencies.Auth.ValidateAndGetClaims(*credential)
+		if err == nil {
+			userID = claims.UserID
+		}
+	}
+	if err != nil {
+		return nil, &authenticationError{code: ErrAuthorizationNotValid, err: err, anonymous: true}
 	}
 
-	for _, rule := range rules {
-		switch rule {
-		case graph.RuleAdminRole:
-			if !userDB.HasRole(user.RoleAdminID) {
-				return nil, ErrAuthorizationNoPermission.CompleteError(ctx, errors.New("only admins have access"))
-			}
-		case graph.RuleDesignerOf:
-			input, ok := graphql.GetFieldContext(ctx).Args["input"]
-			if !ok {
-				return nil, ErrAuthorizationFailed.CompleteError(ctx, errors.New("failed to parse input field from payload"))
-			}
-			var args map[string]interface{}
+	userDB, err := retrieveUser(userID, dependencies)
+	if err != nil {
+		return nil, &authenticationError{code: ErrAuthorizationFailed, err: err, anonymous: true}
+	}
 
-			err = mapstructure.Decode(input, &args)
+	// check if jwt needs to be refreshed, clients using the header refresh their tokens themselves
+	if source == CredentialCookie {
+		timeToLive := time.Unix(claims.Expiration, 0).Sub(time.Now())
+		minimumTimeToLive := dependencies.Auth.TimeToLive.Seconds() * 0.2
+		if timeToLive.Seconds() < minimumTimeToLive {
+			jwt, err := dependencies.Auth.GenerateJWT(&auth.Claims{
+				UserID:     userDB.ID,
+				Expiration: dependencies.Auth.CalculateExpiration(),
+			})
 			if err != nil {
-				return nil, ErrAuthorizationFailed.CompleteError(ctx, errors.New("failed to convert input field to map"))
+				return nil, &authenticationError{code: ErrAuthorizationFailed, err: errors.Wrap(err, "failed to refresh jwt")}
 			}
 
-			nftID, ok := args["NftID"].(int)
-			if !ok {
-				return nil, ErrAuthorizationFailed.CompleteError(ctx, errors.New("no NftID field to check permission"))
-			}
+			httpAccess := middleware.GetHttpAccess(ctx)
+			httpAccess.SetAuthorizationCookie(jwt, dependencies.Auth.TimeToLive)
+		}
+	}
 
-			dependencies := middleware.GetDependencies(ctx)
+	return &authentication{
+		user:       userDB,
+		source:     source,
+		credential: credential,
+		apiKey:     apiKey,
+	}, nil
+}
 
-			nftsDB, err := dependencies.UserRepository.UserCreatedNfts(userDB.ID, []*int{&nftID}, nil)
-			if err != nil {
-				return nil, err
-			} else if len(nftsDB) == 0 {
-				return nil, ErrAuthorizationNoPermission.CompleteError(ctx, errors.New("user is not designer of that nft"))
-			}
-		}
+// RequestUser authenticates the request outside of the fields, like in the extensions of the server, it's nil for
+// anonymous callers and invalid credentials. The directives of the fields reuse the result and report the errors.
+func RequestUser(ctx context.Context, rc *graphql.OperationContext) *user.User {
+	authn, authErr := authenticateRequest(graphql.WithOperationContext(ctx, rc))
+	if authErr != nil {
+		return nil
 	}
+	return authn.user
+}
 
-	// check if jwt needs to be refreshed
-	timeToLive := time.Unix(claims.Expiration, 0).Sub(time.Now())
-	minimumTimeToLive := dependencies.Auth.TimeToLive.Seconds() * 0.2
-	if timeToLive.Seconds() < minimumTimeToLive {
-		jwt, err := dependencies.Auth.GenerateJWT(&auth.Claims{
-			UserID:     userDB.ID,
-			Expiration: dependencies.Auth.CalculateExpiration(),
-		})
-		if err != nil {
-			return nil, ErrAuthorizationFailed.CompleteError(ctx, errors.Wrap(err, "failed to refresh jwt"))
-		}
+// withContext sets the authorizations in the context of the resolver
+func (a *authentication) withContext(ctx context.Context) context.Context {
+	ctx = context.WithValue(ctx, ctxKeyLoggedUser, a.user)
+	ctx = context.WithValue(ctx, ctxKeyCredentialSource, a.source)
+	if a.apiKey != nil {
+		ctx = context.WithValue(ctx, ctxKeyAPIKey, a.apiKey)
+	} else {
+		ctx = context.WithValue(ctx, ctxKeyJWT, a.credential)
+	}
+	return ctx
+}
 
-		httpAccess := middleware.GetHttpAccess(ctx)
-		httpAccess.SetAuthorizationCookie(jwt, dependencies.Auth.TimeToLive)
+// credentialFromRequest reads the Authorization header first, it holds either a JWT or an API key, then the cookie
+func credentialFromRequest(ctx context.Context) (*string, CredentialSource, error) {
+	httpAccess := middleware.GetHttpAccess(ctx)
+	header := httpAccess.Request.Header.Get(authHeaderName)
+	if header == "" {
+		jwt, err := jwtFromCookie(ctx)
+		return jwt, CredentialCookie, err
 	}
 
-	// set authorizations in context
-	ctx = context.WithValue(ctx, ctxKeyLoggedUser, userDB)
-	ctx = context.WithValue(ctx, ctxKeyJWT, jwt)
+	// the scheme is case insensitive (RFC 6750)
+	if len(header) <= len(authHeaderScheme) || !strings.EqualFold(header[:len(authHeaderScheme)], authHeaderScheme) {
+		return nil, "", errNotBearerToken
+	}
+	credential := strings.TrimSpace(header[len(authHeaderScheme):])
 
-	return next(ctx)
+	if strings.HasPrefix(credential, user.APIKeyPrefix) {
+		return &credential, CredentialAPIKey, nil
+	}
+	return &credential, CredentialBearer, nil
+}
+
+// checkAPIKeyScope requires the WRITE scope for mutations and the READ scope for everything else
+func checkAPIKeyScope(ctx context.Context, apiKey *user.APIKey) error {
+	scope := graph.APIKeyScopeRead
+	if graphql.GetOperationContext(ctx).Operation.Operation == ast.Mutation {
+		scope = graph.APIKeyScopeWrite
+	}
+
+	if !apiKey.HasScope(scope.String()) {
+		return errors.New("API key doesn't have the " + scope.String() + " scope")
+	}
+	return nil
 }
 
 func jwtFromCookie(ctx context.Context) (*string, error) {
 	httpAccess := middleware.GetHttpAccess(ctx)
-	authCookie, err := httpAccess.Request.Cookie(authCookieName)
+	authCookie, err := httpAccess.Request.Cookie(httpAccess.CookiePolicy.AuthCookieName())
 	if err != nil {
 		if errors.Is(err, http.ErrNoCookie) {
 			return nil, ErrNoJWT
@@ -137,34 +247,30 @@ func jwtFromCookie(ctx context.Context) (*string, error) {
 		return nil, ErrNoJWT
 	}
 
-	jwt := strings.Replace(authCookie.Value, authCookiePrefix, "", 1)
+	jwt := strings.Replace(authCookie.Value, middleware.AuthCookiePrefix, "", 1)
 
 	return &jwt, nil
 }
 
 func retrieveUser(userID int, dependencies *middleware.Dependencies) (*user.User, error) {
 	// get the user from the database
-	usersDB, err := dependencies.UserRepository.GetUsers(graph.UsersFilter{
-		Ids: []int{
-			userID,
-		},
-	})
-	if len(usersDB) == 0 {
+	cachedUserDB, err := dependencies.Loaders.User(userID)
+	if err != nil {
+		err = errors.Wrap(err, "failed to retrieve user")
+		return nil, err
+	} else if cachedUserDB == nil {
 		return nil, errors.New("user doesn't exist")
 	}
 
-	// get user's roles
-	usersDB[0].Roles, err = dependencies.UserRepository.GetRoles(graph.RolesFilter{
-		User: &graph.RolesUserFilter{
-			ID: &usersDB[0].ID,
-		},
-	})
+	// get user's roles, on a copy as the cached user is shared with the resolvers
+	userDB := *cachedUserDB
+	userDB.Roles, err = dependencies.Loaders.Roles(userID)
 	if err != nil {
 		err = errors.Wrap(err, "failed to retrieve user's roles")
 		return nil, err
 	}
 
-	return usersDB[0], nil
+	return &userDB, nil
 }
 
 // GetLoggedUser finds the user from the context. REQUIRES AuthMiddleware to have run.
@@ -173,6 +279,32 @@ func GetLoggedUser(ctx context.Context) *user.User {
 	return userDB
 }
 
+// GetCredentialSource is empty if no user is logged in
+func GetCredentialSource(ctx context.Context) CredentialSource {
+	source, _ := ctx.Value(ctxKeyCredentialSource).(CredentialSource)
+	return source
+}
+
+// IsCookieRequest tells if the credential of the request is read from the cookie, like for the anonymous requests
+// without an Authorization header whose cookie expired or was revoked
+func IsCookieRequest(ctx context.Context) bool {
+	return middleware.GetHttpAccess(ctx).Request.Header.Get(authHeaderName) == ""
+}
+
+// RequireSession rejects users logged in with an API key, so a leaked key can't be used to manage keys
+func RequireSession(ctx context.Context) error {
+	if GetCredentialSource(ctx) == CredentialAPIKey {
+		return ErrAuthorizationNoPermission.CompleteError(ctx, errors.New("API keys can't be managed with an API key"))
+	}
+	return nil
+}
+
+// GetAPIKey returns the API key the user logged in with, nil if they used a JWT
+func GetAPIKey(ctx context.Context) *user.APIKey {
+	apiKey, _ := ctx.Value(ctxKeyAPIKey).(*user.APIKey)
+	return apiKey
+}
+
 func GetJWT(ctx context.Context) (*string, error) {
 	jwt, ok := ctx.Value(ctxKeyJWT).(*string)
 	if !ok {
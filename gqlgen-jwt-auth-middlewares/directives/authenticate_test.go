@@ -0,0 +1,143 @@
+package directives
+
+import (
+	"context"
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/stretchr/testify/suite"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+	"net/http"
+	"net/http/httptest"
+	"server/api/graphql/middleware"
+	"server/internal/user"
+	"testing"
+)
+
+type AuthenticateSuite struct {
+	suite.Suite
+}
+
+// requestContext is the context the resolvers get for the request
+func (s *AuthenticateSuite) requestContext(request *http.Request) context.Context {
+	cookiePolicy := &middleware.CookiePolicy{
+		Path:       "/",
+		AuthName:   "Authorization",
+		ExpiryName: "Authorization-expiration",
+		CSRFName:   "CSRF-Token",
+		CSRFSecret: []byte("0123456789abcdef0123456789abcdef"),
+	}
+
+	var ctx context.Context
+	handler := middleware.HttpAccessMiddleware(cookiePolicy, &middleware.IPResolver{}, http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			ctx = r.Context()
+		},
+	))
+	handler.ServeHTTP(httptest.NewRecorder(), request)
+	return ctx
+}
+
+func (s *AuthenticateSuite) TestCredentialFromRequest() {
+	for _, test := range []struct {
+		name       string
+		header     string
+		cookie     string
+		credential string
+		source     CredentialSource
+	}{
+		{name: "bearer", header: "Bearer header-jwt", credential: "header-jwt", source: CredentialBearer},
+		{name: "case insensitive scheme", header: "bearer  header-jwt ", credential: "header-jwt", source: CredentialBearer},
+		{name: "API key", header: "Bearer " + user.APIKeyPrefix + "secret", credential: user.APIKeyPrefix + "secret", source: CredentialAPIKey},
+		{name: "cookie", cookie: "Bearer cookie-jwt", credential: "cookie-jwt", source: CredentialCookie},
+		{name: "header before cookie", header: "Bearer header-jwt", cookie: "Bearer cookie-jwt", credential: "header-jwt", source: CredentialBearer},
+	} {
+		s.Run(test.name, func() {
+			request := httptest.NewRequest(http.MethodPost, "/graphql", nil)
+			if test.header != "" {
+				request.Header.Set(authHeaderName, test.header)
+			}
+			if test.cookie != "" {
+				request.AddCookie(&http.Cookie{Name: "Authorization", Value: test.cookie})
+			}
+
+			credential, source, err := credentialFromRequest(s.requestContext(request))
+			s.Require().NoError(err)
+			s.Assert().Equal(test.credential, *credential)
+			s.Assert().Equal(test.source, source)
+		})
+	}
+}
+
+func (s *AuthenticateSuite) TestCredentialFromRequest_NoCredential() {
+	_, _, err := credentialFromRequest(s.requestContext(httptest.NewRequest(http.MethodPost, "/graphql", nil)))
+	s.Assert().ErrorIs(err, ErrNoJWT)
+}
+
+func (s *AuthenticateSuite) TestCredentialFromRequest_NotBearer() {
+	for _, header := range []string{"Basic dXNlcjpwYXNzd29yZA==", "Bearer", "Bearer "} {
+		request := httptest.NewRequest(http.MethodPost, "/graphql", nil)
+		request.Header.Set(authHeaderName, header)
+
+		_, _, err := credentialFromRequest(s.requestContext(request))
+		s.Assert().ErrorIs(err, errNotBearerToken, header)
+	}
+}
+
+func (s *AuthenticateSuite) TestIsCookieRequest() {
+	request := httptest.NewRequest(http.MethodPost, "/graphql", nil)
+	s.Assert().True(IsCookieRequest(s.requestContext(request)), "no credential")
+
+	request.AddCookie(&http.Cookie{Name: "Authorization", Value: "Bearer cookie-jwt"})
+	s.Assert().True(IsCookieRequest(s.requestContext(request)))
+
+	request.Header.Set(authHeaderName, "Bearer header-jwt")
+	s.Assert().False(IsCookieRequest(s.requestContext(request)))
+}
+
+func (s *AuthenticateSuite) TestLoadAuthentication_NotBearerIsAnonymous() {
+	request := httptest.NewRequest(http.MethodPost, "/graphql", nil)
+	request.Header.Set(authHeaderName, "Basic dXNlcjpwYXNzd29yZA==")
+
+	// the header is rejected before the dependencies are used
+	_, authErr := loadAuthentication(s.requestContext(request), nil)
+	s.Require().NotNil(authErr)
+	s.Assert().True(authErr.anonymous)
+	s.Assert().Equal(ErrAuthorizationNotValid, authErr.code)
+}
+
+func (s *AuthenticateSuite) TestCheckAPIKeyScope() {
+	operationContext := func(operation ast.Operation) context.Context {
+		return graphql.WithOperationContext(context.Background(), &graphql.OperationContext{
+			Operation: &ast.OperationDefinition{Operation: operation},
+		})
+	}
+	readKey := &user.APIKey{Scopes: "READ"}
+	writeKey := &user.APIKey{Scopes: "WRITE"}
+	readWriteKey := &user.APIKey{Scopes: "READ,WRITE"}
+
+	s.Assert().NoError(checkAPIKeyScope(operationContext(ast.Query), readKey))
+	s.Assert().NoError(checkAPIKeyScope(operationContext(ast.Query), readWriteKey))
+	s.Assert().EqualError(checkAPIKeyScope(operationContext(ast.Query), writeKey), "API key doesn't have the READ scope")
+
+	s.Assert().NoError(checkAPIKeyScope(operationContext(ast.Mutation), writeKey))
+	s.Assert().NoError(checkAPIKeyScope(operationContext(ast.Mutation), readWriteKey))
+	s.Assert().EqualError(checkAPIKeyScope(operationContext(ast.Mutation), readKey), "API key doesn't have the WRITE scope")
+}
+
+func (s *AuthenticateSuite) TestRequireSession() {
+	loggedUser := &user.User{ID: 1}
+	for _, source := range []CredentialSource{CredentialCookie, CredentialBearer} {
+		authn := &authentication{user: loggedUser, source: source, credential: new(string)}
+		s.Assert().NoError(RequireSession(authn.withContext(context.Background())), source)
+	}
+
+	authn := &authentication{user: loggedUser, source: CredentialAPIKey, apiKey: &user.APIKey{Scopes: "READ,WRITE"}}
+	var gqlErr *gqlerror.Error
+	s.Require().ErrorAs(RequireSession(authn.withContext(context.Background())), &gqlErr)
+	s.Assert().Equal("AUTHORIZATION_NO_PERMISSION", gqlErr.Extensions["code"])
+	s.Assert().Equal("API keys can't be managed with an API key", gqlErr.Message)
+}
+
+func TestAuthenticate(t *testing.T) {
+	suite.Run(t, new(AuthenticateSuite))
+}
@@ -9,44 +9,90 @@ import (
 	"strings"
 )
 
-func Protected(ctx context.Context, obj interface{}, next graphql.Resolver, rules []graph.ProtectedRule) (res interface{}, err error) {
-	userDB := GetLoggedUser(ctx)
+// Protected resolves the field for the logged users passing one of the rules, having one of the roles or one of the
+// permissions. With nullable a denied field resolves to null and the error is added to the response, so the rest of
+// the query resolves.
+func Protected(ctx context.Context, obj interface{}, next graphql.Resolver, rules []graph.ProtectedRule, roles []string, permissions []string, nullable *bool) (res interface{}, err error) {
+	deny := func(err error) (interface{}, error) {
+		gqlErr := ErrAuthorizationNoPermission.CompleteError(ctx, err)
+		if nullable != nil && *nullable {
+			graphql.AddError(ctx, gqlErr)
+			return nil, nil
+		}
+		return nil, gqlErr
+	}
+
+	authn, authErr := authenticateRequest(ctx)
+	if authErr != nil {
+		return deny(errors.New(graphql.GetFieldContext(ctx).Field.Name + " is protected, requires authentication with rules: " + protectedRulesString(rules, roles, permissions)))
+	}
+
+	if passesProtectedRules(authn.user, obj, rules, roles) {
+		return next(authn.withContext(ctx))
+	}
 
-	if userDB == nil  {
-		return nil, ErrAuthorizationNoPermission.CompleteError(
-			ctx,
-			errors.New(graphql.GetFieldContext(ctx).Field.Name + " is protected, requires authentication with rules: " + rulesString(rules)),
-			)
+	// the permissions are only loaded when no rule or role lets the user in
+	if len(permissions) > 0 {
+		permissionSet, err := GetPermissions(ctx, authn.user.ID)
+		if err != nil {
+			return nil, ErrAuthorizationFailed.CompleteError(ctx, err)
+		}
+		if hasOnePermission(permissionSet, permissions) {
+			return next(authn.withContext(ctx))
+		}
 	}
 
-	authorized := false
+	return deny(errors.New(graphql.GetFieldContext(ctx).Field.Name + " field is protected by rules: " + protectedRulesString(rules, roles, permissions)))
+}
 
+func hasOnePermission(permissionSet user.PermissionSet, permissions []string) bool {
+	for _, permission := range permissions {
+		if permissionSet.Has(permission) {
+			return true
+		}
+	}
+	return false
+}
+
+// passesProtectedRules tells if the user passes one of the rules or has one of the roles, parent is the object the
+// field belongs to
+func passesProtectedRules(userDB *user.User, parent interface{}, rules []graph.ProtectedRule, roles []string) bool {
 	for _, rule := range rules {
 		switch rule {
 		case graph.ProtectedRuleAdmin:
 			if userDB.HasRole(user.RoleAdminID) {
-				authorized = true
-				break
+				return true
+			}
+		case graph.ProtectedRuleSelf:
+			if parentUser, ok := parent.(*graph.User); ok && parentUser.ID == userDB.ID {
+				return true
 			}
 		}
 	}
 
-	if !authorized {
-		return nil, ErrAuthorizationNoPermission.CompleteError(
-			ctx,
-			errors.New(graphql.GetFieldContext(ctx).Field.Name + " field is protected by rules: " + rulesString(rules)),
-		)
+	for _, roleName := range roles {
+		for _, role := range userDB.Roles {
+			if strings.EqualFold(role.Name, roleName) {
+				return true
+			}
+		}
 	}
 
-	return next(ctx)
+	return false
 }
 
-func rulesString(rules []graph.ProtectedRule) string {
+func protectedRulesString(rules []graph.ProtectedRule, roles []string, permissions []string) string {
 	var rulesStringArr []string
 
 	for _, rule := range rules {
 		rulesStringArr = append(rulesStringArr, rule.String())
 	}
+	for _, role := range roles {
+		rulesStringArr = append(rulesStringArr, "ROLE("+role+")")
+	}
+	for _, permission := range permissions {
+		rulesStringArr = append(rulesStringArr, "PERMISSION("+permission+")")
+	}
 
 	return strings.Join(rulesStringArr, ", ")
-}
\ No newline at end of file
+}
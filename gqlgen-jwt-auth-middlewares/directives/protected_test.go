@@ -0,0 +1,64 @@
+package directives
+
+import (
+	"github.com/stretchr/testify/suite"
+	"server/api/graphql/graph"
+	"server/internal/user"
+	"testing"
+)
+
+type ProtectedSuite struct {
+	suite.Suite
+}
+
+func (s *ProtectedSuite) TestPassesProtectedRules() {
+	admin := &user.User{ID: 1, Roles: []*user.Role{{ID: user.RoleAdminID, Name: "Admin"}}}
+	designer := &user.User{ID: 2, Roles: []*user.Role{{ID: user.RoleUserID, Name: "User"}, {ID: user.RoleDesignerID, Name: "Designer"}}}
+
+	for _, test := range []struct {
+		name     string
+		user     *user.User
+		parent   interface{}
+		rules    []graph.ProtectedRule
+		roles    []string
+		expected bool
+	}{
+		{name: "admin", user: admin, parent: &graph.User{ID: 2}, rules: []graph.ProtectedRule{graph.ProtectedRuleAdmin}, expected: true},
+		{name: "admin without the role", user: designer, parent: &graph.User{ID: 1}, rules: []graph.ProtectedRule{graph.ProtectedRuleAdmin}},
+		{name: "self", user: designer, parent: &graph.User{ID: 2}, rules: []graph.ProtectedRule{graph.ProtectedRuleSelf}, expected: true},
+		{name: "self of another user", user: designer, parent: &graph.User{ID: 1}, rules: []graph.ProtectedRule{graph.ProtectedRuleSelf}},
+		{name: "self on another type", user: designer, parent: &graph.Role{ID: 2}, rules: []graph.ProtectedRule{graph.ProtectedRuleSelf}},
+		{name: "admin or self as admin", user: admin, parent: &graph.User{ID: 2}, rules: []graph.ProtectedRule{graph.ProtectedRuleAdmin, graph.ProtectedRuleSelf}, expected: true},
+		{name: "admin or self as self", user: designer, parent: &graph.User{ID: 2}, rules: []graph.ProtectedRule{graph.ProtectedRuleAdmin, graph.ProtectedRuleSelf}, expected: true},
+		{name: "role", user: designer, parent: &graph.User{ID: 1}, roles: []string{"designer"}, expected: true},
+		{name: "role without it", user: admin, parent: &graph.User{ID: 2}, roles: []string{"designer"}},
+		{name: "rule or role", user: designer, parent: &graph.User{ID: 1}, rules: []graph.ProtectedRule{graph.ProtectedRuleAdmin}, roles: []string{"Designer"}, expected: true},
+		{name: "no rules", user: admin, parent: &graph.User{ID: 1}},
+		{name: "unknown rule", user: admin, parent: &graph.User{ID: 1}, rules: []graph.ProtectedRule{"EVERYONE"}},
+	} {
+		s.Run(test.name, func() {
+			s.Assert().Equal(test.expected, passesProtectedRules(test.user, test.parent, test.rules, test.roles))
+		})
+	}
+}
+
+func (s *ProtectedSuite) TestHasOnePermission() {
+	permissionSet := user.NewPermissionSet([]string{user.PermissionUsersReadPII})
+
+	s.Assert().True(hasOnePermission(permissionSet, []string{user.PermissionUsersReadPII}))
+	s.Assert().True(hasOnePermission(permissionSet, []string{user.PermissionBlogWrite, user.PermissionUsersReadPII}))
+	s.Assert().False(hasOnePermission(permissionSet, []string{user.PermissionBlogWrite}))
+	s.Assert().False(hasOnePermission(permissionSet, nil))
+}
+
+func (s *ProtectedSuite) TestProtectedRulesString() {
+	s.Assert().Equal("ADMIN, SELF, ROLE(designer), PERMISSION(users:read_pii)", protectedRulesString(
+		[]graph.ProtectedRule{graph.ProtectedRuleAdmin, graph.ProtectedRuleSelf},
+		[]string{"designer"},
+		[]string{user.PermissionUsersReadPII},
+	))
+}
+
+func TestProtected(t *testing.T) {
+	suite.Run(t, new(ProtectedSuite))
+}
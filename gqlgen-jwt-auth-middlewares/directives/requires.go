@@ -0,0 +1,47 @@
+package directives
+
+import (
+	"context"
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/pkg/errors"
+	"server/api/graphql/middleware"
+	"server/internal/user"
+	"strconv"
+	"strings"
+)
+
+// Requires resolves the field only if the logged user has every permission through their roles. It authenticates the
+// request itself, the field doesn't need @authenticate too.
+func Requires(ctx context.Context, obj interface{}, next graphql.Resolver, permissions []string) (res interface{}, err error) {
+	authn, authErr := authenticateRequest(ctx)
+	if authErr != nil {
+		return nil, authErr.code.CompleteError(ctx, authErr.err)
+	}
+
+	permissionSet, err := GetPermissions(ctx, authn.user.ID)
+	if err != nil {
+		return nil, ErrAuthorizationFailed.CompleteError(ctx, err)
+	}
+
+	missing := permissionSet.Missing(permissions)
+	if len(missing) > 0 {
+		return nil, ErrAuthorizationNoPermission.CompleteError(
+			ctx,
+			errors.New(graphql.GetFieldContext(ctx).Field.Name+" requires the permissions: "+strings.Join(missing, ", ")),
+		)
+	}
+
+	return next(authn.withContext(ctx))
+}
+
+// GetPermissions returns the permissions of the user, they're loaded once per request
+func GetPermissions(ctx context.Context, userID int) (user.PermissionSet, error) {
+	dependencies := middleware.GetDependencies(ctx)
+	permissionSet, err := dependencies.Cache.Load("permissions:"+strconv.Itoa(userID), func() (interface{}, error) {
+		return dependencies.UserRepository.GetPermissionSet(userID)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return permissionSet.(user.PermissionSet), nil
+}
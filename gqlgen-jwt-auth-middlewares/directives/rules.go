@@ -0,0 +1,220 @@
+package directives
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"reflect"
+	"server/api/graphql/graph"
+	"server/api/graphql/middleware"
+	"server/internal/user"
+	"strconv"
+	"strings"
+)
+
+var errUnknownRule = errors.New("unknown rule")
+
+// OwnershipChecker tells if the user owns the resource
+type OwnershipChecker func(ctx context.Context, userID int, resourceID int) (bool, error)
+
+// OwnershipRule passes for the users owning the resource whose ID is at Path in the arguments of the field
+type OwnershipRule struct {
+	// Path is the dot separated GraphQL names leading to the ID from the arguments, like input.nftId or id
+	Path  string
+	Check OwnershipChecker
+}
+
+// RuleRegistry tells how each rule of @authenticate is checked, a rule it doesn't know never passes
+type RuleRegistry struct {
+	roles     map[graph.Rule]int
+	ownership map[graph.Rule]OwnershipRule
+}
+
+func NewRuleRegistry() *RuleRegistry {
+	return &RuleRegistry{
+		roles:     map[graph.Rule]int{},
+		ownership: map[graph.Rule]OwnershipRule{},
+	}
+}
+
+// Rules are the rules checked by @authenticate, packages owning a resource can register the rules about it at startup
+var Rules = newDefaultRuleRegistry()
+
+func newDefaultRuleRegistry() *RuleRegistry {
+	registry := NewRuleRegistry()
+	registry.RegisterRole(graph.RuleAdminRole, user.RoleAdminID)
+	registry.RegisterRole(graph.RuleDesignerRole, user.RoleDesignerID)
+	registry.RegisterRole(graph.RuleUserRole, user.RoleUserID)
+	registry.RegisterOwnership(graph.RuleDesignerOf, "input.nftId", isDesignerOf)
+	registry.RegisterOwnership(graph.RuleOwnerOf, "input.nftId", ownsNft)
+	// designer applications are identified by the user who submitted them
+	registry.RegisterOwnership(graph.RuleApplicantOf, "filter.user.id", isUser)
+	return registry
+}
+
+// RegisterRole makes the rule pass for the users with the role
+func (r *RuleRegistry) RegisterRole(rule graph.Rule, roleID int) {
+	r.roles[rule] = roleID
+}
+
+// RegisterOwnership makes the rule pass for the users owning the resource whose ID is at path, see OwnershipRule
+func (r *RuleRegistry) RegisterOwnership(rule graph.Rule, path string, check OwnershipChecker) {
+	r.ownership[rule] = OwnershipRule{
+		Path:  path,
+		Check: check,
+	}
+}
+
+// Check composes the rules, every rule has to pass with RuleMatchAll and at least one with RuleMatchAny. It stops as
+// soon as the result is known, with RuleMatchAny a rule that can't be checked only matters if no other rule passes.
+func (r *RuleRegistry) Check(ctx context.Context, rules []graph.Rule, match graph.RuleMatch, userDB *user.User, args map[string]interface{}) (bool, error) {
+	if len(rules) == 0 {
+		return true, nil
+	}
+
+	var checkErr error
+	for _, rule := range rules {
+		passed, err := r.check(ctx, rule, userDB, args)
+		if err != nil {
+			err = errors.Wrap(err, "failed to check rule "+rule.String())
+		}
+
+		if match == graph.RuleMatchAny {
+			if passed {
+				return true, nil
+			} else if err != nil && checkErr == nil {
+				checkErr = err
+			}
+			continue
+		}
+
+		if err != nil {
+			return false, err
+		} else if !passed {
+			return false, nil
+		}
+	}
+
+	if match == graph.RuleMatchAny {
+		return false, checkErr
+	}
+	return true, nil
+}
+
+// check tells if the user passes the rule, it returns an error when the rule can't be checked
+func (r *RuleRegistry) check(ctx context.Context, rule graph.Rule, userDB *user.User, args map[string]interface{}) (bool, error) {
+	if roleID, ok := r.roles[rule]; ok {
+		return userDB.HasRole(roleID), nil
+	}
+
+	ownershipRule, ok := r.ownership[rule]
+	if !ok {
+		return false, errors.Wrap(errUnknownRule, rule.String())
+	}
+
+	value, err := argumentAt(args, ownershipRule.Path)
+	if err != nil {
+		return false, err
+	}
+	resourceID, err := resourceIDOf(value)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to read "+ownershipRule.Path)
+	}
+
+	return ownershipRule.Check(ctx, userDB.ID, resourceID)
+}
+
+// argumentAt returns the value at the dot separated path in the arguments of a field. Input objects are structs whose
+// json tags are their GraphQL names, they're compared ignoring the case as some tags are spelled nftID.
+func argumentAt(args map[string]interface{}, path string) (interface{}, error) {
+	names := strings.Split(path, ".")
+
+	value, ok := args[names[0]]
+	if !ok {
+		return nil, errors.New("no " + names[0] + " argument")
+	}
+
+	for i, name := range names[1:] {
+		value, ok = fieldOf(value, name)
+		if !ok {
+			return nil, errors.New("no " + strings.Join(names[:i+2], ".") + " argument")
+		}
+	}
+
+	return value, nil
+}
+
+func fieldOf(value interface{}, name string) (interface{}, bool) {
+	if fields, ok := value.(map[string]interface{}); ok {
+		field, ok := fields[name]
+		return field, ok
+	}
+
+	reflected := reflect.ValueOf(value)
+	for reflected.Kind() == reflect.Ptr {
+		if reflected.IsNil() {
+			return nil, false
+		}
+		reflected = reflected.Elem()
+	}
+	if reflected.Kind() != reflect.Struct {
+		return nil, false
+	}
+
+	for i := 0; i < reflected.NumField(); i++ {
+		tag := strings.Split(reflected.Type().Field(i).Tag.Get("json"), ",")[0]
+		if strings.EqualFold(tag, name) {
+			return reflected.Field(i).Interface(), true
+		}
+	}
+	return nil, false
+}
+
+func resourceIDOf(value interface{}) (int, error) {
+	switch id := value.(type) {
+	case int:
+		return id, nil
+	case *int:
+		if id != nil {
+			return *id, nil
+		}
+	case string:
+		return strconv.Atoi(id)
+	case *string:
+		if id != nil {
+			return strconv.Atoi(*id)
+		}
+	default:
+		return 0, errors.Errorf("unsupported id type %T", value)
+	}
+	return 0, errors.New("id is null")
+}
+
+func isDesignerOf(ctx context.Context, userID int, nftID int) (bool, error) {
+	nftsDB, err := middleware.GetDependencies(ctx).UserRepository.UserCreatedNfts(userID, []*int{&nftID}, nil)
+	if err != nil {
+		return false, err
+	}
+	return len(nftsDB) > 0, nil
+}
+
+func ownsNft(ctx context.Context, userID int, nftID int) (bool, error) {
+	return middleware.GetDependencies(ctx).UserRepository.OwnsNft(userID, nftID)
+}
+
+func isUser(ctx context.Context, userID int, resourceUserID int) (bool, error) {
+	return userID == resourceUserID, nil
+}
+
+// rulesListString joins the rules with the operator of match for error messages
+func rulesListString(rules []graph.Rule, match graph.RuleMatch) string {
+	operator := " AND "
+	if match == graph.RuleMatchAny {
+		operator = " OR "
+	}
+
+	names := make([]string, len(rules))
+	for i, rule := range rules {
+		names[i] = rule.String()
+	}
+	return strings.Join(names, operator)
+}
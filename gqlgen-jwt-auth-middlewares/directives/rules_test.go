@@ -0,0 +1,204 @@
+package directives
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/suite"
+	"server/api/graphql/graph"
+	"server/internal/user"
+	"testing"
+)
+
+const (
+	testUserID       = 7
+	testDesignedNft  = 10
+	testOwnedNft     = 20
+	testForeignNft   = 30
+	testUnreachedNft = 40
+)
+
+var errLookup = errors.New("lookup failed")
+
+type nftInput struct {
+	NftID int `json:"nftId"`
+}
+
+type optionalNftInput struct {
+	NftID *int `json:"nftID"`
+}
+
+type applicationsFilter struct {
+	User *struct {
+		ID *int `json:"id"`
+	} `json:"user"`
+}
+
+type RulesSuite struct {
+	suite.Suite
+	registry *RuleRegistry
+}
+
+func (s *RulesSuite) SetupTest() {
+	nftLookup := func(nft int) OwnershipChecker {
+		return func(ctx context.Context, userID int, nftID int) (bool, error) {
+			if nftID == testUnreachedNft {
+				return false, errLookup
+			}
+			return userID == testUserID && nftID == nft, nil
+		}
+	}
+
+	// the default registry with lookups that don't need a DB
+	s.registry = NewRuleRegistry()
+	for rule, roleID := range Rules.roles {
+		s.registry.RegisterRole(rule, roleID)
+	}
+	for rule, ownershipRule := range Rules.ownership {
+		s.registry.RegisterOwnership(rule, ownershipRule.Path, ownershipRule.Check)
+	}
+	s.registry.RegisterOwnership(graph.RuleDesignerOf, Rules.ownership[graph.RuleDesignerOf].Path, nftLookup(testDesignedNft))
+	s.registry.RegisterOwnership(graph.RuleOwnerOf, Rules.ownership[graph.RuleOwnerOf].Path, nftLookup(testOwnedNft))
+}
+
+func withRoles(roleIDs ...int) *user.User {
+	userDB := &user.User{ID: testUserID}
+	for _, roleID := range roleIDs {
+		userDB.Roles = append(userDB.Roles, &user.Role{ID: roleID})
+	}
+	return userDB
+}
+
+func inputArgs(input interface{}) map[string]interface{} {
+	return map[string]interface{}{"input": input}
+}
+
+func applicantArgs(userID *int) map[string]interface{} {
+	filter := &applicationsFilter{}
+	if userID != nil {
+		filter.User = &struct {
+			ID *int `json:"id"`
+		}{ID: userID}
+	}
+	return map[string]interface{}{"filter": filter}
+}
+
+func (s *RulesSuite) TestCheck() {
+	ownedNft := testOwnedNft
+	ownID, otherID := testUserID, testUserID+1
+
+	for _, test := range []struct {
+		name     string
+		rule     graph.Rule
+		user     *user.User
+		args     map[string]interface{}
+		expected bool
+		err      bool
+	}{
+		{name: "admin role", rule: graph.RuleAdminRole, user: withRoles(user.RoleAdminID), expected: true},
+		{name: "admin role without it", rule: graph.RuleAdminRole, user: withRoles(user.RoleUserID, user.RoleDesignerID)},
+		{name: "designer role", rule: graph.RuleDesignerRole, user: withRoles(user.RoleUserID, user.RoleDesignerID), expected: true},
+		{name: "designer role without it", rule: graph.RuleDesignerRole, user: withRoles(user.RoleUserID)},
+		{name: "user role", rule: graph.RuleUserRole, user: withRoles(user.RoleUserID), expected: true},
+		{name: "user role without any role", rule: graph.RuleUserRole, user: withRoles()},
+		{name: "designer of", rule: graph.RuleDesignerOf, user: withRoles(), args: inputArgs(nftInput{NftID: testDesignedNft}), expected: true},
+		{name: "designer of an nft of someone else", rule: graph.RuleDesignerOf, user: withRoles(), args: inputArgs(nftInput{NftID: testForeignNft})},
+		{name: "designer of an owned nft", rule: graph.RuleDesignerOf, user: withRoles(), args: inputArgs(&nftInput{NftID: testOwnedNft})},
+		{name: "designer of without input", rule: graph.RuleDesignerOf, user: withRoles(), err: true},
+		{name: "designer of failing lookup", rule: graph.RuleDesignerOf, user: withRoles(), args: inputArgs(nftInput{NftID: testUnreachedNft}), err: true},
+		{name: "owner of", rule: graph.RuleOwnerOf, user: withRoles(), args: inputArgs(&nftInput{NftID: testOwnedNft}), expected: true},
+		{name: "owner of optional id", rule: graph.RuleOwnerOf, user: withRoles(), args: inputArgs(optionalNftInput{NftID: &ownedNft}), expected: true},
+		{name: "owner of a designed nft", rule: graph.RuleOwnerOf, user: withRoles(), args: inputArgs(nftInput{NftID: testDesignedNft})},
+		{name: "owner of without id", rule: graph.RuleOwnerOf, user: withRoles(), args: inputArgs(optionalNftInput{}), err: true},
+		{name: "owner of failing lookup", rule: graph.RuleOwnerOf, user: withRoles(), args: inputArgs(nftInput{NftID: testUnreachedNft}), err: true},
+		{name: "designer of a map input", rule: graph.RuleDesignerOf, user: withRoles(), args: inputArgs(map[string]interface{}{"nftId": testDesignedNft}), expected: true},
+		{name: "applicant of", rule: graph.RuleApplicantOf, user: withRoles(), args: applicantArgs(&ownID), expected: true},
+		{name: "applicant of someone else", rule: graph.RuleApplicantOf, user: withRoles(), args: applicantArgs(&otherID)},
+		{name: "applicant of without user filter", rule: graph.RuleApplicantOf, user: withRoles(), args: applicantArgs(nil), err: true},
+		{name: "unknown rule", rule: graph.Rule("EVERYONE"), user: withRoles(user.RoleAdminID), err: true},
+	} {
+		s.Run(test.name, func() {
+			passed, err := s.registry.check(context.Background(), test.rule, test.user, test.args)
+			if test.err {
+				s.Assert().Error(err)
+				s.Assert().False(passed)
+				return
+			}
+			s.Assert().NoError(err)
+			s.Assert().Equal(test.expected, passed)
+		})
+	}
+}
+
+// TestCheck_EveryRule makes sure a rule added to the schema is registered before it can be used
+func (s *RulesSuite) TestCheck_EveryRule() {
+	for _, rule := range graph.AllRule {
+		_, err := Rules.check(context.Background(), rule, withRoles(), nil)
+		s.Assert().NotErrorIs(err, errUnknownRule, rule.String())
+	}
+}
+
+func (s *RulesSuite) TestCheck_Composition() {
+	designed := inputArgs(nftInput{NftID: testDesignedNft})
+	unreached := inputArgs(nftInput{NftID: testUnreachedNft})
+
+	for _, test := range []struct {
+		name     string
+		rules    []graph.Rule
+		match    graph.RuleMatch
+		user     *user.User
+		args     map[string]interface{}
+		expected bool
+		err      bool
+	}{
+		{name: "no rules", match: graph.RuleMatchAll, user: withRoles(), expected: true},
+		{name: "all pass", rules: []graph.Rule{graph.RuleDesignerRole, graph.RuleDesignerOf}, match: graph.RuleMatchAll, user: withRoles(user.RoleDesignerID), args: designed, expected: true},
+		{name: "all with one failing", rules: []graph.Rule{graph.RuleDesignerRole, graph.RuleDesignerOf}, match: graph.RuleMatchAll, user: withRoles(), args: designed},
+		{name: "all with one error", rules: []graph.Rule{graph.RuleAdminRole, graph.RuleOwnerOf}, match: graph.RuleMatchAll, user: withRoles(user.RoleAdminID), args: unreached, err: true},
+		{name: "all stops at the first failing rule", rules: []graph.Rule{graph.RuleAdminRole, graph.RuleOwnerOf}, match: graph.RuleMatchAll, user: withRoles(), args: unreached},
+		{name: "any with one passing", rules: []graph.Rule{graph.RuleAdminRole, graph.RuleDesignerOf}, match: graph.RuleMatchAny, user: withRoles(), args: designed, expected: true},
+		{name: "any with none passing", rules: []graph.Rule{graph.RuleAdminRole, graph.RuleOwnerOf}, match: graph.RuleMatchAny, user: withRoles(), args: designed},
+		{name: "any passes over an error", rules: []graph.Rule{graph.RuleOwnerOf, graph.RuleAdminRole}, match: graph.RuleMatchAny, user: withRoles(user.RoleAdminID), args: unreached, expected: true},
+		{name: "any with an error and none passing", rules: []graph.Rule{graph.RuleOwnerOf, graph.RuleAdminRole}, match: graph.RuleMatchAny, user: withRoles(), args: unreached, err: true},
+	} {
+		s.Run(test.name, func() {
+			passed, err := s.registry.Check(context.Background(), test.rules, test.match, test.user, test.args)
+			if test.err {
+				s.Assert().ErrorIs(err, errLookup)
+				s.Assert().False(passed)
+				return
+			}
+			s.Assert().NoError(err)
+			s.Assert().Equal(test.expected, passed)
+		})
+	}
+}
+
+func (s *RulesSuite) TestArgumentAt() {
+	id := 3
+	args := map[string]interface{}{
+		"id":    id,
+		"input": &optionalNftInput{NftID: &id},
+		"empty": (*optionalNftInput)(nil),
+	}
+
+	value, err := argumentAt(args, "id")
+	s.Require().NoError(err)
+	s.Assert().Equal(id, value)
+
+	value, err = argumentAt(args, "input.nftId")
+	s.Require().NoError(err)
+	s.Assert().Equal(&id, value)
+
+	_, err = argumentAt(args, "input.categoryId")
+	s.Assert().EqualError(err, "no input.categoryId argument")
+
+	_, err = argumentAt(args, "empty.nftId")
+	s.Assert().EqualError(err, "no empty.nftId argument")
+
+	_, err = argumentAt(args, "filter")
+	s.Assert().EqualError(err, "no filter argument")
+}
+
+func TestRules(t *testing.T) {
+	suite.Run(t, new(RulesSuite))
+}
@@ -48,13 +48,23 @@ type ResolverRoot interface {
 }
 
 type DirectiveRoot struct {
-	Authenticate func(ctx context.Context, obj interface{}, next graphql.Resolver, rules []Rule, enforce *bool) (res interface{}, err error)
+	Authenticate func(ctx context.Context, obj interface{}, next graphql.Resolver, rules []Rule, enforce *bool, match *RuleMatch) (res interface{}, err error)
 	IntBetween   func(ctx context.Context, obj interface{}, next graphql.Resolver, biggerThan *int, lessThan *int, fieldName string) (res interface{}, err error)
 	Lowercase    func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
-	Protected    func(ctx context.Context, obj interface{}, next graphql.Resolver, rules []ProtectedRule) (res interface{}, err error)
+	Protected    func(ctx context.Context, obj interface{}, next graphql.Resolver, rules []ProtectedRule, roles []string, permissions []string, nullable *bool) (res interface{}, err error)
+	Requires     func(ctx context.Context, obj interface{}, next graphql.Resolver, permissions []string) (res interface{}, err error)
 }
 
 type ComplexityRoot struct {
+	APIKey struct {
+		CreationTime func(childComplexity int) int
+		ID           func(childComplexity int) int
+		LastUsedTime func(childComplexity int) int
+		Name         func(childComplexity int) int
+		Prefix       func(childComplexity int) int
+		Scopes       func(childComplexity int) int
+	}
+
 	Address struct {
 		Country     func(childComplexity int) int
 		CountryCode func(childComplexity int) int
@@ -142,6 +152,11 @@ type ComplexityRoot struct {
 		StripeCheckoutLink func(childComplexity int) int
 	}
 
+	CreatedAPIKey struct {
+		APIKey func(childComplexity int) int
+		Key    func(childComplexity int) int
+	}
+
 	Currency struct {
 		Code      func(childComplexity int) int
 		Countries func(childComplexity int) int
@@ -153,42 +168,83 @@ type ComplexityRoot struct {
 		UserID     func(childComplexity int) int
 	}
 
+	EmailPreview struct {
+		From    func(childComplexity int) int
+		HTML    func(childComplexity int) int
+		Subject func(childComplexity int) int
+		Text    func(childComplexity int) int
+	}
+
 	FromTo struct {
 		Address func(childComplexity int) int
 		User    func(childComplexity int) int
 	}
 
+	Job struct {
+		Attempts     func(childComplexity int) int
+		CreationTime func(childComplexity int) int
+		DeadTime     func(childComplexity int) int
+		ID           func(childComplexity int) int
+		LastError    func(childComplexity int) int
+		Payload      func(childComplexity int) int
+		Type         func(childComplexity int) int
+	}
+
 	Mutation struct {
 		AssignOffChainNfts               func(childComplexity int, input AssignOffChainNftsInput) int
 		AssociateAddressEnd              func(childComplexity int, input *AssociateAddressEnd) int
 		AssociateAddressInitialize       func(childComplexity int, input *AssociateAddressInitialize) int
 		Buy                              func(childComplexity int, input *BuyInput) int
+		CancelAccountDeletion            func(childComplexity int) int
 		ConfirmEmail                     func(childComplexity int, input ConfirmEmailInput) int
+		ConfirmEmailChange               func(childComplexity int, input ConfirmEmailChangeInput) int
+		CreateAPIKey                     func(childComplexity int, input CreateAPIKeyInput) int
 		CreateBatchTransferAuthorization func(childComplexity int, input *CreateBatchTransferAuthorizationInput) int
 		CreateBlogPost                   func(childComplexity int, input *CreateBlogPostInput) int
 		CreateBuyAuthorization           func(childComplexity int, input *CreateBuyAuthorizationInput) int
 		CreateCard                       func(childComplexity int, input *CreateCardInput) int
 		CreateIPFSHash                   func(childComplexity int, input CreateIPFSHashInput) int
 		CreatePaymentIntent              func(childComplexity int, input *CreatePaymentIntentInput) int
+		CreatePermission                 func(childComplexity int, input CreatePermissionInput) int
+		CreateRole                       func(childComplexity int, input CreateRoleInput) int
 		CreateStripeCheckoutSession      func(childComplexity int, input *CreateStripeCheckoutLinkInput) int
 		CreateTransferAuthorization      func(childComplexity int, input *CreateTransferAuthorizationInput) int
 		CreateUser                       func(childComplexity int, input CreateUserInput) int
 		DeleteBlogPost                   func(childComplexity int, input *DeleteBlogPostInput) int
+		DeleteMyAccount                  func(childComplexity int, input DeleteMyAccountInput) int
+		DeletePermission                 func(childComplexity int, input DeletePermissionInput) int
+		DeleteRole                       func(childComplexity int, input DeleteRoleInput) int
+		ExportMyData                     func(childComplexity int, input ExportMyDataInput) int
 		ForgotPasswordEnd                func(childComplexity int, input *ForgotPasswordEnd) int
 		ForgotPasswordInitialize         func(childComplexity int, input *ForgotPasswordInitialize) int
 		FulfillPaymentIntent             func(childComplexity int, input *FulfillPaymentIntentInput) int
 		Login                            func(childComplexity int, input *LoginInput) int
 		LoginBlockchainEnd               func(childComplexity int, input *LoginBlockchainEndInput) int
-		LoginBlockchainInitialize        func(childComplexity int) int
+		LoginBlockchainInitialize        func(childComplexity int, input LoginBlockchainInitializeInput) int
+		LoginPasskeyEnd                  func(childComplexity int, input LoginPasskeyEndInput) int
+		LoginPasskeyInitialize           func(childComplexity int) int
 		Logout                           func(childComplexity int) int
+		RegisterPasskeyEnd               func(childComplexity int, input RegisterPasskeyEndInput) int
+		RegisterPasskeyInitialize        func(childComplexity int) int
+		RequestEmailChange               func(childComplexity int, input RequestEmailChangeInput) int
 		ResendConfirmationEmail          func(childComplexity int, input *ResendConfirmationEmailInput) int
 		ResolveDesignerApplication       func(childComplexity int, input *ResolveDesignerApplicationInput) int
+		RetryJob                         func(childComplexity int, input RetryJobInput) int
+		RevokeAPIKey                     func(childComplexity int, input RevokeAPIKeyInput) int
 		SaveCreationIntent               func(childComplexity int, input SaveCreationIntentInput) int
 		SetFilter                        func(childComplexity int, input SaveFilter) int
+		SetLocale                        func(childComplexity int, input SetLocaleInput) int
 		SetRole                          func(childComplexity int, input *SetRoleInput) int
+		SetRolePermission                func(childComplexity int, input SetRolePermissionInput) int
+		SocialLoginEnd                   func(childComplexity int, input SocialLoginEndInput) int
+		SocialLoginInitialize            func(childComplexity int, input SocialLoginInitializeInput) int
+		SocialReauthenticateEnd          func(childComplexity int, input SocialLoginEndInput) int
+		SocialReauthenticateInitialize   func(childComplexity int, input SocialLoginInitializeInput) int
 		SubmitDesignerApplication        func(childComplexity int) int
 		Subscribe                        func(childComplexity int, input *SubscriptionInput) int
 		ToggleCategory                   func(childComplexity int, input ToggleCategory) int
+		UnlinkSocialLogin                func(childComplexity int, input UnlinkSocialLoginInput) int
+		UnlockLogin                      func(childComplexity int, input UnlockLoginInput) int
 		Unsubscribe                      func(childComplexity int, input *UnsubscribeInput) int
 		UpdateOmnisendContacts           func(childComplexity int) int
 		UpdateOmnisendProducts           func(childComplexity int) int
@@ -228,11 +284,24 @@ type ComplexityRoot struct {
 		User      func(childComplexity int) int
 	}
 
+	PageInfo struct {
+		EndCursor       func(childComplexity int) int
+		HasNextPage     func(childComplexity int) int
+		HasPreviousPage func(childComplexity int) int
+		StartCursor     func(childComplexity int) int
+	}
+
 	PaymentIntent struct {
 		ClientSecret func(childComplexity int) int
 		ID           func(childComplexity int) int
 	}
 
+	Permission struct {
+		Description func(childComplexity int) int
+		ID          func(childComplexity int) int
+		Name        func(childComplexity int) int
+	}
+
 	Profile struct {
 		Description func(childComplexity int) int
 		Image       func(childComplexity int) int
@@ -242,16 +311,22 @@ type ComplexityRoot struct {
 		BlogPosts                  func(childComplexity int, filter BlogPostsFilter) int
 		Categories                 func(childComplexity int) int
 		CreateStripeAccountLink    func(childComplexity int, input *CreateStripeAccountLinkInput) int
+		DeadJobs                   func(childComplexity int, pagination *Pagination) int
 		DesignerApplications       func(childComplexity int, filter DesignerApplicationsFilter) int
+		EmailTemplates             func(childComplexity int) int
 		GetBankAccount             func(childComplexity int) int
 		GetBankAccountRequirements func(childComplexity int) int
+		MyAPIKeys                  func(childComplexity int) int
 		Nfts                       func(childComplexity int, filter NftsFilter) int
 		OffchainNfts               func(childComplexity int) int
+		Permissions                func(childComplexity int, filter *PermissionsFilter) int
+		PreviewEmail               func(childComplexity int, input PreviewEmailInput) int
 		Roles                      func(childComplexity int, filter RolesFilter) int
 		SendEvent                  func(childComplexity int, input *SendEventInput) int
 		SendSuccessfulBuyEmail     func(childComplexity int, input SendSuccessfulBuyEmailInput) int
 		Subscriptions              func(childComplexity int, filter SubscriptionsFilter) int
 		Users                      func(childComplexity int, filter UsersFilter) int
+		UsersConnection            func(childComplexity int, filter UsersFilter, first *int, after *string, last *int, before *string) int
 		ValidateEmail              func(childComplexity int, email string) int
 	}
 
@@ -307,6 +382,16 @@ type ComplexityRoot struct {
 		StripeTransferCapabilityStatus func(childComplexity int) int
 	}
 
+	UserConnection struct {
+		Edges    func(childComplexity int) int
+		PageInfo func(childComplexity int) int
+	}
+
+	UserEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
+	}
+
 	UserHasNfts struct {
 		Address  func(childComplexity int) int
 		Nft      func(childComplexity int) int
@@ -329,14 +414,26 @@ type DesignerApplicationResolver interface {
 	User(ctx context.Context, obj *DesignerApplication) (*User, error)
 }
 type MutationResolver interface {
+	CreateAPIKey(ctx context.Context, input CreateAPIKeyInput) (*CreatedAPIKey, error)
+	RevokeAPIKey(ctx context.Context, input RevokeAPIKeyInput) (*string, error)
 	Login(ctx context.Context, input *LoginInput) (*Authentication, error)
-	LoginBlockchainInitialize(ctx context.Context) (*string, error)
+	LoginBlockchainInitialize(ctx context.Context, input LoginBlockchainInitializeInput) (*string, error)
 	LoginBlockchainEnd(ctx context.Context, input *LoginBlockchainEndInput) (*Authentication, error)
+	LoginPasskeyInitialize(ctx context.Context) (*string, error)
+	LoginPasskeyEnd(ctx context.Context, input LoginPasskeyEndInput) (*Authentication, error)
+	SocialLoginInitialize(ctx context.Context, input SocialLoginInitializeInput) (*string, error)
+	SocialLoginEnd(ctx context.Context, input SocialLoginEndInput) (*Authentication, error)
+	UnlinkSocialLogin(ctx context.Context, input UnlinkSocialLoginInput) (*string, error)
+	SocialReauthenticateInitialize(ctx context.Context, input SocialLoginInitializeInput) (*string, error)
+	SocialReauthenticateEnd(ctx context.Context, input SocialLoginEndInput) (*string, error)
 	Logout(ctx context.Context) (*string, error)
 	ForgotPasswordInitialize(ctx context.Context, input *ForgotPasswordInitialize) (*string, error)
 	ForgotPasswordEnd(ctx context.Context, input *ForgotPasswordEnd) (*string, error)
+	UnlockLogin(ctx context.Context, input UnlockLoginInput) (*string, error)
 	AssociateAddressInitialize(ctx context.Context, input *AssociateAddressInitialize) (*string, error)
 	AssociateAddressEnd(ctx context.Context, input *AssociateAddressEnd) (*string, error)
+	RegisterPasskeyInitialize(ctx context.Context) (*string, error)
+	RegisterPasskeyEnd(ctx context.Context, input RegisterPasskeyEndInput) (*string, error)
 	CreateTransferAuthorization(ctx context.Context, input *CreateTransferAuthorizationInput) (*AuthorizationTransferResponse, error)
 	CreateBatchTransferAuthorization(ctx context.Context, input *CreateBatchTransferAuthorizationInput) (*AuthorizationBatchResponse, error)
 	CreateBuyAuthorization(ctx context.Context, input *CreateBuyAuthorizationInput) (*AuthorizationBuyResponse, error)
@@ -344,12 +441,18 @@ type MutationResolver interface {
 	DeleteBlogPost(ctx context.Context, input *DeleteBlogPostInput) (*string, error)
 	SubmitDesignerApplication(ctx context.Context) (*string, error)
 	ResolveDesignerApplication(ctx context.Context, input *ResolveDesignerApplicationInput) (*string, error)
+	RetryJob(ctx context.Context, input RetryJobInput) (*string, error)
 	ToggleCategory(ctx context.Context, input ToggleCategory) (*Category, error)
 	CreateIPFSHash(ctx context.Context, input CreateIPFSHashInput) (*string, error)
 	SetFilter(ctx context.Context, input SaveFilter) (*string, error)
 	SaveCreationIntent(ctx context.Context, input SaveCreationIntentInput) (*string, error)
 	UpdateOmnisendProducts(ctx context.Context) (*string, error)
 	UpdateOmnisendContacts(ctx context.Context) (*string, error)
+	CreateRole(ctx context.Context, input CreateRoleInput) (*Role, error)
+	DeleteRole(ctx context.Context, input DeleteRoleInput) (*string, error)
+	CreatePermission(ctx context.Context, input CreatePermissionInput) (*Permission, error)
+	DeletePermission(ctx context.Context, input DeletePermissionInput) (*string, error)
+	SetRolePermission(ctx context.Context, input SetRolePermissionInput) (*string, error)
 	UpsertSale(ctx context.Context, input *UpsertSaleInput) (string, error)
 	CreateCard(ctx context.Context, input *CreateCardInput) (string, error)
 	CreatePaymentIntent(ctx context.Context, input *CreatePaymentIntentInput) (*PaymentIntent, error)
@@ -362,8 +465,14 @@ type MutationResolver interface {
 	CreateUser(ctx context.Context, input CreateUserInput) (*User, error)
 	ConfirmEmail(ctx context.Context, input ConfirmEmailInput) (*string, error)
 	ResendConfirmationEmail(ctx context.Context, input *ResendConfirmationEmailInput) (*string, error)
+	RequestEmailChange(ctx context.Context, input RequestEmailChangeInput) (*string, error)
+	ConfirmEmailChange(ctx context.Context, input ConfirmEmailChangeInput) (*string, error)
+	DeleteMyAccount(ctx context.Context, input DeleteMyAccountInput) (*string, error)
+	CancelAccountDeletion(ctx context.Context) (*string, error)
+	ExportMyData(ctx context.Context, input ExportMyDataInput) (*string, error)
 	SetRole(ctx context.Context, input *SetRoleInput) (*string, error)
 	UpdateProfile(ctx context.Context, input *ProfileInput) (*string, error)
+	SetLocale(ctx context.Context, input SetLocaleInput) (*string, error)
 	AssignOffChainNfts(ctx context.Context, input AssignOffChainNftsInput) (*string, error)
 }
 type NftResolver interface {
@@ -379,18 +488,24 @@ type NftResolver interface {
 	Attributes(ctx context.Context, obj *Nft) ([]*Attribute, error)
 }
 type QueryResolver interface {
+	MyAPIKeys(ctx context.Context) ([]*APIKey, error)
 	BlogPosts(ctx context.Context, filter BlogPostsFilter) ([]*BlogPost, error)
 	Categories(ctx context.Context) ([]*Category, error)
 	SendEvent(ctx context.Context, input *SendEventInput) (*string, error)
 	DesignerApplications(ctx context.Context, filter DesignerApplicationsFilter) ([]*DesignerApplication, error)
+	DeadJobs(ctx context.Context, pagination *Pagination) ([]*Job, error)
 	SendSuccessfulBuyEmail(ctx context.Context, input SendSuccessfulBuyEmailInput) (*string, error)
+	EmailTemplates(ctx context.Context) ([]string, error)
+	PreviewEmail(ctx context.Context, input PreviewEmailInput) (*EmailPreview, error)
 	Nfts(ctx context.Context, filter NftsFilter) ([]*Nft, error)
 	Roles(ctx context.Context, filter RolesFilter) ([]*Role, error)
+	Permissions(ctx context.Context, filter *PermissionsFilter) ([]*Permission, error)
 	CreateStripeAccountLink(ctx context.Context, input *CreateStripeAccountLinkInput) (string, error)
 	GetBankAccountRequirements(ctx context.Context) (*BankAccountRequirements, error)
 	GetBankAccount(ctx context.Context) (*BankAccount, error)
 	Subscriptions(ctx context.Context, filter SubscriptionsFilter) ([]*UserSubscription, error)
 	Users(ctx context.Context, filter UsersFilter) (*UsersResult, error)
+	UsersConnection(ctx context.Context, filter UsersFilter, first *int, after *string, last *int, before *string) (*UserConnection, error)
 	OffchainNfts(ctx context.Context) ([]*OffchainNft, error)
 	ValidateEmail(ctx context.Context, email string) (*string, error)
 }
@@ -430,6 +545,48 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 	_ = ec
 	switch typeName + "." + field {
 
+	case "APIKey.creationTime":
+		if e.complexity.APIKey.CreationTime == nil {
+			break
+		}
+
+		return e.complexity.APIKey.CreationTime(childComplexity), true
+
+	case "APIKey.id":
+		if e.complexity.APIKey.ID == nil {
+			break
+		}
+
+		return e.complexity.APIKey.ID(childComplexity), true
+
+	case "APIKey.lastUsedTime":
+		if e.complexity.APIKey.LastUsedTime == nil {
+			break
+		}
+
+		return e.complexity.APIKey.LastUsedTime(childComplexity), true
+
+	case "APIKey.name":
+		if e.complexity.APIKey.Name == nil {
+			break
+		}
+
+		return e.complexity.APIKey.Name(childComplexity), true
+
+	case "APIKey.prefix":
+		if e.complexity.APIKey.Prefix == nil {
+			break
+		}
+
+		return e.complexity.APIKey.Prefix(childComplexity), true
+
+	case "APIKey.scopes":
+		if e.complexity.APIKey.Scopes == nil {
+			break
+		}
+
+		return e.complexity.APIKey.Scopes(childComplexity), true
+
 	case "Address.country":
 		if e.complexity.Address.Country == nil {
 			break
@@ -745,6 +902,20 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.CreateStripeCheckoutResponse.StripeCheckoutLink(childComplexity), true
 
+	case "CreatedAPIKey.apiKey":
+		if e.complexity.CreatedAPIKey.APIKey == nil {
+			break
+		}
+
+		return e.complexity.CreatedAPIKey.APIKey(childComplexity), true
+
+	case "CreatedAPIKey.key":
+		if e.complexity.CreatedAPIKey.Key == nil {
+			break
+		}
+
+		return e.complexity.CreatedAPIKey.Key(childComplexity), true
+
 	case "Currency.code":
 		if e.complexity.Currency.Code == nil {
 			break
@@ -780,6 +951,34 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.DesignerApplication.UserID(childComplexity), true
 
+	case "EmailPreview.from":
+		if e.complexity.EmailPreview.From == nil {
+			break
+		}
+
+		return e.complexity.EmailPreview.From(childComplexity), true
+
+	case "EmailPreview.html":
+		if e.complexity.EmailPreview.HTML == nil {
+			break
+		}
+
+		return e.complexity.EmailPreview.HTML(childComplexity), true
+
+	case "EmailPreview.subject":
+		if e.complexity.EmailPreview.Subject == nil {
+			break
+		}
+
+		return e.complexity.EmailPreview.Subject(childComplexity), true
+
+	case "EmailPreview.text":
+		if e.complexity.EmailPreview.Text == nil {
+			break
+		}
+
+		return e.complexity.EmailPreview.Text(childComplexity), true
+
 	case "FromTo.address":
 		if e.complexity.FromTo.Address == nil {
 			break
@@ -794,6 +993,55 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.FromTo.User(childComplexity), true
 
+	case "Job.attempts":
+		if e.complexity.Job.Attempts == nil {
+			break
+		}
+
+		return e.complexity.Job.Attempts(childComplexity), true
+
+	case "Job.creationTime":
+		if e.complexity.Job.CreationTime == nil {
+			break
+		}
+
+		return e.complexity.Job.CreationTime(childComplexity), true
+
+	case "Job.deadTime":
+		if e.complexity.Job.DeadTime == nil {
+			break
+		}
+
+		return e.complexity.Job.DeadTime(childComplexity), true
+
+	case "Job.id":
+		if e.complexity.Job.ID == nil {
+			break
+		}
+
+		return e.complexity.Job.ID(childComplexity), true
+
+	case "Job.lastError":
+		if e.complexity.Job.LastError == nil {
+			break
+		}
+
+		return e.complexity.Job.LastError(childComplexity), true
+
+	case "Job.payload":
+		if e.complexity.Job.Payload == nil {
+			break
+		}
+
+		return e.complexity.Job.Payload(childComplexity), true
+
+	case "Job.type":
+		if e.complexity.Job.Type == nil {
+			break
+		}
+
+		return e.complexity.Job.Type(childComplexity), true
+
 	case "Mutation.assignOffChainNfts":
 		if e.complexity.Mutation.AssignOffChainNfts == nil {
 			break
@@ -842,6 +1090,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Mutation.Buy(childComplexity, args["input"].(*BuyInput)), true
 
+	case "Mutation.cancelAccountDeletion":
+		if e.complexity.Mutation.CancelAccountDeletion == nil {
+			break
+		}
+
+		return e.complexity.Mutation.CancelAccountDeletion(childComplexity), true
+
 	case "Mutation.confirmEmail":
 		if e.complexity.Mutation.ConfirmEmail == nil {
 			break
@@ -854,6 +1109,30 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Mutation.ConfirmEmail(childComplexity, args["input"].(ConfirmEmailInput)), true
 
+	case "Mutation.confirmEmailChange":
+		if e.complexity.Mutation.ConfirmEmailChange == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_confirmEmailChange_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.ConfirmEmailChange(childComplexity, args["input"].(ConfirmEmailChangeInput)), true
+
+	case "Mutation.createAPIKey":
+		if e.complexity.Mutation.CreateAPIKey == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_createAPIKey_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.CreateAPIKey(childComplexity, args["input"].(CreateAPIKeyInput)), true
+
 	case "Mutation.createBatchTransferAuthorization":
 		if e.complexity.Mutation.CreateBatchTransferAuthorization == nil {
 			break
@@ -926,6 +1205,30 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Mutation.CreatePaymentIntent(childComplexity, args["input"].(*CreatePaymentIntentInput)), true
 
+	case "Mutation.createPermission":
+		if e.complexity.Mutation.CreatePermission == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_createPermission_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.CreatePermission(childComplexity, args["input"].(CreatePermissionInput)), true
+
+	case "Mutation.createRole":
+		if e.complexity.Mutation.CreateRole == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_createRole_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.CreateRole(childComplexity, args["input"].(CreateRoleInput)), true
+
 	case "Mutation.createStripeCheckoutSession":
 		if e.complexity.Mutation.CreateStripeCheckoutSession == nil {
 			break
@@ -974,6 +1277,54 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Mutation.DeleteBlogPost(childComplexity, args["input"].(*DeleteBlogPostInput)), true
 
+	case "Mutation.deleteMyAccount":
+		if e.complexity.Mutation.DeleteMyAccount == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_deleteMyAccount_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.DeleteMyAccount(childComplexity, args["input"].(DeleteMyAccountInput)), true
+
+	case "Mutation.deletePermission":
+		if e.complexity.Mutation.DeletePermission == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_deletePermission_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.DeletePermission(childComplexity, args["input"].(DeletePermissionInput)), true
+
+	case "Mutation.deleteRole":
+		if e.complexity.Mutation.DeleteRole == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_deleteRole_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.DeleteRole(childComplexity, args["input"].(DeleteRoleInput)), true
+
+	case "Mutation.exportMyData":
+		if e.complexity.Mutation.ExportMyData == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_exportMyData_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.ExportMyData(childComplexity, args["input"].(ExportMyDataInput)), true
+
 	case "Mutation.forgotPasswordEnd":
 		if e.complexity.Mutation.ForgotPasswordEnd == nil {
 			break
@@ -1039,7 +1390,31 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 			break
 		}
 
-		return e.complexity.Mutation.LoginBlockchainInitialize(childComplexity), true
+		args, err := ec.field_Mutation_loginBlockchainInitialize_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.LoginBlockchainInitialize(childComplexity, args["input"].(LoginBlockchainInitializeInput)), true
+
+	case "Mutation.loginPasskeyEnd":
+		if e.complexity.Mutation.LoginPasskeyEnd == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_loginPasskeyEnd_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.LoginPasskeyEnd(childComplexity, args["input"].(LoginPasskeyEndInput)), true
+
+	case "Mutation.loginPasskeyInitialize":
+		if e.complexity.Mutation.LoginPasskeyInitialize == nil {
+			break
+		}
+
+		return e.complexity.Mutation.LoginPasskeyInitialize(childComplexity), true
 
 	case "Mutation.logout":
 		if e.complexity.Mutation.Logout == nil {
@@ -1048,6 +1423,37 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Mutation.Logout(childComplexity), true
 
+	case "Mutation.registerPasskeyEnd":
+		if e.complexity.Mutation.RegisterPasskeyEnd == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_registerPasskeyEnd_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.RegisterPasskeyEnd(childComplexity, args["input"].(RegisterPasskeyEndInput)), true
+
+	case "Mutation.registerPasskeyInitialize":
+		if e.complexity.Mutation.RegisterPasskeyInitialize == nil {
+			break
+		}
+
+		return e.complexity.Mutation.RegisterPasskeyInitialize(childComplexity), true
+
+	case "Mutation.requestEmailChange":
+		if e.complexity.Mutation.RequestEmailChange == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_requestEmailChange_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.RequestEmailChange(childComplexity, args["input"].(RequestEmailChangeInput)), true
+
 	case "Mutation.resendConfirmationEmail":
 		if e.complexity.Mutation.ResendConfirmationEmail == nil {
 			break
@@ -1072,6 +1478,30 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Mutation.ResolveDesignerApplication(childComplexity, args["input"].(*ResolveDesignerApplicationInput)), true
 
+	case "Mutation.retryJob":
+		if e.complexity.Mutation.RetryJob == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_retryJob_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.RetryJob(childComplexity, args["input"].(RetryJobInput)), true
+
+	case "Mutation.revokeAPIKey":
+		if e.complexity.Mutation.RevokeAPIKey == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_revokeAPIKey_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.RevokeAPIKey(childComplexity, args["input"].(RevokeAPIKeyInput)), true
+
 	case "Mutation.saveCreationIntent":
 		if e.complexity.Mutation.SaveCreationIntent == nil {
 			break
@@ -1096,6 +1526,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Mutation.SetFilter(childComplexity, args["input"].(SaveFilter)), true
 
+	case "Mutation.setLocale":
+		if e.complexity.Mutation.SetLocale == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_setLocale_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.SetLocale(childComplexity, args["input"].(SetLocaleInput)), true
+
 	case "Mutation.setRole":
 		if e.complexity.Mutation.SetRole == nil {
 			break
@@ -1108,62 +1550,146 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Mutation.SetRole(childComplexity, args["input"].(*SetRoleInput)), true
 
-	case "Mutation.submitDesignerApplication":
-		if e.complexity.Mutation.SubmitDesignerApplication == nil {
+	case "Mutation.setRolePermission":
+		if e.complexity.Mutation.SetRolePermission == nil {
 			break
 		}
 
-		return e.complexity.Mutation.SubmitDesignerApplication(childComplexity), true
+		args, err := ec.field_Mutation_setRolePermission_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-	case "Mutation.subscribe":
-		if e.complexity.Mutation.Subscribe == nil {
+		return e.complexity.Mutation.SetRolePermission(childComplexity, args["input"].(SetRolePermissionInput)), true
+
+	case "Mutation.socialLoginEnd":
+		if e.complexity.Mutation.SocialLoginEnd == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_subscribe_args(context.TODO(), rawArgs)
+		args, err := ec.field_Mutation_socialLoginEnd_args(context.TODO(), rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Mutation.Subscribe(childComplexity, args["input"].(*SubscriptionInput)), true
+		return e.complexity.Mutation.SocialLoginEnd(childComplexity, args["input"].(SocialLoginEndInput)), true
 
-	case "Mutation.toggleCategory":
-		if e.complexity.Mutation.ToggleCategory == nil {
+	case "Mutation.socialLoginInitialize":
+		if e.complexity.Mutation.SocialLoginInitialize == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_toggleCategory_args(context.TODO(), rawArgs)
+		args, err := ec.field_Mutation_socialLoginInitialize_args(context.TODO(), rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Mutation.ToggleCategory(childComplexity, args["input"].(ToggleCategory)), true
+		return e.complexity.Mutation.SocialLoginInitialize(childComplexity, args["input"].(SocialLoginInitializeInput)), true
 
-	case "Mutation.unsubscribe":
-		if e.complexity.Mutation.Unsubscribe == nil {
+	case "Mutation.socialReauthenticateEnd":
+		if e.complexity.Mutation.SocialReauthenticateEnd == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_unsubscribe_args(context.TODO(), rawArgs)
+		args, err := ec.field_Mutation_socialReauthenticateEnd_args(context.TODO(), rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Mutation.Unsubscribe(childComplexity, args["input"].(*UnsubscribeInput)), true
+		return e.complexity.Mutation.SocialReauthenticateEnd(childComplexity, args["input"].(SocialLoginEndInput)), true
 
-	case "Mutation.updateOmnisendContacts":
-		if e.complexity.Mutation.UpdateOmnisendContacts == nil {
+	case "Mutation.socialReauthenticateInitialize":
+		if e.complexity.Mutation.SocialReauthenticateInitialize == nil {
 			break
 		}
 
-		return e.complexity.Mutation.UpdateOmnisendContacts(childComplexity), true
+		args, err := ec.field_Mutation_socialReauthenticateInitialize_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-	case "Mutation.updateOmnisendProducts":
-		if e.complexity.Mutation.UpdateOmnisendProducts == nil {
+		return e.complexity.Mutation.SocialReauthenticateInitialize(childComplexity, args["input"].(SocialLoginInitializeInput)), true
+
+	case "Mutation.submitDesignerApplication":
+		if e.complexity.Mutation.SubmitDesignerApplication == nil {
 			break
 		}
 
-		return e.complexity.Mutation.UpdateOmnisendProducts(childComplexity), true
+		return e.complexity.Mutation.SubmitDesignerApplication(childComplexity), true
+
+	case "Mutation.subscribe":
+		if e.complexity.Mutation.Subscribe == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_subscribe_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.Subscribe(childComplexity, args["input"].(*SubscriptionInput)), true
+
+	case "Mutation.toggleCategory":
+		if e.complexity.Mutation.ToggleCategory == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_toggleCategory_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.ToggleCategory(childComplexity, args["input"].(ToggleCategory)), true
+
+	case "Mutation.unlinkSocialLogin":
+		if e.complexity.Mutation.UnlinkSocialLogin == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_unlinkSocialLogin_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.UnlinkSocialLogin(childComplexity, args["input"].(UnlinkSocialLoginInput)), true
+
+	case "Mutation.unlockLogin":
+		if e.complexity.Mutation.UnlockLogin == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_unlockLogin_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.UnlockLogin(childComplexity, args["input"].(UnlockLoginInput)), true
+
+	case "Mutation.unsubscribe":
+		if e.complexity.Mutation.Unsubscribe == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_unsubscribe_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.Unsubscribe(childComplexity, args["input"].(*UnsubscribeInput)), true
+
+	case "Mutation.updateOmnisendContacts":
+		if e.complexity.Mutation.UpdateOmnisendContacts == nil {
+			break
+		}
+
+		return e.complexity.Mutation.UpdateOmnisendContacts(childComplexity), true
+
+	case "Mutation.updateOmnisendProducts":
+		if e.complexity.Mutation.UpdateOmnisendProducts == nil {
+			break
+		}
+
+		return e.complexity.Mutation.UpdateOmnisendProducts(childComplexity), true
 
 	case "Mutation.updateProfile":
 		if e.complexity.Mutation.UpdateProfile == nil {
@@ -1355,6 +1881,34 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Owner.User(childComplexity), true
 
+	case "PageInfo.endCursor":
+		if e.complexity.PageInfo.EndCursor == nil {
+			break
+		}
+
+		return e.complexity.PageInfo.EndCursor(childComplexity), true
+
+	case "PageInfo.hasNextPage":
+		if e.complexity.PageInfo.HasNextPage == nil {
+			break
+		}
+
+		return e.complexity.PageInfo.HasNextPage(childComplexity), true
+
+	case "PageInfo.hasPreviousPage":
+		if e.complexity.PageInfo.HasPreviousPage == nil {
+			break
+		}
+
+		return e.complexity.PageInfo.HasPreviousPage(childComplexity), true
+
+	case "PageInfo.startCursor":
+		if e.complexity.PageInfo.StartCursor == nil {
+			break
+		}
+
+		return e.complexity.PageInfo.StartCursor(childComplexity), true
+
 	case "PaymentIntent.clientSecret":
 		if e.complexity.PaymentIntent.ClientSecret == nil {
 			break
@@ -1369,6 +1923,27 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.PaymentIntent.ID(childComplexity), true
 
+	case "Permission.description":
+		if e.complexity.Permission.Description == nil {
+			break
+		}
+
+		return e.complexity.Permission.Description(childComplexity), true
+
+	case "Permission.id":
+		if e.complexity.Permission.ID == nil {
+			break
+		}
+
+		return e.complexity.Permission.ID(childComplexity), true
+
+	case "Permission.name":
+		if e.complexity.Permission.Name == nil {
+			break
+		}
+
+		return e.complexity.Permission.Name(childComplexity), true
+
 	case "Profile.description":
 		if e.complexity.Profile.Description == nil {
 			break
@@ -1414,6 +1989,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.CreateStripeAccountLink(childComplexity, args["input"].(*CreateStripeAccountLinkInput)), true
 
+	case "Query.deadJobs":
+		if e.complexity.Query.DeadJobs == nil {
+			break
+		}
+
+		args, err := ec.field_Query_deadJobs_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.DeadJobs(childComplexity, args["pagination"].(*Pagination)), true
+
 	case "Query.designerApplications":
 		if e.complexity.Query.DesignerApplications == nil {
 			break
@@ -1426,6 +2013,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.DesignerApplications(childComplexity, args["filter"].(DesignerApplicationsFilter)), true
 
+	case "Query.emailTemplates":
+		if e.complexity.Query.EmailTemplates == nil {
+			break
+		}
+
+		return e.complexity.Query.EmailTemplates(childComplexity), true
+
 	case "Query.getBankAccount":
 		if e.complexity.Query.GetBankAccount == nil {
 			break
@@ -1440,6 +2034,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.GetBankAccountRequirements(childComplexity), true
 
+	case "Query.myAPIKeys":
+		if e.complexity.Query.MyAPIKeys == nil {
+			break
+		}
+
+		return e.complexity.Query.MyAPIKeys(childComplexity), true
+
 	case "Query.nfts":
 		if e.complexity.Query.Nfts == nil {
 			break
@@ -1459,6 +2060,30 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.OffchainNfts(childComplexity), true
 
+	case "Query.permissions":
+		if e.complexity.Query.Permissions == nil {
+			break
+		}
+
+		args, err := ec.field_Query_permissions_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Permissions(childComplexity, args["filter"].(*PermissionsFilter)), true
+
+	case "Query.previewEmail":
+		if e.complexity.Query.PreviewEmail == nil {
+			break
+		}
+
+		args, err := ec.field_Query_previewEmail_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.PreviewEmail(childComplexity, args["input"].(PreviewEmailInput)), true
+
 	case "Query.roles":
 		if e.complexity.Query.Roles == nil {
 			break
@@ -1519,6 +2144,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.Users(childComplexity, args["filter"].(UsersFilter)), true
 
+	case "Query.usersConnection":
+		if e.complexity.Query.UsersConnection == nil {
+			break
+		}
+
+		args, err := ec.field_Query_usersConnection_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.UsersConnection(childComplexity, args["filter"].(UsersFilter), args["first"].(*int), args["after"].(*string), args["last"].(*int), args["before"].(*string)), true
+
 	case "Query.validateEmail":
 		if e.complexity.Query.ValidateEmail == nil {
 			break
@@ -1774,6 +2411,34 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.User.StripeTransferCapabilityStatus(childComplexity), true
 
+	case "UserConnection.edges":
+		if e.complexity.UserConnection.Edges == nil {
+			break
+		}
+
+		return e.complexity.UserConnection.Edges(childComplexity), true
+
+	case "UserConnection.pageInfo":
+		if e.complexity.UserConnection.PageInfo == nil {
+			break
+		}
+
+		return e.complexity.UserConnection.PageInfo(childComplexity), true
+
+	case "UserEdge.cursor":
+		if e.complexity.UserEdge.Cursor == nil {
+			break
+		}
+
+		return e.complexity.UserEdge.Cursor(childComplexity), true
+
+	case "UserEdge.node":
+		if e.complexity.UserEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.UserEdge.Node(childComplexity), true
+
 	case "UserHasNfts.address":
 		if e.complexity.UserHasNfts.Address == nil {
 			break
@@ -1894,19 +2559,87 @@ func (ec *executionContext) introspectType(name string) (*introspection.Type, er
 }
 
 var sources = []*ast.Source{
+	{Name: "api/graphql/schemas/api_key.graphql", Input: `"""
+Mutations require the WRITE scope, queries the READ one
+"""
+enum APIKeyScope {
+    READ
+    WRITE
+}
+
+type APIKey {
+    id: Int!
+    name: String!
+    """ Start of the key to tell keys apart, the key itself is only returned at creation """
+    prefix: String!
+    scopes: [APIKeyScope!]!
+    creationTime: Time!
+    lastUsedTime: Time
+}
+
+type CreatedAPIKey {
+    apiKey: APIKey!
+    """ Sent as Authorization: Bearer <key>, it can't be retrieved again """
+    key: String!
+}
+`, BuiltIn: false},
+	{Name: "api/graphql/schemas/api_key_mutation.graphql", Input: `extend type Mutation {
+    """ API keys can't be managed with API keys """
+    createAPIKey(input: CreateAPIKeyInput!): CreatedAPIKey! @authenticate
+    revokeAPIKey(input: RevokeAPIKeyInput!): String @authenticate
+}
+
+input CreateAPIKeyInput {
+    name: String!
+    scopes: [APIKeyScope!]!
+}
+
+input RevokeAPIKeyInput {
+    id: Int!
+}
+`, BuiltIn: false},
+	{Name: "api/graphql/schemas/api_key_query.graphql", Input: `extend type Query {
+    myAPIKeys: [APIKey!]! @authenticate
+}
+`, BuiltIn: false},
 	{Name: "api/graphql/schemas/auth.graphql", Input: `extend type Mutation {
     login(input: LoginInput): Authentication
 
-    loginBlockchainInitialize: String
+    """ Returns an EIP-4361 (Sign-In with Ethereum) message to be signed with personal_sign """
+    loginBlockchainInitialize(input: LoginBlockchainInitializeInput!): String
     loginBlockchainEnd(input: LoginBlockchainEndInput): Authentication
 
-    logout: String @authenticate
+    """ Returns the JSON encoded options for navigator.credentials.get """
+    loginPasskeyInitialize: String
+    loginPasskeyEnd(input: LoginPasskeyEndInput!): Authentication
+
+    """
+    Returns the URL of the provider the user must be redirected to, and sets a cookie binding the flow to the browser.
+    socialLoginEnd must be called by the same browser.
+    """
+    socialLoginInitialize(input: SocialLoginInitializeInput!): String
+    socialLoginEnd(input: SocialLoginEndInput!): Authentication
+    unlinkSocialLogin(input: UnlinkSocialLoginInput!): String @authenticate
+    """ Starts a login with a linked provider to re-authenticate users that have no password, returns the URL of the provider """
+    socialReauthenticateInitialize(input: SocialLoginInitializeInput!): String @authenticate
+    """ Returns a single use key accepted instead of the password by the mutations that re-authenticate """
+    socialReauthenticateEnd(input: SocialLoginEndInput!): String @authenticate
+
+    logout: String @authenticate(enforce: false)
 
     forgotPasswordInitialize(input: ForgotPasswordInitialize): String
     forgotPasswordEnd(input: ForgotPasswordEnd): String
 
+    """ Clears the failed login attempts and locks of an email and/or IP """
+    unlockLogin(input: UnlockLoginInput!): String @requires(permissions: ["users:unlock"])
+
+    """ Returns an EIP-4361 (Sign-In with Ethereum) message to be signed with personal_sign """
     associateAddressInitialize(input: AssociateAddressInitialize): String @authenticate
     associateAddressEnd(input: AssociateAddressEnd): String @authenticate
+
+    """ Returns the JSON encoded options for navigator.credentials.create """
+    registerPasskeyInitialize: String @authenticate
+    registerPasskeyEnd(input: RegisterPasskeyEndInput!): String @authenticate
 }
 
 input ForgotPasswordEnd {
@@ -1918,16 +2651,51 @@ input ForgotPasswordInitialize {
     email: String! @lowercase
 }
 
+input LoginBlockchainInitializeInput {
+    address: String!
+}
+
 input LoginBlockchainEndInput {
+    """ The exact message returned by loginBlockchainInitialize """
     message: String!
     signedMessage: String!
 }
 
+input LoginPasskeyEndInput {
+    """ JSON encoded PublicKeyCredential returned by navigator.credentials.get """
+    credential: String!
+}
+
+input RegisterPasskeyEndInput {
+    """ JSON encoded PublicKeyCredential returned by navigator.credentials.create """
+    credential: String!
+}
+
+input SocialLoginInitializeInput {
+    """ Name of a configured provider, e.g. google or github """
+    provider: String! @lowercase
+}
+
+input SocialLoginEndInput {
+    """ state and code query parameters the provider redirected back with """
+    state: String!
+    code: String!
+}
+
+input UnlinkSocialLoginInput {
+    provider: String! @lowercase
+}
+
 input LoginInput {
     email: String! @lowercase
     password: String!
 }
 
+input UnlockLoginInput {
+    email: String @lowercase
+    ip: String
+}
+
 input AssociateAddressEnd {
     signedMessage: String!
 }
@@ -2016,8 +2784,8 @@ input CreateBuyAuthorizationInput {
 }
 `, BuiltIn: false},
 	{Name: "api/graphql/schemas/blog.mutation.graphql", Input: `extend type Mutation {
-    createBlogPost(input: CreateBlogPostInput): String @authenticate(rules: [ADMIN_ROLE])
-    deleteBlogPost(input: DeleteBlogPostInput): String @authenticate(rules: [ADMIN_ROLE])
+    createBlogPost(input: CreateBlogPostInput): String @requires(permissions: ["blog:write"])
+    deleteBlogPost(input: DeleteBlogPostInput): String @requires(permissions: ["blog:write"])
 }
 
 input CreateBlogPostInput {
@@ -2031,7 +2799,7 @@ input DeleteBlogPostInput {
 }
 `, BuiltIn: false},
 	{Name: "api/graphql/schemas/blog_query.graphql", Input: `extend type Query {
-    blogPosts(filter: BlogPostsFilter!): [BlogPost!]
+    blogPosts(filter: BlogPostsFilter!): [BlogPost!] @listSize(slicingArguments: ["filter.pagination.limit"])
 }
 
 input BlogPostsFilter {
@@ -2094,7 +2862,7 @@ input SignatureInput {
 `, BuiltIn: false},
 	{Name: "api/graphql/schemas/designer_application_mutation.graphql", Input: `extend type Mutation {
     submitDesignerApplication: String @authenticate
-    resolveDesignerApplication(input: ResolveDesignerApplicationInput): String @authenticate(rules: [ADMIN_ROLE])
+    resolveDesignerApplication(input: ResolveDesignerApplicationInput): String @requires(permissions: ["designer_applications:resolve"])
 }
 
 input ResolveDesignerApplicationInput {
@@ -2103,7 +2871,8 @@ input ResolveDesignerApplicationInput {
 }
 `, BuiltIn: false},
 	{Name: "api/graphql/schemas/designer_application_query.graphql", Input: `extend type Query {
-    designerApplications(filter: DesignerApplicationsFilter!): [DesignerApplication]
+    """ Admins see every application, the other users only their own one by filtering on their id """
+    designerApplications(filter: DesignerApplicationsFilter!): [DesignerApplication] @authenticate(rules: [ADMIN_ROLE, APPLICANT_OF], match: ANY) @listSize(slicingArguments: ["filter.pagination.limit"])
 }
 
 input DesignerApplicationsFilter {
@@ -2124,6 +2893,34 @@ input DesignerApplicationsUsersFilter {
     name: String
     email: String @lowercase
 }
+`, BuiltIn: false},
+	{Name: "api/graphql/schemas/job.graphql", Input: `type Job {
+    id: Int!
+    type: String!
+    payload: String!
+    attempts: Int!
+    lastError: String!
+    creationTime: Time!
+    deadTime: Time
+}
+`, BuiltIn: false},
+	{Name: "api/graphql/schemas/job_mutation.graphql", Input: `extend type Mutation {
+    """
+    Puts a dead job back in the queue with its attempts reset
+    """
+    retryJob(input: RetryJobInput!): String @requires(permissions: ["jobs:manage"])
+}
+
+input RetryJobInput {
+    id: Int!
+}
+`, BuiltIn: false},
+	{Name: "api/graphql/schemas/job_query.graphql", Input: `extend type Query {
+    """
+    Jobs that failed every attempt and won't run again until they're retried
+    """
+    deadJobs(pagination: Pagination): [Job!]! @requires(permissions: ["jobs:manage"]) @listSize(slicingArguments: ["pagination.limit"])
+}
 `, BuiltIn: false},
 	{Name: "api/graphql/schemas/mailing.graphql", Input: `extend type Query {
     sendSuccessfulBuyEmail(input: SendSuccessfulBuyEmailInput!): String @authenticate
@@ -2132,15 +2929,35 @@ input DesignerApplicationsUsersFilter {
 input SendSuccessfulBuyEmailInput {
     nftID: Int!
     txHash: String!
-}`, BuiltIn: false},
+}
+extend type Query {
+    """ Names of the transactional email templates """
+    emailTemplates: [String!]! @requires(permissions: ["emails:preview"])
+    """ Renders a transactional email template with sample data """
+    previewEmail(input: PreviewEmailInput!): EmailPreview! @requires(permissions: ["emails:preview"])
+}
+
+input PreviewEmailInput {
+    template: String!
+    """ Falls back to the default locale if the template isn't translated """
+    locale: String
+}
+
+type EmailPreview {
+    from: String!
+    subject: String!
+    html: String!
+    text: String!
+}
+`, BuiltIn: false},
 	{Name: "api/graphql/schemas/nft.graphql", Input: `type Nft {
     id: Int!
     totalSupply: Int!
     creator: String!
     creatorUser: User @goField(forceResolver: true)
     blockCreation: Int!
-    transfers: [Transfer!] @goField(forceResolver: true)
-    owners: [Owner!] @goField(forceResolver: true)
+    transfers: [Transfer!] @goField(forceResolver: true) @cost(weight: 5)
+    owners: [Owner!] @goField(forceResolver: true) @cost(weight: 5)
     categories: [Category]! @goField(forceResolver: true)
     sale: Sale @goField(forceResolver: true)
     filterUrl: String @goField(forceResolver: true)
@@ -2216,7 +3033,7 @@ input AttributeInput {
 }
 `, BuiltIn: false},
 	{Name: "api/graphql/schemas/nft_query.graphql", Input: `extend type Query {
-    nfts(filter: NftsFilter!): [Nft!] @authenticate(enforce: false)
+    nfts(filter: NftsFilter!): [Nft!] @authenticate(enforce: false) @listSize(slicingArguments: ["filter.pagination.limit"])
 }
 
 input NftsFilter {
@@ -2234,18 +3051,65 @@ input NftsOrderBy {
 }
 `, BuiltIn: false},
 	{Name: "api/graphql/schemas/omnisend.graphql", Input: `extend type Mutation {
-    updateOmnisendProducts: String @authenticate(rules: [ADMIN_ROLE])
-    updateOmnisendContacts: String @authenticate(rules: [ADMIN_ROLE])
+    updateOmnisendProducts: String @requires(permissions: ["omnisend:sync"])
+    updateOmnisendContacts: String @requires(permissions: ["omnisend:sync"])
 }`, BuiltIn: false},
+	{Name: "api/graphql/schemas/permission.graphql", Input: `"""
+Named resource:action, granted to users through their roles
+"""
+type Permission {
+    id: Int!
+    name: String!
+    description: String!
+}
+`, BuiltIn: false},
 	{Name: "api/graphql/schemas/role.graphql", Input: `type Role {
     id: Int!
     name: String!
 
     users: [User!] @goField(forceResolver: true)
 }
+`, BuiltIn: false},
+	{Name: "api/graphql/schemas/role_mutation.graphql", Input: `extend type Mutation {
+    createRole(input: CreateRoleInput!): Role! @requires(permissions: ["roles:manage"])
+    """ Takes the role away from its users, the built-in roles can't be deleted """
+    deleteRole(input: DeleteRoleInput!): String @requires(permissions: ["roles:manage"])
+
+    createPermission(input: CreatePermissionInput!): Permission! @requires(permissions: ["roles:manage"])
+    """ Revokes the permission from every role """
+    deletePermission(input: DeletePermissionInput!): String @requires(permissions: ["roles:manage"])
+    """ Grants the permission to the role, or revokes it when activate is false """
+    setRolePermission(input: SetRolePermissionInput!): String @requires(permissions: ["roles:manage"])
+}
+
+input CreateRoleInput {
+    name: String!
+}
+
+input DeleteRoleInput {
+    id: Int!
+}
+
+input CreatePermissionInput {
+    """ resource:action """
+    name: String! @lowercase
+    description: String!
+}
+
+input DeletePermissionInput {
+    id: Int!
+}
+
+input SetRolePermissionInput {
+    roleId: Int!
+    permissionId: Int!
+    activate: Boolean!
+}
 `, BuiltIn: false},
 	{Name: "api/graphql/schemas/role_query.graphql", Input: `extend type Query {
     roles(filter: RolesFilter!): [Role!]
+    """ Every permission, or the ones granted to a role """
+    permissions(filter: PermissionsFilter): [Permission!]! @requires(permissions: ["roles:manage"])
 }
 
 input RolesFilter {
@@ -2257,6 +3121,10 @@ input RolesFilter {
 input RolesUserFilter {
     id: Int
 }
+
+input PermissionsFilter {
+    roleId: Int
+}
 `, BuiltIn: false},
 	{Name: "api/graphql/schemas/sale.graphql", Input: `type Sale {
     nftId: Int
@@ -2267,7 +3135,7 @@ input RolesUserFilter {
 }
 `, BuiltIn: false},
 	{Name: "api/graphql/schemas/sale_mutation.graphql", Input: `extend type Mutation {
-    upsertSale(input: UpsertSaleInput): String! @authenticate(rules:[DESIGNER_OF])
+    upsertSale(input: UpsertSaleInput): String! @authenticate(rules: [DESIGNER_OF])
 }
 
 input UpsertSaleInput {
@@ -2293,6 +3161,14 @@ input Pagination {
     page: Int! @intBetween(biggerThan: 0, fieldName: "page")
 }
 
+""" Relay page info of a connection, the cursors are null on an empty page """
+type PageInfo {
+    hasNextPage: Boolean!
+    hasPreviousPage: Boolean!
+    startCursor: String
+    endCursor: String
+}
+
 type Response {
     success: Boolean,
     message: String
@@ -2318,20 +3194,56 @@ directive @lowercase on INPUT_FIELD_DEFINITION
 directive @goField(forceResolver: Boolean, name: String) on INPUT_FIELD_DEFINITION
     | FIELD_DEFINITION
 
+"""
+Role rules pass for the users with the role, the other ones for the users owning the resource whose ID is in the
+arguments of the field, see directives.OwnershipRule
+"""
 enum RULE {
     ADMIN_ROLE,
     DESIGNER_ROLE,
     USER_ROLE,
+    """ Designed the NFT of input.nftId """
     DESIGNER_OF,
-    OWNER_OF
+    """ Holds the NFT of input.nftId, on-chain or off-chain """
+    OWNER_OF,
+    """ Submitted the designer application of filter.user.id """
+    APPLICANT_OF
+}
+
+""" How the rules of @authenticate are composed, every rule has to pass by default """
+enum RULE_MATCH {
+    ALL,
+    ANY
 }
 
-directive @authenticate(rules: [RULE!], enforce: Boolean) on FIELD_DEFINITION
+directive @authenticate(rules: [RULE!], enforce: Boolean, match: RULE_MATCH) on FIELD_DEFINITION
 
 enum PROTECTED_RULE {
-    ADMIN
-}
-directive @protected(rules: [PROTECTED_RULE!]) on FIELD_DEFINITION`, BuiltIn: false},
+    """ The logged user is an admin """
+    ADMIN,
+    """ The parent is the User of the logged user """
+    SELF
+}
+"""
+Resolves the field for the logged users passing one of the rules, having one of the roles, given by name, or one of the
+permissions. With nullable a denied field resolves to null with an error instead of failing its parent, the field has
+to be nullable.
+"""
+directive @protected(rules: [PROTECTED_RULE!], roles: [String!], permissions: [String!], nullable: Boolean) on FIELD_DEFINITION
+
+""" Resolves the field only if the logged user has every permission through their roles, like "blog:write" """
+directive @requires(permissions: [String!]!) on FIELD_DEFINITION
+
+""" Weight of the field in the cost of an operation, 1 for objects and 0 for scalars by default, see complexity.Cost """
+directive @cost(weight: Int!) on FIELD_DEFINITION
+
+"""
+Size of the list returned by the field in the cost of an operation: the first of slicingArguments set in the query,
+like first or filter.pagination.limit, else assumedSize. With sizedFields the field returns an object holding the lists
+and the size applies to these fields, like the edges of a connection.
+"""
+directive @listSize(assumedSize: Int, slicingArguments: [String!], sizedFields: [String!]) on FIELD_DEFINITION
+`, BuiltIn: false},
 	{Name: "api/graphql/schemas/stripe.graphql", Input: ``, BuiltIn: false},
 	{Name: "api/graphql/schemas/stripe_mutation.graphql", Input: `extend type Mutation {
     createCard(input: CreateCardInput): String!
@@ -2469,13 +3381,14 @@ type FromTo {
 `, BuiltIn: false},
 	{Name: "api/graphql/schemas/user.graphql", Input: `type User {
     id: Int!
-    firstName: String! @protected(rules: [ADMIN])
-    lastName: String! @protected(rules: [ADMIN])
-    email: String! @protected(rules: [ADMIN])
+    """ Personal data, null with an error for other users than admins and the user themselves """
+    firstName: String @protected(rules: [SELF], permissions: ["users:read_pii"], nullable: true)
+    lastName: String @protected(rules: [SELF], permissions: ["users:read_pii"], nullable: true)
+    email: String @protected(rules: [SELF], permissions: ["users:read_pii"], nullable: true)
     preferredName: String!
 
     addresses: [String!] @goField(forceResolver: true)
-    owned: [UserHasNfts!] @goField(forceResolver: true)
+    owned: [UserHasNfts!] @goField(forceResolver: true) @cost(weight: 5)
     designed(filter: DesignedFieldFilter): [Nft!] @goField(forceResolver: true)
     roles: [Role!] @goField(forceResolver: true)
 
@@ -2518,11 +3431,24 @@ type Profile {
 
     resendConfirmationEmail(input: ResendConfirmationEmailInput): String
 
-    setRole(input: SetRoleInput): String @authenticate(rules: [ADMIN_ROLE])
+    """ Sends a confirmation link to the new email and notifies the current one """
+    requestEmailChange(input: RequestEmailChangeInput!): String @authenticate
+    """ Swaps the email and revokes every other session of the user """
+    confirmEmailChange(input: ConfirmEmailChangeInput!): String @authenticate(enforce: false)
+
+    """ Schedules the deletion of the account after a grace period, returns when it will be deleted (RFC 3339) """
+    deleteMyAccount(input: DeleteMyAccountInput!): String @authenticate
+    cancelAccountDeletion: String @authenticate
+    """ Returns a base64 encoded ZIP with everything linked to the user """
+    exportMyData(input: ExportMyDataInput!): String @authenticate
+
+    setRole(input: SetRoleInput): String @requires(permissions: ["users:assign_roles"])
 
     updateProfile(input: ProfileInput): String @authenticate
+    """ Sets the language of the emails, an empty locale falls back to the one of the country """
+    setLocale(input: SetLocaleInput!): String @authenticate
 
-    assignOffChainNfts(input: AssignOffChainNftsInput!): String @authenticate(rules: [ADMIN_ROLE])
+    assignOffChainNfts(input: AssignOffChainNftsInput!): String @requires(permissions: ["nfts:assign_offchain"])
 }
 
 input AssignOffChainNftsInput {
@@ -2540,6 +3466,10 @@ input ProfileInput {
     description: String
 }
 
+input SetLocaleInput {
+    locale: String!
+}
+
 input SetRoleInput {
     userId: Int!
     roleId: Int!
@@ -2556,14 +3486,48 @@ input CreateUserInput {
 
     """ ISO 3166-1 alpha-2 """
     country: String!
+    """ Language of the emails, the one of the country is used when it's not set """
+    locale: String
 }
 
 input ConfirmEmailInput {
     key: String!
 }
+
+input RequestEmailChangeInput {
+    newEmail: String! @lowercase
+    """ Required unless reauthenticationKey is set """
+    password: String
+    """ Returned by socialReauthenticateEnd, for users that have no password """
+    reauthenticationKey: String
+}
+
+input ConfirmEmailChangeInput {
+    key: String!
+}
+
+input DeleteMyAccountInput {
+    """ Required unless reauthenticationKey is set """
+    password: String
+    """ Returned by socialReauthenticateEnd, for users that have no password """
+    reauthenticationKey: String
+}
+
+input ExportMyDataInput {
+    """ Required unless reauthenticationKey is set """
+    password: String
+    """ Returned by socialReauthenticateEnd, for users that have no password """
+    reauthenticationKey: String
+}
 `, BuiltIn: false},
 	{Name: "api/graphql/schemas/user_query.graphql", Input: `extend type Query {
-    users(filter: UsersFilter!): UsersResult! @authenticate(enforce: false)
+    """ Returns the first page of 100 users when the filter has no pagination """
+    users(filter: UsersFilter!): UsersResult! @authenticate(enforce: false) @listSize(assumedSize: 100, slicingArguments: ["filter.pagination.limit"], sizedFields: ["users"])
+    """
+    Pages the users with opaque cursors in the order of the filter, its pagination is ignored. Takes first and after
+    or last and before, at most 100 users per page.
+    """
+    usersConnection(filter: UsersFilter!, first: Int, after: String, last: Int, before: String): UserConnection! @authenticate(enforce: false) @listSize(assumedSize: 100, slicingArguments: ["first", "last"], sizedFields: ["edges"])
     offchainNfts: [OffchainNft!] @authenticate
 
     """ Returns a nil string if it's valid """
@@ -2580,7 +3544,17 @@ type UsersResult {
     count: Int! @goField(forceResolver: true)
 }
 
-input UsersFilter {
+type UserConnection {
+    edges: [UserEdge!]!
+    pageInfo: PageInfo!
+}
+
+type UserEdge {
+    cursor: String!
+    node: User!
+}
+
+input UsersFilter {
     ids: [Int!]
     name: String
     email: String @lowercase
@@ -2628,6 +3602,15 @@ func (ec *executionContext) dir_authenticate_args(ctx context.Context, rawArgs m
 		}
 	}
 	args["enforce"] = arg1
+	var arg2 *RuleMatch
+	if tmp, ok := rawArgs["match"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("match"))
+		arg2, err = ec.unmarshalORULE_MATCH2ᚖserverᚋapiᚋgraphqlᚋgraphᚐRuleMatch(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["match"] = arg2
 	return args, nil
 }
 
@@ -2676,6 +3659,48 @@ func (ec *executionContext) dir_protected_args(ctx context.Context, rawArgs map[
 		}
 	}
 	args["rules"] = arg0
+	var arg1 []string
+	if tmp, ok := rawArgs["roles"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("roles"))
+		arg1, err = ec.unmarshalOString2ᚕstringᚄ(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["roles"] = arg1
+	var arg2 []string
+	if tmp, ok := rawArgs["permissions"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("permissions"))
+		arg2, err = ec.unmarshalOString2ᚕstringᚄ(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["permissions"] = arg2
+	var arg3 *bool
+	if tmp, ok := rawArgs["nullable"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("nullable"))
+		arg3, err = ec.unmarshalOBoolean2ᚖbool(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["nullable"] = arg3
+	return args, nil
+}
+
+func (ec *executionContext) dir_requires_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 []string
+	if tmp, ok := rawArgs["permissions"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("permissions"))
+		arg0, err = ec.unmarshalNString2ᚕstringᚄ(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["permissions"] = arg0
 	return args, nil
 }
 
@@ -2739,6 +3764,21 @@ func (ec *executionContext) field_Mutation_buy_args(ctx context.Context, rawArgs
 	return args, nil
 }
 
+func (ec *executionContext) field_Mutation_confirmEmailChange_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 ConfirmEmailChangeInput
+	if tmp, ok := rawArgs["input"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("input"))
+		arg0, err = ec.unmarshalNConfirmEmailChangeInput2serverᚋapiᚋgraphqlᚋgraphᚐConfirmEmailChangeInput(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["input"] = arg0
+	return args, nil
+}
+
 func (ec *executionContext) field_Mutation_confirmEmail_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
@@ -2754,6 +3794,21 @@ func (ec *executionContext) field_Mutation_confirmEmail_args(ctx context.Context
 	return args, nil
 }
 
+func (ec *executionContext) field_Mutation_createAPIKey_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 CreateAPIKeyInput
+	if tmp, ok := rawArgs["input"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("input"))
+		arg0, err = ec.unmarshalNCreateAPIKeyInput2serverᚋapiᚋgraphqlᚋgraphᚐCreateAPIKeyInput(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["input"] = arg0
+	return args, nil
+}
+
 func (ec *executionContext) field_Mutation_createBatchTransferAuthorization_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
@@ -2844,6 +3899,36 @@ func (ec *executionContext) field_Mutation_createPaymentIntent_args(ctx context.
 	return args, nil
 }
 
+func (ec *executionContext) field_Mutation_createPermission_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 CreatePermissionInput
+	if tmp, ok := rawArgs["input"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("input"))
+		arg0, err = ec.unmarshalNCreatePermissionInput2serverᚋapiᚋgraphqlᚋgraphᚐCreatePermissionInput(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["input"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_createRole_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 CreateRoleInput
+	if tmp, ok := rawArgs["input"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("input"))
+		arg0, err = ec.unmarshalNCreateRoleInput2serverᚋapiᚋgraphqlᚋgraphᚐCreateRoleInput(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["input"] = arg0
+	return args, nil
+}
+
 func (ec *executionContext) field_Mutation_createStripeCheckoutSession_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
@@ -2904,13 +3989,13 @@ func (ec *executionContext) field_Mutation_deleteBlogPost_args(ctx context.Conte
 	return args, nil
 }
 
-func (ec *executionContext) field_Mutation_forgotPasswordEnd_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Mutation_deleteMyAccount_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
-	var arg0 *ForgotPasswordEnd
+	var arg0 DeleteMyAccountInput
 	if tmp, ok := rawArgs["input"]; ok {
 		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("input"))
-		arg0, err = ec.unmarshalOForgotPasswordEnd2ᚖserverᚋapiᚋgraphqlᚋgraphᚐForgotPasswordEnd(ctx, tmp)
+		arg0, err = ec.unmarshalNDeleteMyAccountInput2serverᚋapiᚋgraphqlᚋgraphᚐDeleteMyAccountInput(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
@@ -2919,13 +4004,13 @@ func (ec *executionContext) field_Mutation_forgotPasswordEnd_args(ctx context.Co
 	return args, nil
 }
 
-func (ec *executionContext) field_Mutation_forgotPasswordInitialize_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Mutation_deletePermission_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
-	var arg0 *ForgotPasswordInitialize
+	var arg0 DeletePermissionInput
 	if tmp, ok := rawArgs["input"]; ok {
 		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("input"))
-		arg0, err = ec.unmarshalOForgotPasswordInitialize2ᚖserverᚋapiᚋgraphqlᚋgraphᚐForgotPasswordInitialize(ctx, tmp)
+		arg0, err = ec.unmarshalNDeletePermissionInput2serverᚋapiᚋgraphqlᚋgraphᚐDeletePermissionInput(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
@@ -2934,13 +4019,13 @@ func (ec *executionContext) field_Mutation_forgotPasswordInitialize_args(ctx con
 	return args, nil
 }
 
-func (ec *executionContext) field_Mutation_fulfillPaymentIntent_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Mutation_deleteRole_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
-	var arg0 *FulfillPaymentIntentInput
+	var arg0 DeleteRoleInput
 	if tmp, ok := rawArgs["input"]; ok {
 		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("input"))
-		arg0, err = ec.unmarshalOFulfillPaymentIntentInput2ᚖserverᚋapiᚋgraphqlᚋgraphᚐFulfillPaymentIntentInput(ctx, tmp)
+		arg0, err = ec.unmarshalNDeleteRoleInput2serverᚋapiᚋgraphqlᚋgraphᚐDeleteRoleInput(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
@@ -2949,13 +4034,13 @@ func (ec *executionContext) field_Mutation_fulfillPaymentIntent_args(ctx context
 	return args, nil
 }
 
-func (ec *executionContext) field_Mutation_loginBlockchainEnd_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Mutation_exportMyData_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
-	var arg0 *LoginBlockchainEndInput
+	var arg0 ExportMyDataInput
 	if tmp, ok := rawArgs["input"]; ok {
 		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("input"))
-		arg0, err = ec.unmarshalOLoginBlockchainEndInput2ᚖserverᚋapiᚋgraphqlᚋgraphᚐLoginBlockchainEndInput(ctx, tmp)
+		arg0, err = ec.unmarshalNExportMyDataInput2serverᚋapiᚋgraphqlᚋgraphᚐExportMyDataInput(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
@@ -2964,13 +4049,13 @@ func (ec *executionContext) field_Mutation_loginBlockchainEnd_args(ctx context.C
 	return args, nil
 }
 
-func (ec *executionContext) field_Mutation_login_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Mutation_forgotPasswordEnd_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
-	var arg0 *LoginInput
+	var arg0 *ForgotPasswordEnd
 	if tmp, ok := rawArgs["input"]; ok {
 		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("input"))
-		arg0, err = ec.unmarshalOLoginInput2ᚖserverᚋapiᚋgraphqlᚋgraphᚐLoginInput(ctx, tmp)
+		arg0, err = ec.unmarshalOForgotPasswordEnd2ᚖserverᚋapiᚋgraphqlᚋgraphᚐForgotPasswordEnd(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
@@ -2979,13 +4064,13 @@ func (ec *executionContext) field_Mutation_login_args(ctx context.Context, rawAr
 	return args, nil
 }
 
-func (ec *executionContext) field_Mutation_resendConfirmationEmail_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Mutation_forgotPasswordInitialize_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
-	var arg0 *ResendConfirmationEmailInput
+	var arg0 *ForgotPasswordInitialize
 	if tmp, ok := rawArgs["input"]; ok {
 		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("input"))
-		arg0, err = ec.unmarshalOResendConfirmationEmailInput2ᚖserverᚋapiᚋgraphqlᚋgraphᚐResendConfirmationEmailInput(ctx, tmp)
+		arg0, err = ec.unmarshalOForgotPasswordInitialize2ᚖserverᚋapiᚋgraphqlᚋgraphᚐForgotPasswordInitialize(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
@@ -2994,13 +4079,13 @@ func (ec *executionContext) field_Mutation_resendConfirmationEmail_args(ctx cont
 	return args, nil
 }
 
-func (ec *executionContext) field_Mutation_resolveDesignerApplication_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Mutation_fulfillPaymentIntent_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
-	var arg0 *ResolveDesignerApplicationInput
+	var arg0 *FulfillPaymentIntentInput
 	if tmp, ok := rawArgs["input"]; ok {
 		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("input"))
-		arg0, err = ec.unmarshalOResolveDesignerApplicationInput2ᚖserverᚋapiᚋgraphqlᚋgraphᚐResolveDesignerApplicationInput(ctx, tmp)
+		arg0, err = ec.unmarshalOFulfillPaymentIntentInput2ᚖserverᚋapiᚋgraphqlᚋgraphᚐFulfillPaymentIntentInput(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
@@ -3009,13 +4094,13 @@ func (ec *executionContext) field_Mutation_resolveDesignerApplication_args(ctx c
 	return args, nil
 }
 
-func (ec *executionContext) field_Mutation_saveCreationIntent_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Mutation_loginBlockchainEnd_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
-	var arg0 SaveCreationIntentInput
+	var arg0 *LoginBlockchainEndInput
 	if tmp, ok := rawArgs["input"]; ok {
 		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("input"))
-		arg0, err = ec.unmarshalNSaveCreationIntentInput2serverᚋapiᚋgraphqlᚋgraphᚐSaveCreationIntentInput(ctx, tmp)
+		arg0, err = ec.unmarshalOLoginBlockchainEndInput2ᚖserverᚋapiᚋgraphqlᚋgraphᚐLoginBlockchainEndInput(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
@@ -3024,13 +4109,13 @@ func (ec *executionContext) field_Mutation_saveCreationIntent_args(ctx context.C
 	return args, nil
 }
 
-func (ec *executionContext) field_Mutation_setFilter_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Mutation_loginBlockchainInitialize_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
-	var arg0 SaveFilter
+	var arg0 LoginBlockchainInitializeInput
 	if tmp, ok := rawArgs["input"]; ok {
 		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("input"))
-		arg0, err = ec.unmarshalNSaveFilter2serverᚋapiᚋgraphqlᚋgraphᚐSaveFilter(ctx, tmp)
+		arg0, err = ec.unmarshalNLoginBlockchainInitializeInput2serverᚋapiᚋgraphqlᚋgraphᚐLoginBlockchainInitializeInput(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
@@ -3039,13 +4124,13 @@ func (ec *executionContext) field_Mutation_setFilter_args(ctx context.Context, r
 	return args, nil
 }
 
-func (ec *executionContext) field_Mutation_setRole_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Mutation_loginPasskeyEnd_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
-	var arg0 *SetRoleInput
+	var arg0 LoginPasskeyEndInput
 	if tmp, ok := rawArgs["input"]; ok {
 		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("input"))
-		arg0, err = ec.unmarshalOSetRoleInput2ᚖserverᚋapiᚋgraphqlᚋgraphᚐSetRoleInput(ctx, tmp)
+		arg0, err = ec.unmarshalNLoginPasskeyEndInput2serverᚋapiᚋgraphqlᚋgraphᚐLoginPasskeyEndInput(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
@@ -3054,13 +4139,13 @@ func (ec *executionContext) field_Mutation_setRole_args(ctx context.Context, raw
 	return args, nil
 }
 
-func (ec *executionContext) field_Mutation_subscribe_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Mutation_login_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
-	var arg0 *SubscriptionInput
+	var arg0 *LoginInput
 	if tmp, ok := rawArgs["input"]; ok {
 		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("input"))
-		arg0, err = ec.unmarshalOSubscriptionInput2ᚖserverᚋapiᚋgraphqlᚋgraphᚐSubscriptionInput(ctx, tmp)
+		arg0, err = ec.unmarshalOLoginInput2ᚖserverᚋapiᚋgraphqlᚋgraphᚐLoginInput(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
@@ -3069,13 +4154,13 @@ func (ec *executionContext) field_Mutation_subscribe_args(ctx context.Context, r
 	return args, nil
 }
 
-func (ec *executionContext) field_Mutation_toggleCategory_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Mutation_registerPasskeyEnd_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
-	var arg0 ToggleCategory
+	var arg0 RegisterPasskeyEndInput
 	if tmp, ok := rawArgs["input"]; ok {
 		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("input"))
-		arg0, err = ec.unmarshalNToggleCategory2serverᚋapiᚋgraphqlᚋgraphᚐToggleCategory(ctx, tmp)
+		arg0, err = ec.unmarshalNRegisterPasskeyEndInput2serverᚋapiᚋgraphqlᚋgraphᚐRegisterPasskeyEndInput(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
@@ -3084,13 +4169,13 @@ func (ec *executionContext) field_Mutation_toggleCategory_args(ctx context.Conte
 	return args, nil
 }
 
-func (ec *executionContext) field_Mutation_unsubscribe_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Mutation_requestEmailChange_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
-	var arg0 *UnsubscribeInput
+	var arg0 RequestEmailChangeInput
 	if tmp, ok := rawArgs["input"]; ok {
 		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("input"))
-		arg0, err = ec.unmarshalOUnsubscribeInput2ᚖserverᚋapiᚋgraphqlᚋgraphᚐUnsubscribeInput(ctx, tmp)
+		arg0, err = ec.unmarshalNRequestEmailChangeInput2serverᚋapiᚋgraphqlᚋgraphᚐRequestEmailChangeInput(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
@@ -3099,13 +4184,13 @@ func (ec *executionContext) field_Mutation_unsubscribe_args(ctx context.Context,
 	return args, nil
 }
 
-func (ec *executionContext) field_Mutation_updateProfile_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Mutation_resendConfirmationEmail_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
-	var arg0 *ProfileInput
+	var arg0 *ResendConfirmationEmailInput
 	if tmp, ok := rawArgs["input"]; ok {
 		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("input"))
-		arg0, err = ec.unmarshalOProfileInput2ᚖserverᚋapiᚋgraphqlᚋgraphᚐProfileInput(ctx, tmp)
+		arg0, err = ec.unmarshalOResendConfirmationEmailInput2ᚖserverᚋapiᚋgraphqlᚋgraphᚐResendConfirmationEmailInput(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
@@ -3114,13 +4199,13 @@ func (ec *executionContext) field_Mutation_updateProfile_args(ctx context.Contex
 	return args, nil
 }
 
-func (ec *executionContext) field_Mutation_upsertPayoutToken_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Mutation_resolveDesignerApplication_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
-	var arg0 UpsertPayoutTokenInput
+	var arg0 *ResolveDesignerApplicationInput
 	if tmp, ok := rawArgs["input"]; ok {
 		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("input"))
-		arg0, err = ec.unmarshalNUpsertPayoutTokenInput2serverᚋapiᚋgraphqlᚋgraphᚐUpsertPayoutTokenInput(ctx, tmp)
+		arg0, err = ec.unmarshalOResolveDesignerApplicationInput2ᚖserverᚋapiᚋgraphqlᚋgraphᚐResolveDesignerApplicationInput(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
@@ -3129,13 +4214,13 @@ func (ec *executionContext) field_Mutation_upsertPayoutToken_args(ctx context.Co
 	return args, nil
 }
 
-func (ec *executionContext) field_Mutation_upsertSale_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Mutation_retryJob_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
-	var arg0 *UpsertSaleInput
+	var arg0 RetryJobInput
 	if tmp, ok := rawArgs["input"]; ok {
 		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("input"))
-		arg0, err = ec.unmarshalOUpsertSaleInput2ᚖserverᚋapiᚋgraphqlᚋgraphᚐUpsertSaleInput(ctx, tmp)
+		arg0, err = ec.unmarshalNRetryJobInput2serverᚋapiᚋgraphqlᚋgraphᚐRetryJobInput(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
@@ -3144,43 +4229,43 @@ func (ec *executionContext) field_Mutation_upsertSale_args(ctx context.Context,
 	return args, nil
 }
 
-func (ec *executionContext) field_Query___type_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Mutation_revokeAPIKey_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
-	var arg0 string
-	if tmp, ok := rawArgs["name"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
-		arg0, err = ec.unmarshalNString2string(ctx, tmp)
+	var arg0 RevokeAPIKeyInput
+	if tmp, ok := rawArgs["input"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("input"))
+		arg0, err = ec.unmarshalNRevokeAPIKeyInput2serverᚋapiᚋgraphqlᚋgraphᚐRevokeAPIKeyInput(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["name"] = arg0
+	args["input"] = arg0
 	return args, nil
 }
 
-func (ec *executionContext) field_Query_blogPosts_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Mutation_saveCreationIntent_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
-	var arg0 BlogPostsFilter
-	if tmp, ok := rawArgs["filter"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("filter"))
-		arg0, err = ec.unmarshalNBlogPostsFilter2serverᚋapiᚋgraphqlᚋgraphᚐBlogPostsFilter(ctx, tmp)
+	var arg0 SaveCreationIntentInput
+	if tmp, ok := rawArgs["input"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("input"))
+		arg0, err = ec.unmarshalNSaveCreationIntentInput2serverᚋapiᚋgraphqlᚋgraphᚐSaveCreationIntentInput(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["filter"] = arg0
+	args["input"] = arg0
 	return args, nil
 }
 
-func (ec *executionContext) field_Query_createStripeAccountLink_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Mutation_setFilter_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
-	var arg0 *CreateStripeAccountLinkInput
+	var arg0 SaveFilter
 	if tmp, ok := rawArgs["input"]; ok {
 		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("input"))
-		arg0, err = ec.unmarshalOCreateStripeAccountLinkInput2ᚖserverᚋapiᚋgraphqlᚋgraphᚐCreateStripeAccountLinkInput(ctx, tmp)
+		arg0, err = ec.unmarshalNSaveFilter2serverᚋapiᚋgraphqlᚋgraphᚐSaveFilter(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
@@ -3189,58 +4274,58 @@ func (ec *executionContext) field_Query_createStripeAccountLink_args(ctx context
 	return args, nil
 }
 
-func (ec *executionContext) field_Query_designerApplications_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Mutation_setLocale_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
-	var arg0 DesignerApplicationsFilter
-	if tmp, ok := rawArgs["filter"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("filter"))
-		arg0, err = ec.unmarshalNDesignerApplicationsFilter2serverᚋapiᚋgraphqlᚋgraphᚐDesignerApplicationsFilter(ctx, tmp)
+	var arg0 SetLocaleInput
+	if tmp, ok := rawArgs["input"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("input"))
+		arg0, err = ec.unmarshalNSetLocaleInput2serverᚋapiᚋgraphqlᚋgraphᚐSetLocaleInput(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["filter"] = arg0
+	args["input"] = arg0
 	return args, nil
 }
 
-func (ec *executionContext) field_Query_nfts_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Mutation_setRolePermission_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
-	var arg0 NftsFilter
-	if tmp, ok := rawArgs["filter"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("filter"))
-		arg0, err = ec.unmarshalNNftsFilter2serverᚋapiᚋgraphqlᚋgraphᚐNftsFilter(ctx, tmp)
+	var arg0 SetRolePermissionInput
+	if tmp, ok := rawArgs["input"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("input"))
+		arg0, err = ec.unmarshalNSetRolePermissionInput2serverᚋapiᚋgraphqlᚋgraphᚐSetRolePermissionInput(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["filter"] = arg0
+	args["input"] = arg0
 	return args, nil
 }
 
-func (ec *executionContext) field_Query_roles_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Mutation_setRole_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
-	var arg0 RolesFilter
-	if tmp, ok := rawArgs["filter"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("filter"))
-		arg0, err = ec.unmarshalNRolesFilter2serverᚋapiᚋgraphqlᚋgraphᚐRolesFilter(ctx, tmp)
+	var arg0 *SetRoleInput
+	if tmp, ok := rawArgs["input"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("input"))
+		arg0, err = ec.unmarshalOSetRoleInput2ᚖserverᚋapiᚋgraphqlᚋgraphᚐSetRoleInput(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["filter"] = arg0
+	args["input"] = arg0
 	return args, nil
 }
 
-func (ec *executionContext) field_Query_sendEvent_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Mutation_socialLoginEnd_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
-	var arg0 *SendEventInput
+	var arg0 SocialLoginEndInput
 	if tmp, ok := rawArgs["input"]; ok {
 		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("input"))
-		arg0, err = ec.unmarshalOSendEventInput2ᚖserverᚋapiᚋgraphqlᚋgraphᚐSendEventInput(ctx, tmp)
+		arg0, err = ec.unmarshalNSocialLoginEndInput2serverᚋapiᚋgraphqlᚋgraphᚐSocialLoginEndInput(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
@@ -3249,13 +4334,13 @@ func (ec *executionContext) field_Query_sendEvent_args(ctx context.Context, rawA
 	return args, nil
 }
 
-func (ec *executionContext) field_Query_sendSuccessfulBuyEmail_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Mutation_socialLoginInitialize_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
-	var arg0 SendSuccessfulBuyEmailInput
+	var arg0 SocialLoginInitializeInput
 	if tmp, ok := rawArgs["input"]; ok {
 		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("input"))
-		arg0, err = ec.unmarshalNSendSuccessfulBuyEmailInput2serverᚋapiᚋgraphqlᚋgraphᚐSendSuccessfulBuyEmailInput(ctx, tmp)
+		arg0, err = ec.unmarshalNSocialLoginInitializeInput2serverᚋapiᚋgraphqlᚋgraphᚐSocialLoginInitializeInput(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
@@ -3264,105 +4349,2267 @@ func (ec *executionContext) field_Query_sendSuccessfulBuyEmail_args(ctx context.
 	return args, nil
 }
 
-func (ec *executionContext) field_Query_subscriptions_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Mutation_socialReauthenticateEnd_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
-	var arg0 SubscriptionsFilter
-	if tmp, ok := rawArgs["filter"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("filter"))
-		arg0, err = ec.unmarshalNSubscriptionsFilter2serverᚋapiᚋgraphqlᚋgraphᚐSubscriptionsFilter(ctx, tmp)
+	var arg0 SocialLoginEndInput
+	if tmp, ok := rawArgs["input"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("input"))
+		arg0, err = ec.unmarshalNSocialLoginEndInput2serverᚋapiᚋgraphqlᚋgraphᚐSocialLoginEndInput(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["filter"] = arg0
+	args["input"] = arg0
 	return args, nil
 }
 
-func (ec *executionContext) field_Query_users_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Mutation_socialReauthenticateInitialize_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
-	var arg0 UsersFilter
-	if tmp, ok := rawArgs["filter"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("filter"))
-		arg0, err = ec.unmarshalNUsersFilter2serverᚋapiᚋgraphqlᚋgraphᚐUsersFilter(ctx, tmp)
+	var arg0 SocialLoginInitializeInput
+	if tmp, ok := rawArgs["input"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("input"))
+		arg0, err = ec.unmarshalNSocialLoginInitializeInput2serverᚋapiᚋgraphqlᚋgraphᚐSocialLoginInitializeInput(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["filter"] = arg0
+	args["input"] = arg0
 	return args, nil
 }
 
-func (ec *executionContext) field_Query_validateEmail_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Mutation_subscribe_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
-	var arg0 string
-	if tmp, ok := rawArgs["email"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("email"))
-		arg0, err = ec.unmarshalNString2string(ctx, tmp)
+	var arg0 *SubscriptionInput
+	if tmp, ok := rawArgs["input"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("input"))
+		arg0, err = ec.unmarshalOSubscriptionInput2ᚖserverᚋapiᚋgraphqlᚋgraphᚐSubscriptionInput(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["email"] = arg0
+	args["input"] = arg0
 	return args, nil
 }
 
-func (ec *executionContext) field_User_designed_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Mutation_toggleCategory_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
-	var arg0 *DesignedFieldFilter
-	if tmp, ok := rawArgs["filter"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("filter"))
-		arg0, err = ec.unmarshalODesignedFieldFilter2ᚖserverᚋapiᚋgraphqlᚋgraphᚐDesignedFieldFilter(ctx, tmp)
+	var arg0 ToggleCategory
+	if tmp, ok := rawArgs["input"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("input"))
+		arg0, err = ec.unmarshalNToggleCategory2serverᚋapiᚋgraphqlᚋgraphᚐToggleCategory(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["filter"] = arg0
+	args["input"] = arg0
 	return args, nil
 }
 
-func (ec *executionContext) field___Type_enumValues_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Mutation_unlinkSocialLogin_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
-	var arg0 bool
-	if tmp, ok := rawArgs["includeDeprecated"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("includeDeprecated"))
-		arg0, err = ec.unmarshalOBoolean2bool(ctx, tmp)
+	var arg0 UnlinkSocialLoginInput
+	if tmp, ok := rawArgs["input"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("input"))
+		arg0, err = ec.unmarshalNUnlinkSocialLoginInput2serverᚋapiᚋgraphqlᚋgraphᚐUnlinkSocialLoginInput(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["input"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_unlockLogin_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 UnlockLoginInput
+	if tmp, ok := rawArgs["input"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("input"))
+		arg0, err = ec.unmarshalNUnlockLoginInput2serverᚋapiᚋgraphqlᚋgraphᚐUnlockLoginInput(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["input"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_unsubscribe_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 *UnsubscribeInput
+	if tmp, ok := rawArgs["input"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("input"))
+		arg0, err = ec.unmarshalOUnsubscribeInput2ᚖserverᚋapiᚋgraphqlᚋgraphᚐUnsubscribeInput(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["input"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_updateProfile_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 *ProfileInput
+	if tmp, ok := rawArgs["input"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("input"))
+		arg0, err = ec.unmarshalOProfileInput2ᚖserverᚋapiᚋgraphqlᚋgraphᚐProfileInput(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["input"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_upsertPayoutToken_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 UpsertPayoutTokenInput
+	if tmp, ok := rawArgs["input"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("input"))
+		arg0, err = ec.unmarshalNUpsertPayoutTokenInput2serverᚋapiᚋgraphqlᚋgraphᚐUpsertPayoutTokenInput(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["input"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_upsertSale_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 *UpsertSaleInput
+	if tmp, ok := rawArgs["input"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("input"))
+		arg0, err = ec.unmarshalOUpsertSaleInput2ᚖserverᚋapiᚋgraphqlᚋgraphᚐUpsertSaleInput(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["input"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query___type_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["name"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
+		arg0, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["name"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_blogPosts_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 BlogPostsFilter
+	if tmp, ok := rawArgs["filter"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("filter"))
+		arg0, err = ec.unmarshalNBlogPostsFilter2serverᚋapiᚋgraphqlᚋgraphᚐBlogPostsFilter(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["filter"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_createStripeAccountLink_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 *CreateStripeAccountLinkInput
+	if tmp, ok := rawArgs["input"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("input"))
+		arg0, err = ec.unmarshalOCreateStripeAccountLinkInput2ᚖserverᚋapiᚋgraphqlᚋgraphᚐCreateStripeAccountLinkInput(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["input"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_deadJobs_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 *Pagination
+	if tmp, ok := rawArgs["pagination"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("pagination"))
+		arg0, err = ec.unmarshalOPagination2ᚖserverᚋapiᚋgraphqlᚋgraphᚐPagination(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["pagination"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_designerApplications_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 DesignerApplicationsFilter
+	if tmp, ok := rawArgs["filter"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("filter"))
+		arg0, err = ec.unmarshalNDesignerApplicationsFilter2serverᚋapiᚋgraphqlᚋgraphᚐDesignerApplicationsFilter(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["filter"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_nfts_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 NftsFilter
+	if tmp, ok := rawArgs["filter"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("filter"))
+		arg0, err = ec.unmarshalNNftsFilter2serverᚋapiᚋgraphqlᚋgraphᚐNftsFilter(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["filter"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_permissions_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 *PermissionsFilter
+	if tmp, ok := rawArgs["filter"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("filter"))
+		arg0, err = ec.unmarshalOPermissionsFilter2ᚖserverᚋapiᚋgraphqlᚋgraphᚐPermissionsFilter(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["filter"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_previewEmail_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 PreviewEmailInput
+	if tmp, ok := rawArgs["input"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("input"))
+		arg0, err = ec.unmarshalNPreviewEmailInput2serverᚋapiᚋgraphqlᚋgraphᚐPreviewEmailInput(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["input"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_roles_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 RolesFilter
+	if tmp, ok := rawArgs["filter"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("filter"))
+		arg0, err = ec.unmarshalNRolesFilter2serverᚋapiᚋgraphqlᚋgraphᚐRolesFilter(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["filter"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_sendEvent_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 *SendEventInput
+	if tmp, ok := rawArgs["input"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("input"))
+		arg0, err = ec.unmarshalOSendEventInput2ᚖserverᚋapiᚋgraphqlᚋgraphᚐSendEventInput(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["input"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_sendSuccessfulBuyEmail_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 SendSuccessfulBuyEmailInput
+	if tmp, ok := rawArgs["input"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("input"))
+		arg0, err = ec.unmarshalNSendSuccessfulBuyEmailInput2serverᚋapiᚋgraphqlᚋgraphᚐSendSuccessfulBuyEmailInput(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["input"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_subscriptions_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 SubscriptionsFilter
+	if tmp, ok := rawArgs["filter"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("filter"))
+		arg0, err = ec.unmarshalNSubscriptionsFilter2serverᚋapiᚋgraphqlᚋgraphᚐSubscriptionsFilter(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["includeDeprecated"] = arg0
-	return args, nil
+	args["filter"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_usersConnection_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 UsersFilter
+	if tmp, ok := rawArgs["filter"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("filter"))
+		arg0, err = ec.unmarshalNUsersFilter2serverᚋapiᚋgraphqlᚋgraphᚐUsersFilter(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["filter"] = arg0
+	var arg1 *int
+	if tmp, ok := rawArgs["first"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("first"))
+		arg1, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["first"] = arg1
+	var arg2 *string
+	if tmp, ok := rawArgs["after"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("after"))
+		arg2, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["after"] = arg2
+	var arg3 *int
+	if tmp, ok := rawArgs["last"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("last"))
+		arg3, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["last"] = arg3
+	var arg4 *string
+	if tmp, ok := rawArgs["before"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("before"))
+		arg4, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["before"] = arg4
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_users_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 UsersFilter
+	if tmp, ok := rawArgs["filter"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("filter"))
+		arg0, err = ec.unmarshalNUsersFilter2serverᚋapiᚋgraphqlᚋgraphᚐUsersFilter(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["filter"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_validateEmail_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["email"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("email"))
+		arg0, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["email"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_User_designed_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 *DesignedFieldFilter
+	if tmp, ok := rawArgs["filter"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("filter"))
+		arg0, err = ec.unmarshalODesignedFieldFilter2ᚖserverᚋapiᚋgraphqlᚋgraphᚐDesignedFieldFilter(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["filter"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field___Type_enumValues_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 bool
+	if tmp, ok := rawArgs["includeDeprecated"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("includeDeprecated"))
+		arg0, err = ec.unmarshalOBoolean2bool(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["includeDeprecated"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field___Type_fields_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 bool
+	if tmp, ok := rawArgs["includeDeprecated"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("includeDeprecated"))
+		arg0, err = ec.unmarshalOBoolean2bool(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["includeDeprecated"] = arg0
+	return args, nil
+}
+
+// endregion ***************************** args.gotpl *****************************
+
+// region    ************************** directives.gotpl **************************
+
+// endregion ************************** directives.gotpl **************************
+
+// region    **************************** field.gotpl *****************************
+
+func (ec *executionContext) _APIKey_id(ctx context.Context, field graphql.CollectedField, obj *APIKey) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "APIKey",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _APIKey_name(ctx context.Context, field graphql.CollectedField, obj *APIKey) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "APIKey",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _APIKey_prefix(ctx context.Context, field graphql.CollectedField, obj *APIKey) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "APIKey",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Prefix, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _APIKey_scopes(ctx context.Context, field graphql.CollectedField, obj *APIKey) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "APIKey",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Scopes, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]APIKeyScope)
+	fc.Result = res
+	return ec.marshalNAPIKeyScope2ᚕserverᚋapiᚋgraphqlᚋgraphᚐAPIKeyScopeᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _APIKey_creationTime(ctx context.Context, field graphql.CollectedField, obj *APIKey) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "APIKey",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.CreationTime, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	fc.Result = res
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _APIKey_lastUsedTime(ctx context.Context, field graphql.CollectedField, obj *APIKey) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "APIKey",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.LastUsedTime, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*time.Time)
+	fc.Result = res
+	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Address_countryCode(ctx context.Context, field graphql.CollectedField, obj *Address) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Address",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.CountryCode, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Address_country(ctx context.Context, field graphql.CollectedField, obj *Address) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Address",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Country, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Attribute_displayType(ctx context.Context, field graphql.CollectedField, obj *Attribute) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Attribute",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.DisplayType, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Attribute_traitType(ctx context.Context, field graphql.CollectedField, obj *Attribute) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Attribute",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.TraitType, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Attribute_value(ctx context.Context, field graphql.CollectedField, obj *Attribute) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Attribute",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Value, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Authentication_jwt(ctx context.Context, field graphql.CollectedField, obj *Authentication) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Authentication",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Jwt, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Authentication_user(ctx context.Context, field graphql.CollectedField, obj *Authentication) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Authentication",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.User, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*User)
+	fc.Result = res
+	return ec.marshalNUser2ᚖserverᚋapiᚋgraphqlᚋgraphᚐUser(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _AuthorizationBatch_tokenContract(ctx context.Context, field graphql.CollectedField, obj *AuthorizationBatch) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "AuthorizationBatch",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.TokenContract, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _AuthorizationBatch_ids(ctx context.Context, field graphql.CollectedField, obj *AuthorizationBatch) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "AuthorizationBatch",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Ids, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]int)
+	fc.Result = res
+	return ec.marshalOInt2ᚕintᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _AuthorizationBatch_amounts(ctx context.Context, field graphql.CollectedField, obj *AuthorizationBatch) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "AuthorizationBatch",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Amounts, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]int)
+	fc.Result = res
+	return ec.marshalOInt2ᚕintᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _AuthorizationBatch_validUntil(ctx context.Context, field graphql.CollectedField, obj *AuthorizationBatch) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "AuthorizationBatch",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ValidUntil, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _AuthorizationBatchResponse_signature(ctx context.Context, field graphql.CollectedField, obj *AuthorizationBatchResponse) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "AuthorizationBatchResponse",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Signature, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*Signature)
+	fc.Result = res
+	return ec.marshalNSignature2ᚖserverᚋapiᚋgraphqlᚋgraphᚐSignature(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _AuthorizationBatchResponse_authorization(ctx context.Context, field graphql.CollectedField, obj *AuthorizationBatchResponse) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "AuthorizationBatchResponse",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Authorization, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*AuthorizationBatch)
+	fc.Result = res
+	return ec.marshalNAuthorizationBatch2ᚖserverᚋapiᚋgraphqlᚋgraphᚐAuthorizationBatch(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _AuthorizationBuy_tokenContract(ctx context.Context, field graphql.CollectedField, obj *AuthorizationBuy) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "AuthorizationBuy",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.TokenContract, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _AuthorizationBuy_seller(ctx context.Context, field graphql.CollectedField, obj *AuthorizationBuy) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "AuthorizationBuy",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Seller, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _AuthorizationBuy_id(ctx context.Context, field graphql.CollectedField, obj *AuthorizationBuy) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "AuthorizationBuy",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _AuthorizationBuy_amount(ctx context.Context, field graphql.CollectedField, obj *AuthorizationBuy) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "AuthorizationBuy",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Amount, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _AuthorizationBuy_toSeller(ctx context.Context, field graphql.CollectedField, obj *AuthorizationBuy) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "AuthorizationBuy",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ToSeller, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _AuthorizationBuy_total(ctx context.Context, field graphql.CollectedField, obj *AuthorizationBuy) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "AuthorizationBuy",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Total, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _AuthorizationBuy_validUntil(ctx context.Context, field graphql.CollectedField, obj *AuthorizationBuy) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "AuthorizationBuy",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ValidUntil, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _AuthorizationBuyResponse_signature(ctx context.Context, field graphql.CollectedField, obj *AuthorizationBuyResponse) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "AuthorizationBuyResponse",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Signature, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*Signature)
+	fc.Result = res
+	return ec.marshalNSignature2ᚖserverᚋapiᚋgraphqlᚋgraphᚐSignature(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _AuthorizationBuyResponse_authorization(ctx context.Context, field graphql.CollectedField, obj *AuthorizationBuyResponse) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "AuthorizationBuyResponse",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Authorization, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*AuthorizationBuy)
+	fc.Result = res
+	return ec.marshalNAuthorizationBuy2ᚖserverᚋapiᚋgraphqlᚋgraphᚐAuthorizationBuy(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _AuthorizationTransfer_tokenContract(ctx context.Context, field graphql.CollectedField, obj *AuthorizationTransfer) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "AuthorizationTransfer",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.TokenContract, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _AuthorizationTransfer_id(ctx context.Context, field graphql.CollectedField, obj *AuthorizationTransfer) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "AuthorizationTransfer",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _AuthorizationTransfer_amount(ctx context.Context, field graphql.CollectedField, obj *AuthorizationTransfer) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "AuthorizationTransfer",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Amount, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _AuthorizationTransfer_validUntil(ctx context.Context, field graphql.CollectedField, obj *AuthorizationTransfer) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "AuthorizationTransfer",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ValidUntil, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _AuthorizationTransferResponse_signature(ctx context.Context, field graphql.CollectedField, obj *AuthorizationTransferResponse) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "AuthorizationTransferResponse",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Signature, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*Signature)
+	fc.Result = res
+	return ec.marshalNSignature2ᚖserverᚋapiᚋgraphqlᚋgraphᚐSignature(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _AuthorizationTransferResponse_authorization(ctx context.Context, field graphql.CollectedField, obj *AuthorizationTransferResponse) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "AuthorizationTransferResponse",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Authorization, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*AuthorizationTransfer)
+	fc.Result = res
+	return ec.marshalNAuthorizationTransfer2ᚖserverᚋapiᚋgraphqlᚋgraphᚐAuthorizationTransfer(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _BankAccount_country(ctx context.Context, field graphql.CollectedField, obj *BankAccount) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "BankAccount",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Country, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _BankAccount_accountHolderName(ctx context.Context, field graphql.CollectedField, obj *BankAccount) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "BankAccount",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.AccountHolderName, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _BankAccount_accountHolderType(ctx context.Context, field graphql.CollectedField, obj *BankAccount) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "BankAccount",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.AccountHolderType, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _BankAccount_currency(ctx context.Context, field graphql.CollectedField, obj *BankAccount) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "BankAccount",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Currency, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _BankAccount_routingNumber(ctx context.Context, field graphql.CollectedField, obj *BankAccount) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "BankAccount",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.RoutingNumber, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _BankAccount_status(ctx context.Context, field graphql.CollectedField, obj *BankAccount) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "BankAccount",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Status, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _BankAccount_last4(ctx context.Context, field graphql.CollectedField, obj *BankAccount) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "BankAccount",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Last4, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _BankAccountRequirements_currencies(ctx context.Context, field graphql.CollectedField, obj *BankAccountRequirements) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "BankAccountRequirements",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Currencies, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]*Currency)
+	fc.Result = res
+	return ec.marshalOCurrency2ᚕᚖserverᚋapiᚋgraphqlᚋgraphᚐCurrencyᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _BlogPost_id(ctx context.Context, field graphql.CollectedField, obj *BlogPost) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "BlogPost",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _BlogPost_title(ctx context.Context, field graphql.CollectedField, obj *BlogPost) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "BlogPost",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Title, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _BlogPost_body(ctx context.Context, field graphql.CollectedField, obj *BlogPost) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "BlogPost",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Body, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _BlogPost_image(ctx context.Context, field graphql.CollectedField, obj *BlogPost) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "BlogPost",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Image, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _BlogPost_CreationTime(ctx context.Context, field graphql.CollectedField, obj *BlogPost) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "BlogPost",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.CreationTime, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	fc.Result = res
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Category_id(ctx context.Context, field graphql.CollectedField, obj *Category) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Category",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Category_name(ctx context.Context, field graphql.CollectedField, obj *Category) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Category",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) field___Type_fields_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
-	var err error
-	args := map[string]interface{}{}
-	var arg0 bool
-	if tmp, ok := rawArgs["includeDeprecated"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("includeDeprecated"))
-		arg0, err = ec.unmarshalOBoolean2bool(ctx, tmp)
-		if err != nil {
-			return nil, err
+func (ec *executionContext) _CreateStripeCheckoutResponse_stripeCheckoutLink(ctx context.Context, field graphql.CollectedField, obj *CreateStripeCheckoutResponse) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
 		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "CreateStripeCheckoutResponse",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
 	}
-	args["includeDeprecated"] = arg0
-	return args, nil
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.StripeCheckoutLink, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-// endregion ***************************** args.gotpl *****************************
+func (ec *executionContext) _CreateStripeCheckoutResponse_paymentIntentId(ctx context.Context, field graphql.CollectedField, obj *CreateStripeCheckoutResponse) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "CreateStripeCheckoutResponse",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
 
-// region    ************************** directives.gotpl **************************
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.PaymentIntentID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
 
-// endregion ************************** directives.gotpl **************************
+func (ec *executionContext) _CreatedAPIKey_apiKey(ctx context.Context, field graphql.CollectedField, obj *CreatedAPIKey) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "CreatedAPIKey",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
 
-// region    **************************** field.gotpl *****************************
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.APIKey, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*APIKey)
+	fc.Result = res
+	return ec.marshalNAPIKey2ᚖserverᚋapiᚋgraphqlᚋgraphᚐAPIKey(ctx, field.Selections, res)
+}
 
-func (ec *executionContext) _Address_countryCode(ctx context.Context, field graphql.CollectedField, obj *Address) (ret graphql.Marshaler) {
+func (ec *executionContext) _CreatedAPIKey_key(ctx context.Context, field graphql.CollectedField, obj *CreatedAPIKey) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3370,7 +6617,7 @@ func (ec *executionContext) _Address_countryCode(ctx context.Context, field grap
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Address",
+		Object:     "CreatedAPIKey",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -3380,7 +6627,7 @@ func (ec *executionContext) _Address_countryCode(ctx context.Context, field grap
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.CountryCode, nil
+		return obj.Key, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3397,7 +6644,7 @@ func (ec *executionContext) _Address_countryCode(ctx context.Context, field grap
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Address_country(ctx context.Context, field graphql.CollectedField, obj *Address) (ret graphql.Marshaler) {
+func (ec *executionContext) _Currency_code(ctx context.Context, field graphql.CollectedField, obj *Currency) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3405,7 +6652,7 @@ func (ec *executionContext) _Address_country(ctx context.Context, field graphql.
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Address",
+		Object:     "Currency",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -3415,7 +6662,109 @@ func (ec *executionContext) _Address_country(ctx context.Context, field graphql.
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Country, nil
+		return obj.Code, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Currency_countries(ctx context.Context, field graphql.CollectedField, obj *Currency) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Currency",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Countries, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]string)
+	fc.Result = res
+	return ec.marshalOString2ᚕstringᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _DesignerApplication_userId(ctx context.Context, field graphql.CollectedField, obj *DesignerApplication) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "DesignerApplication",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.UserID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _DesignerApplication_user(ctx context.Context, field graphql.CollectedField, obj *DesignerApplication) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "DesignerApplication",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.DesignerApplication().User(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3427,12 +6776,12 @@ func (ec *executionContext) _Address_country(ctx context.Context, field graphql.
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*User)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNUser2ᚖserverᚋapiᚋgraphqlᚋgraphᚐUser(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Attribute_displayType(ctx context.Context, field graphql.CollectedField, obj *Attribute) (ret graphql.Marshaler) {
+func (ec *executionContext) _DesignerApplication_submitTime(ctx context.Context, field graphql.CollectedField, obj *DesignerApplication) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3440,7 +6789,7 @@ func (ec *executionContext) _Attribute_displayType(ctx context.Context, field gr
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Attribute",
+		Object:     "DesignerApplication",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -3450,21 +6799,24 @@ func (ec *executionContext) _Attribute_displayType(ctx context.Context, field gr
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.DisplayType, nil
+		return obj.SubmitTime, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(time.Time)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Attribute_traitType(ctx context.Context, field graphql.CollectedField, obj *Attribute) (ret graphql.Marshaler) {
+func (ec *executionContext) _EmailPreview_from(ctx context.Context, field graphql.CollectedField, obj *EmailPreview) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3472,7 +6824,7 @@ func (ec *executionContext) _Attribute_traitType(ctx context.Context, field grap
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Attribute",
+		Object:     "EmailPreview",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -3482,21 +6834,24 @@ func (ec *executionContext) _Attribute_traitType(ctx context.Context, field grap
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TraitType, nil
+		return obj.From, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Attribute_value(ctx context.Context, field graphql.CollectedField, obj *Attribute) (ret graphql.Marshaler) {
+func (ec *executionContext) _EmailPreview_subject(ctx context.Context, field graphql.CollectedField, obj *EmailPreview) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3504,7 +6859,7 @@ func (ec *executionContext) _Attribute_value(ctx context.Context, field graphql.
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Attribute",
+		Object:     "EmailPreview",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -3514,21 +6869,24 @@ func (ec *executionContext) _Attribute_value(ctx context.Context, field graphql.
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Value, nil
+		return obj.Subject, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Authentication_jwt(ctx context.Context, field graphql.CollectedField, obj *Authentication) (ret graphql.Marshaler) {
+func (ec *executionContext) _EmailPreview_html(ctx context.Context, field graphql.CollectedField, obj *EmailPreview) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3536,7 +6894,7 @@ func (ec *executionContext) _Authentication_jwt(ctx context.Context, field graph
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Authentication",
+		Object:     "EmailPreview",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -3546,7 +6904,7 @@ func (ec *executionContext) _Authentication_jwt(ctx context.Context, field graph
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Jwt, nil
+		return obj.HTML, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3563,7 +6921,7 @@ func (ec *executionContext) _Authentication_jwt(ctx context.Context, field graph
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Authentication_user(ctx context.Context, field graphql.CollectedField, obj *Authentication) (ret graphql.Marshaler) {
+func (ec *executionContext) _EmailPreview_text(ctx context.Context, field graphql.CollectedField, obj *EmailPreview) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3571,7 +6929,7 @@ func (ec *executionContext) _Authentication_user(ctx context.Context, field grap
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Authentication",
+		Object:     "EmailPreview",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -3581,7 +6939,7 @@ func (ec *executionContext) _Authentication_user(ctx context.Context, field grap
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.User, nil
+		return obj.Text, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3593,12 +6951,12 @@ func (ec *executionContext) _Authentication_user(ctx context.Context, field grap
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*User)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNUser2ᚖserverᚋapiᚋgraphqlᚋgraphᚐUser(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _AuthorizationBatch_tokenContract(ctx context.Context, field graphql.CollectedField, obj *AuthorizationBatch) (ret graphql.Marshaler) {
+func (ec *executionContext) _FromTo_address(ctx context.Context, field graphql.CollectedField, obj *FromTo) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3606,7 +6964,7 @@ func (ec *executionContext) _AuthorizationBatch_tokenContract(ctx context.Contex
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "AuthorizationBatch",
+		Object:     "FromTo",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -3616,7 +6974,7 @@ func (ec *executionContext) _AuthorizationBatch_tokenContract(ctx context.Contex
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TokenContract, nil
+		return obj.Address, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3633,7 +6991,7 @@ func (ec *executionContext) _AuthorizationBatch_tokenContract(ctx context.Contex
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _AuthorizationBatch_ids(ctx context.Context, field graphql.CollectedField, obj *AuthorizationBatch) (ret graphql.Marshaler) {
+func (ec *executionContext) _FromTo_user(ctx context.Context, field graphql.CollectedField, obj *FromTo) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3641,7 +6999,7 @@ func (ec *executionContext) _AuthorizationBatch_ids(ctx context.Context, field g
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "AuthorizationBatch",
+		Object:     "FromTo",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -3651,7 +7009,7 @@ func (ec *executionContext) _AuthorizationBatch_ids(ctx context.Context, field g
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Ids, nil
+		return obj.User, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3660,12 +7018,12 @@ func (ec *executionContext) _AuthorizationBatch_ids(ctx context.Context, field g
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.([]int)
+	res := resTmp.(*User)
 	fc.Result = res
-	return ec.marshalOInt2ᚕintᚄ(ctx, field.Selections, res)
+	return ec.marshalOUser2ᚖserverᚋapiᚋgraphqlᚋgraphᚐUser(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _AuthorizationBatch_amounts(ctx context.Context, field graphql.CollectedField, obj *AuthorizationBatch) (ret graphql.Marshaler) {
+func (ec *executionContext) _Job_id(ctx context.Context, field graphql.CollectedField, obj *Job) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3673,7 +7031,7 @@ func (ec *executionContext) _AuthorizationBatch_amounts(ctx context.Context, fie
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "AuthorizationBatch",
+		Object:     "Job",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -3683,21 +7041,24 @@ func (ec *executionContext) _AuthorizationBatch_amounts(ctx context.Context, fie
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Amounts, nil
+		return obj.ID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]int)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalOInt2ᚕintᚄ(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _AuthorizationBatch_validUntil(ctx context.Context, field graphql.CollectedField, obj *AuthorizationBatch) (ret graphql.Marshaler) {
+func (ec *executionContext) _Job_type(ctx context.Context, field graphql.CollectedField, obj *Job) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3705,7 +7066,7 @@ func (ec *executionContext) _AuthorizationBatch_validUntil(ctx context.Context,
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "AuthorizationBatch",
+		Object:     "Job",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -3715,7 +7076,7 @@ func (ec *executionContext) _AuthorizationBatch_validUntil(ctx context.Context,
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ValidUntil, nil
+		return obj.Type, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3727,12 +7088,12 @@ func (ec *executionContext) _AuthorizationBatch_validUntil(ctx context.Context,
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _AuthorizationBatchResponse_signature(ctx context.Context, field graphql.CollectedField, obj *AuthorizationBatchResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _Job_payload(ctx context.Context, field graphql.CollectedField, obj *Job) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3740,7 +7101,7 @@ func (ec *executionContext) _AuthorizationBatchResponse_signature(ctx context.Co
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "AuthorizationBatchResponse",
+		Object:     "Job",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -3750,7 +7111,7 @@ func (ec *executionContext) _AuthorizationBatchResponse_signature(ctx context.Co
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Signature, nil
+		return obj.Payload, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3762,12 +7123,12 @@ func (ec *executionContext) _AuthorizationBatchResponse_signature(ctx context.Co
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*Signature)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNSignature2ᚖserverᚋapiᚋgraphqlᚋgraphᚐSignature(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _AuthorizationBatchResponse_authorization(ctx context.Context, field graphql.CollectedField, obj *AuthorizationBatchResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _Job_attempts(ctx context.Context, field graphql.CollectedField, obj *Job) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3775,7 +7136,7 @@ func (ec *executionContext) _AuthorizationBatchResponse_authorization(ctx contex
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "AuthorizationBatchResponse",
+		Object:     "Job",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -3785,7 +7146,7 @@ func (ec *executionContext) _AuthorizationBatchResponse_authorization(ctx contex
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Authorization, nil
+		return obj.Attempts, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3797,12 +7158,12 @@ func (ec *executionContext) _AuthorizationBatchResponse_authorization(ctx contex
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*AuthorizationBatch)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNAuthorizationBatch2ᚖserverᚋapiᚋgraphqlᚋgraphᚐAuthorizationBatch(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _AuthorizationBuy_tokenContract(ctx context.Context, field graphql.CollectedField, obj *AuthorizationBuy) (ret graphql.Marshaler) {
+func (ec *executionContext) _Job_lastError(ctx context.Context, field graphql.CollectedField, obj *Job) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3810,7 +7171,7 @@ func (ec *executionContext) _AuthorizationBuy_tokenContract(ctx context.Context,
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "AuthorizationBuy",
+		Object:     "Job",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -3820,7 +7181,7 @@ func (ec *executionContext) _AuthorizationBuy_tokenContract(ctx context.Context,
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TokenContract, nil
+		return obj.LastError, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3837,7 +7198,7 @@ func (ec *executionContext) _AuthorizationBuy_tokenContract(ctx context.Context,
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _AuthorizationBuy_seller(ctx context.Context, field graphql.CollectedField, obj *AuthorizationBuy) (ret graphql.Marshaler) {
+func (ec *executionContext) _Job_creationTime(ctx context.Context, field graphql.CollectedField, obj *Job) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3845,7 +7206,7 @@ func (ec *executionContext) _AuthorizationBuy_seller(ctx context.Context, field
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "AuthorizationBuy",
+		Object:     "Job",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -3855,7 +7216,7 @@ func (ec *executionContext) _AuthorizationBuy_seller(ctx context.Context, field
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Seller, nil
+		return obj.CreationTime, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3867,12 +7228,12 @@ func (ec *executionContext) _AuthorizationBuy_seller(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(time.Time)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _AuthorizationBuy_id(ctx context.Context, field graphql.CollectedField, obj *AuthorizationBuy) (ret graphql.Marshaler) {
+func (ec *executionContext) _Job_deadTime(ctx context.Context, field graphql.CollectedField, obj *Job) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3880,7 +7241,7 @@ func (ec *executionContext) _AuthorizationBuy_id(ctx context.Context, field grap
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "AuthorizationBuy",
+		Object:     "Job",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -3890,24 +7251,21 @@ func (ec *executionContext) _AuthorizationBuy_id(ctx context.Context, field grap
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
+		return obj.DeadTime, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(*time.Time)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _AuthorizationBuy_amount(ctx context.Context, field graphql.CollectedField, obj *AuthorizationBuy) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_createAPIKey(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3915,17 +7273,44 @@ func (ec *executionContext) _AuthorizationBuy_amount(ctx context.Context, field
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "AuthorizationBuy",
+		Object:     "Mutation",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_createAPIKey_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Amount, nil
+		directive0 := func(rctx context.Context) (interface{}, error) {
+			ctx = rctx // use context from middleware stack in children
+			return ec.resolvers.Mutation().CreateAPIKey(rctx, args["input"].(CreateAPIKeyInput))
+		}
+		directive1 := func(ctx context.Context) (interface{}, error) {
+			if ec.directives.Authenticate == nil {
+				return nil, errors.New("directive authenticate is not implemented")
+			}
+			return ec.directives.Authenticate(ctx, nil, directive0, nil, nil, nil)
+		}
+
+		tmp, err := directive1(rctx)
+		if err != nil {
+			return nil, graphql.ErrorOnPath(ctx, err)
+		}
+		if tmp == nil {
+			return nil, nil
+		}
+		if data, ok := tmp.(*CreatedAPIKey); ok {
+			return data, nil
+		}
+		return nil, fmt.Errorf(`unexpected type %T from directive, should be *server/api/graphql/graph.CreatedAPIKey`, tmp)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3937,12 +7322,12 @@ func (ec *executionContext) _AuthorizationBuy_amount(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(*CreatedAPIKey)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNCreatedAPIKey2ᚖserverᚋapiᚋgraphqlᚋgraphᚐCreatedAPIKey(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _AuthorizationBuy_toSeller(ctx context.Context, field graphql.CollectedField, obj *AuthorizationBuy) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_revokeAPIKey(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3950,34 +7335,58 @@ func (ec *executionContext) _AuthorizationBuy_toSeller(ctx context.Context, fiel
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "AuthorizationBuy",
+		Object:     "Mutation",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_revokeAPIKey_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.ToSeller, nil
+		directive0 := func(rctx context.Context) (interface{}, error) {
+			ctx = rctx // use context from middleware stack in children
+			return ec.resolvers.Mutation().RevokeAPIKey(rctx, args["input"].(RevokeAPIKeyInput))
+		}
+		directive1 := func(ctx context.Context) (interface{}, error) {
+			if ec.directives.Authenticate == nil {
+				return nil, errors.New("directive authenticate is not implemented")
+			}
+			return ec.directives.Authenticate(ctx, nil, directive0, nil, nil, nil)
+		}
+
+		tmp, err := directive1(rctx)
+		if err != nil {
+			return nil, graphql.ErrorOnPath(ctx, err)
+		}
+		if tmp == nil {
+			return nil, nil
+		}
+		if data, ok := tmp.(*string); ok {
+			return data, nil
+		}
+		return nil, fmt.Errorf(`unexpected type %T from directive, should be *string`, tmp)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _AuthorizationBuy_total(ctx context.Context, field graphql.CollectedField, obj *AuthorizationBuy) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_login(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3985,34 +7394,38 @@ func (ec *executionContext) _AuthorizationBuy_total(ctx context.Context, field g
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "AuthorizationBuy",
+		Object:     "Mutation",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_login_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Total, nil
+		return ec.resolvers.Mutation().Login(rctx, args["input"].(*LoginInput))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*Authentication)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOAuthentication2ᚖserverᚋapiᚋgraphqlᚋgraphᚐAuthentication(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _AuthorizationBuy_validUntil(ctx context.Context, field graphql.CollectedField, obj *AuthorizationBuy) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_loginBlockchainInitialize(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4020,34 +7433,38 @@ func (ec *executionContext) _AuthorizationBuy_validUntil(ctx context.Context, fi
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "AuthorizationBuy",
+		Object:     "Mutation",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_loginBlockchainInitialize_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ValidUntil, nil
+		return ec.resolvers.Mutation().LoginBlockchainInitialize(rctx, args["input"].(LoginBlockchainInitializeInput))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _AuthorizationBuyResponse_signature(ctx context.Context, field graphql.CollectedField, obj *AuthorizationBuyResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_loginBlockchainEnd(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4055,34 +7472,38 @@ func (ec *executionContext) _AuthorizationBuyResponse_signature(ctx context.Cont
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "AuthorizationBuyResponse",
+		Object:     "Mutation",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_loginBlockchainEnd_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Signature, nil
+		return ec.resolvers.Mutation().LoginBlockchainEnd(rctx, args["input"].(*LoginBlockchainEndInput))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(*Signature)
+	res := resTmp.(*Authentication)
 	fc.Result = res
-	return ec.marshalNSignature2ᚖserverᚋapiᚋgraphqlᚋgraphᚐSignature(ctx, field.Selections, res)
+	return ec.marshalOAuthentication2ᚖserverᚋapiᚋgraphqlᚋgraphᚐAuthentication(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _AuthorizationBuyResponse_authorization(ctx context.Context, field graphql.CollectedField, obj *AuthorizationBuyResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_loginPasskeyInitialize(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4090,34 +7511,31 @@ func (ec *executionContext) _AuthorizationBuyResponse_authorization(ctx context.
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "AuthorizationBuyResponse",
+		Object:     "Mutation",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Authorization, nil
+		return ec.resolvers.Mutation().LoginPasskeyInitialize(rctx)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(*AuthorizationBuy)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNAuthorizationBuy2ᚖserverᚋapiᚋgraphqlᚋgraphᚐAuthorizationBuy(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _AuthorizationTransfer_tokenContract(ctx context.Context, field graphql.CollectedField, obj *AuthorizationTransfer) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_loginPasskeyEnd(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4125,34 +7543,38 @@ func (ec *executionContext) _AuthorizationTransfer_tokenContract(ctx context.Con
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "AuthorizationTransfer",
+		Object:     "Mutation",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_loginPasskeyEnd_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TokenContract, nil
+		return ec.resolvers.Mutation().LoginPasskeyEnd(rctx, args["input"].(LoginPasskeyEndInput))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*Authentication)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOAuthentication2ᚖserverᚋapiᚋgraphqlᚋgraphᚐAuthentication(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _AuthorizationTransfer_id(ctx context.Context, field graphql.CollectedField, obj *AuthorizationTransfer) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_socialLoginInitialize(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4160,34 +7582,38 @@ func (ec *executionContext) _AuthorizationTransfer_id(ctx context.Context, field
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "AuthorizationTransfer",
+		Object:     "Mutation",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_socialLoginInitialize_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
+		return ec.resolvers.Mutation().SocialLoginInitialize(rctx, args["input"].(SocialLoginInitializeInput))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _AuthorizationTransfer_amount(ctx context.Context, field graphql.CollectedField, obj *AuthorizationTransfer) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_socialLoginEnd(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4195,34 +7621,38 @@ func (ec *executionContext) _AuthorizationTransfer_amount(ctx context.Context, f
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "AuthorizationTransfer",
+		Object:     "Mutation",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_socialLoginEnd_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Amount, nil
+		return ec.resolvers.Mutation().SocialLoginEnd(rctx, args["input"].(SocialLoginEndInput))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(*Authentication)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalOAuthentication2ᚖserverᚋapiᚋgraphqlᚋgraphᚐAuthentication(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _AuthorizationTransfer_validUntil(ctx context.Context, field graphql.CollectedField, obj *AuthorizationTransfer) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_unlinkSocialLogin(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4230,34 +7660,58 @@ func (ec *executionContext) _AuthorizationTransfer_validUntil(ctx context.Contex
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "AuthorizationTransfer",
+		Object:     "Mutation",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_unlinkSocialLogin_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.ValidUntil, nil
+		directive0 := func(rctx context.Context) (interface{}, error) {
+			ctx = rctx // use context from middleware stack in children
+			return ec.resolvers.Mutation().UnlinkSocialLogin(rctx, args["input"].(UnlinkSocialLoginInput))
+		}
+		directive1 := func(ctx context.Context) (interface{}, error) {
+			if ec.directives.Authenticate == nil {
+				return nil, errors.New("directive authenticate is not implemented")
+			}
+			return ec.directives.Authenticate(ctx, nil, directive0, nil, nil, nil)
+		}
+
+		tmp, err := directive1(rctx)
+		if err != nil {
+			return nil, graphql.ErrorOnPath(ctx, err)
+		}
+		if tmp == nil {
+			return nil, nil
+		}
+		if data, ok := tmp.(*string); ok {
+			return data, nil
+		}
+		return nil, fmt.Errorf(`unexpected type %T from directive, should be *string`, tmp)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _AuthorizationTransferResponse_signature(ctx context.Context, field graphql.CollectedField, obj *AuthorizationTransferResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_socialReauthenticateInitialize(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4265,34 +7719,58 @@ func (ec *executionContext) _AuthorizationTransferResponse_signature(ctx context
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "AuthorizationTransferResponse",
+		Object:     "Mutation",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_socialReauthenticateInitialize_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Signature, nil
+		directive0 := func(rctx context.Context) (interface{}, error) {
+			ctx = rctx // use context from middleware stack in children
+			return ec.resolvers.Mutation().SocialReauthenticateInitialize(rctx, args["input"].(SocialLoginInitializeInput))
+		}
+		directive1 := func(ctx context.Context) (interface{}, error) {
+			if ec.directives.Authenticate == nil {
+				return nil, errors.New("directive authenticate is not implemented")
+			}
+			return ec.directives.Authenticate(ctx, nil, directive0, nil, nil, nil)
+		}
+
+		tmp, err := directive1(rctx)
+		if err != nil {
+			return nil, graphql.ErrorOnPath(ctx, err)
+		}
+		if tmp == nil {
+			return nil, nil
+		}
+		if data, ok := tmp.(*string); ok {
+			return data, nil
+		}
+		return nil, fmt.Errorf(`unexpected type %T from directive, should be *string`, tmp)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(*Signature)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNSignature2ᚖserverᚋapiᚋgraphqlᚋgraphᚐSignature(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _AuthorizationTransferResponse_authorization(ctx context.Context, field graphql.CollectedField, obj *AuthorizationTransferResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_socialReauthenticateEnd(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4300,34 +7778,58 @@ func (ec *executionContext) _AuthorizationTransferResponse_authorization(ctx con
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "AuthorizationTransferResponse",
+		Object:     "Mutation",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_socialReauthenticateEnd_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Authorization, nil
+		directive0 := func(rctx context.Context) (interface{}, error) {
+			ctx = rctx // use context from middleware stack in children
+			return ec.resolvers.Mutation().SocialReauthenticateEnd(rctx, args["input"].(SocialLoginEndInput))
+		}
+		directive1 := func(ctx context.Context) (interface{}, error) {
+			if ec.directives.Authenticate == nil {
+				return nil, errors.New("directive authenticate is not implemented")
+			}
+			return ec.directives.Authenticate(ctx, nil, directive0, nil, nil, nil)
+		}
+
+		tmp, err := directive1(rctx)
+		if err != nil {
+			return nil, graphql.ErrorOnPath(ctx, err)
+		}
+		if tmp == nil {
+			return nil, nil
+		}
+		if data, ok := tmp.(*string); ok {
+			return data, nil
+		}
+		return nil, fmt.Errorf(`unexpected type %T from directive, should be *string`, tmp)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(*AuthorizationTransfer)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNAuthorizationTransfer2ᚖserverᚋapiᚋgraphqlᚋgraphᚐAuthorizationTransfer(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _BankAccount_country(ctx context.Context, field graphql.CollectedField, obj *BankAccount) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_logout(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4335,34 +7837,55 @@ func (ec *executionContext) _BankAccount_country(ctx context.Context, field grap
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "BankAccount",
+		Object:     "Mutation",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Country, nil
+		directive0 := func(rctx context.Context) (interface{}, error) {
+			ctx = rctx // use context from middleware stack in children
+			return ec.resolvers.Mutation().Logout(rctx)
+		}
+		directive1 := func(ctx context.Context) (interface{}, error) {
+			enforce, err := ec.unmarshalOBoolean2ᚖbool(ctx, false)
+			if err != nil {
+				return nil, err
+			}
+			if ec.directives.Authenticate == nil {
+				return nil, errors.New("directive authenticate is not implemented")
+			}
+			return ec.directives.Authenticate(ctx, nil, directive0, nil, enforce, nil)
+		}
+
+		tmp, err := directive1(rctx)
+		if err != nil {
+			return nil, graphql.ErrorOnPath(ctx, err)
+		}
+		if tmp == nil {
+			return nil, nil
+		}
+		if data, ok := tmp.(*string); ok {
+			return data, nil
+		}
+		return nil, fmt.Errorf(`unexpected type %T from directive, should be *string`, tmp)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _BankAccount_accountHolderName(ctx context.Context, field graphql.CollectedField, obj *BankAccount) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_forgotPasswordInitialize(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4370,34 +7893,38 @@ func (ec *executionContext) _BankAccount_accountHolderName(ctx context.Context,
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "BankAccount",
+		Object:     "Mutation",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_forgotPasswordInitialize_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.AccountHolderName, nil
+		return ec.resolvers.Mutation().ForgotPasswordInitialize(rctx, args["input"].(*ForgotPasswordInitialize))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _BankAccount_accountHolderType(ctx context.Context, field graphql.CollectedField, obj *BankAccount) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_forgotPasswordEnd(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4405,34 +7932,38 @@ func (ec *executionContext) _BankAccount_accountHolderType(ctx context.Context,
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "BankAccount",
+		Object:     "Mutation",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_forgotPasswordEnd_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.AccountHolderType, nil
+		return ec.resolvers.Mutation().ForgotPasswordEnd(rctx, args["input"].(*ForgotPasswordEnd))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _BankAccount_currency(ctx context.Context, field graphql.CollectedField, obj *BankAccount) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_unlockLogin(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4440,34 +7971,62 @@ func (ec *executionContext) _BankAccount_currency(ctx context.Context, field gra
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "BankAccount",
+		Object:     "Mutation",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_unlockLogin_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Currency, nil
+		directive0 := func(rctx context.Context) (interface{}, error) {
+			ctx = rctx // use context from middleware stack in children
+			return ec.resolvers.Mutation().UnlockLogin(rctx, args["input"].(UnlockLoginInput))
+		}
+		directive1 := func(ctx context.Context) (interface{}, error) {
+			permissions, err := ec.unmarshalNString2ᚕstringᚄ(ctx, []interface{}{"users:unlock"})
+			if err != nil {
+				return nil, err
+			}
+			if ec.directives.Requires == nil {
+				return nil, errors.New("directive requires is not implemented")
+			}
+			return ec.directives.Requires(ctx, nil, directive0, permissions)
+		}
+
+		tmp, err := directive1(rctx)
+		if err != nil {
+			return nil, graphql.ErrorOnPath(ctx, err)
+		}
+		if tmp == nil {
+			return nil, nil
+		}
+		if data, ok := tmp.(*string); ok {
+			return data, nil
+		}
+		return nil, fmt.Errorf(`unexpected type %T from directive, should be *string`, tmp)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _BankAccount_routingNumber(ctx context.Context, field graphql.CollectedField, obj *BankAccount) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_associateAddressInitialize(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4475,34 +8034,58 @@ func (ec *executionContext) _BankAccount_routingNumber(ctx context.Context, fiel
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "BankAccount",
+		Object:     "Mutation",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_associateAddressInitialize_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.RoutingNumber, nil
+		directive0 := func(rctx context.Context) (interface{}, error) {
+			ctx = rctx // use context from middleware stack in children
+			return ec.resolvers.Mutation().AssociateAddressInitialize(rctx, args["input"].(*AssociateAddressInitialize))
+		}
+		directive1 := func(ctx context.Context) (interface{}, error) {
+			if ec.directives.Authenticate == nil {
+				return nil, errors.New("directive authenticate is not implemented")
+			}
+			return ec.directives.Authenticate(ctx, nil, directive0, nil, nil, nil)
+		}
+
+		tmp, err := directive1(rctx)
+		if err != nil {
+			return nil, graphql.ErrorOnPath(ctx, err)
+		}
+		if tmp == nil {
+			return nil, nil
+		}
+		if data, ok := tmp.(*string); ok {
+			return data, nil
+		}
+		return nil, fmt.Errorf(`unexpected type %T from directive, should be *string`, tmp)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _BankAccount_status(ctx context.Context, field graphql.CollectedField, obj *BankAccount) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_associateAddressEnd(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4510,34 +8093,58 @@ func (ec *executionContext) _BankAccount_status(ctx context.Context, field graph
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "BankAccount",
+		Object:     "Mutation",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_associateAddressEnd_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Status, nil
+		directive0 := func(rctx context.Context) (interface{}, error) {
+			ctx = rctx // use context from middleware stack in children
+			return ec.resolvers.Mutation().AssociateAddressEnd(rctx, args["input"].(*AssociateAddressEnd))
+		}
+		directive1 := func(ctx context.Context) (interface{}, error) {
+			if ec.directives.Authenticate == nil {
+				return nil, errors.New("directive authenticate is not implemented")
+			}
+			return ec.directives.Authenticate(ctx, nil, directive0, nil, nil, nil)
+		}
+
+		tmp, err := directive1(rctx)
+		if err != nil {
+			return nil, graphql.ErrorOnPath(ctx, err)
+		}
+		if tmp == nil {
+			return nil, nil
+		}
+		if data, ok := tmp.(*string); ok {
+			return data, nil
+		}
+		return nil, fmt.Errorf(`unexpected type %T from directive, should be *string`, tmp)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _BankAccount_last4(ctx context.Context, field graphql.CollectedField, obj *BankAccount) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_registerPasskeyInitialize(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4545,34 +8152,51 @@ func (ec *executionContext) _BankAccount_last4(ctx context.Context, field graphq
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "BankAccount",
+		Object:     "Mutation",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Last4, nil
+		directive0 := func(rctx context.Context) (interface{}, error) {
+			ctx = rctx // use context from middleware stack in children
+			return ec.resolvers.Mutation().RegisterPasskeyInitialize(rctx)
+		}
+		directive1 := func(ctx context.Context) (interface{}, error) {
+			if ec.directives.Authenticate == nil {
+				return nil, errors.New("directive authenticate is not implemented")
+			}
+			return ec.directives.Authenticate(ctx, nil, directive0, nil, nil, nil)
+		}
+
+		tmp, err := directive1(rctx)
+		if err != nil {
+			return nil, graphql.ErrorOnPath(ctx, err)
+		}
+		if tmp == nil {
+			return nil, nil
+		}
+		if data, ok := tmp.(*string); ok {
+			return data, nil
+		}
+		return nil, fmt.Errorf(`unexpected type %T from directive, should be *string`, tmp)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _BankAccountRequirements_currencies(ctx context.Context, field graphql.CollectedField, obj *BankAccountRequirements) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_registerPasskeyEnd(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4580,17 +8204,44 @@ func (ec *executionContext) _BankAccountRequirements_currencies(ctx context.Cont
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "BankAccountRequirements",
+		Object:     "Mutation",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_registerPasskeyEnd_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Currencies, nil
+		directive0 := func(rctx context.Context) (interface{}, error) {
+			ctx = rctx // use context from middleware stack in children
+			return ec.resolvers.Mutation().RegisterPasskeyEnd(rctx, args["input"].(RegisterPasskeyEndInput))
+		}
+		directive1 := func(ctx context.Context) (interface{}, error) {
+			if ec.directives.Authenticate == nil {
+				return nil, errors.New("directive authenticate is not implemented")
+			}
+			return ec.directives.Authenticate(ctx, nil, directive0, nil, nil, nil)
+		}
+
+		tmp, err := directive1(rctx)
+		if err != nil {
+			return nil, graphql.ErrorOnPath(ctx, err)
+		}
+		if tmp == nil {
+			return nil, nil
+		}
+		if data, ok := tmp.(*string); ok {
+			return data, nil
+		}
+		return nil, fmt.Errorf(`unexpected type %T from directive, should be *string`, tmp)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4599,12 +8250,12 @@ func (ec *executionContext) _BankAccountRequirements_currencies(ctx context.Cont
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.([]*Currency)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalOCurrency2ᚕᚖserverᚋapiᚋgraphqlᚋgraphᚐCurrencyᚄ(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _BlogPost_id(ctx context.Context, field graphql.CollectedField, obj *BlogPost) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_createTransferAuthorization(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4612,34 +8263,58 @@ func (ec *executionContext) _BlogPost_id(ctx context.Context, field graphql.Coll
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "BlogPost",
+		Object:     "Mutation",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_createTransferAuthorization_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
+		directive0 := func(rctx context.Context) (interface{}, error) {
+			ctx = rctx // use context from middleware stack in children
+			return ec.resolvers.Mutation().CreateTransferAuthorization(rctx, args["input"].(*CreateTransferAuthorizationInput))
+		}
+		directive1 := func(ctx context.Context) (interface{}, error) {
+			if ec.directives.Authenticate == nil {
+				return nil, errors.New("directive authenticate is not implemented")
+			}
+			return ec.directives.Authenticate(ctx, nil, directive0, nil, nil, nil)
+		}
+
+		tmp, err := directive1(rctx)
+		if err != nil {
+			return nil, graphql.ErrorOnPath(ctx, err)
+		}
+		if tmp == nil {
+			return nil, nil
+		}
+		if data, ok := tmp.(*AuthorizationTransferResponse); ok {
+			return data, nil
+		}
+		return nil, fmt.Errorf(`unexpected type %T from directive, should be *server/api/graphql/graph.AuthorizationTransferResponse`, tmp)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(*AuthorizationTransferResponse)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalOAuthorizationTransferResponse2ᚖserverᚋapiᚋgraphqlᚋgraphᚐAuthorizationTransferResponse(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _BlogPost_title(ctx context.Context, field graphql.CollectedField, obj *BlogPost) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_createBatchTransferAuthorization(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4647,34 +8322,58 @@ func (ec *executionContext) _BlogPost_title(ctx context.Context, field graphql.C
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "BlogPost",
+		Object:     "Mutation",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_createBatchTransferAuthorization_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Title, nil
+		directive0 := func(rctx context.Context) (interface{}, error) {
+			ctx = rctx // use context from middleware stack in children
+			return ec.resolvers.Mutation().CreateBatchTransferAuthorization(rctx, args["input"].(*CreateBatchTransferAuthorizationInput))
+		}
+		directive1 := func(ctx context.Context) (interface{}, error) {
+			if ec.directives.Authenticate == nil {
+				return nil, errors.New("directive authenticate is not implemented")
+			}
+			return ec.directives.Authenticate(ctx, nil, directive0, nil, nil, nil)
+		}
+
+		tmp, err := directive1(rctx)
+		if err != nil {
+			return nil, graphql.ErrorOnPath(ctx, err)
+		}
+		if tmp == nil {
+			return nil, nil
+		}
+		if data, ok := tmp.(*AuthorizationBatchResponse); ok {
+			return data, nil
+		}
+		return nil, fmt.Errorf(`unexpected type %T from directive, should be *server/api/graphql/graph.AuthorizationBatchResponse`, tmp)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*AuthorizationBatchResponse)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOAuthorizationBatchResponse2ᚖserverᚋapiᚋgraphqlᚋgraphᚐAuthorizationBatchResponse(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _BlogPost_body(ctx context.Context, field graphql.CollectedField, obj *BlogPost) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_createBuyAuthorization(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4682,34 +8381,58 @@ func (ec *executionContext) _BlogPost_body(ctx context.Context, field graphql.Co
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "BlogPost",
+		Object:     "Mutation",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_createBuyAuthorization_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Body, nil
+		directive0 := func(rctx context.Context) (interface{}, error) {
+			ctx = rctx // use context from middleware stack in children
+			return ec.resolvers.Mutation().CreateBuyAuthorization(rctx, args["input"].(*CreateBuyAuthorizationInput))
+		}
+		directive1 := func(ctx context.Context) (interface{}, error) {
+			if ec.directives.Authenticate == nil {
+				return nil, errors.New("directive authenticate is not implemented")
+			}
+			return ec.directives.Authenticate(ctx, nil, directive0, nil, nil, nil)
+		}
+
+		tmp, err := directive1(rctx)
+		if err != nil {
+			return nil, graphql.ErrorOnPath(ctx, err)
+		}
+		if tmp == nil {
+			return nil, nil
+		}
+		if data, ok := tmp.(*AuthorizationBuyResponse); ok {
+			return data, nil
+		}
+		return nil, fmt.Errorf(`unexpected type %T from directive, should be *server/api/graphql/graph.AuthorizationBuyResponse`, tmp)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*AuthorizationBuyResponse)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOAuthorizationBuyResponse2ᚖserverᚋapiᚋgraphqlᚋgraphᚐAuthorizationBuyResponse(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _BlogPost_image(ctx context.Context, field graphql.CollectedField, obj *BlogPost) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_createBlogPost(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4717,17 +8440,48 @@ func (ec *executionContext) _BlogPost_image(ctx context.Context, field graphql.C
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "BlogPost",
+		Object:     "Mutation",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_createBlogPost_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Image, nil
+		directive0 := func(rctx context.Context) (interface{}, error) {
+			ctx = rctx // use context from middleware stack in children
+			return ec.resolvers.Mutation().CreateBlogPost(rctx, args["input"].(*CreateBlogPostInput))
+		}
+		directive1 := func(ctx context.Context) (interface{}, error) {
+			permissions, err := ec.unmarshalNString2ᚕstringᚄ(ctx, []interface{}{"blog:write"})
+			if err != nil {
+				return nil, err
+			}
+			if ec.directives.Requires == nil {
+				return nil, errors.New("directive requires is not implemented")
+			}
+			return ec.directives.Requires(ctx, nil, directive0, permissions)
+		}
+
+		tmp, err := directive1(rctx)
+		if err != nil {
+			return nil, graphql.ErrorOnPath(ctx, err)
+		}
+		if tmp == nil {
+			return nil, nil
+		}
+		if data, ok := tmp.(*string); ok {
+			return data, nil
+		}
+		return nil, fmt.Errorf(`unexpected type %T from directive, should be *string`, tmp)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4741,7 +8495,7 @@ func (ec *executionContext) _BlogPost_image(ctx context.Context, field graphql.C
 	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _BlogPost_CreationTime(ctx context.Context, field graphql.CollectedField, obj *BlogPost) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_deleteBlogPost(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4749,34 +8503,62 @@ func (ec *executionContext) _BlogPost_CreationTime(ctx context.Context, field gr
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "BlogPost",
+		Object:     "Mutation",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_deleteBlogPost_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.CreationTime, nil
+		directive0 := func(rctx context.Context) (interface{}, error) {
+			ctx = rctx // use context from middleware stack in children
+			return ec.resolvers.Mutation().DeleteBlogPost(rctx, args["input"].(*DeleteBlogPostInput))
+		}
+		directive1 := func(ctx context.Context) (interface{}, error) {
+			permissions, err := ec.unmarshalNString2ᚕstringᚄ(ctx, []interface{}{"blog:write"})
+			if err != nil {
+				return nil, err
+			}
+			if ec.directives.Requires == nil {
+				return nil, errors.New("directive requires is not implemented")
+			}
+			return ec.directives.Requires(ctx, nil, directive0, permissions)
+		}
+
+		tmp, err := directive1(rctx)
+		if err != nil {
+			return nil, graphql.ErrorOnPath(ctx, err)
+		}
+		if tmp == nil {
+			return nil, nil
+		}
+		if data, ok := tmp.(*string); ok {
+			return data, nil
+		}
+		return nil, fmt.Errorf(`unexpected type %T from directive, should be *string`, tmp)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(time.Time)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Category_id(ctx context.Context, field graphql.CollectedField, obj *Category) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_submitDesignerApplication(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4784,34 +8566,51 @@ func (ec *executionContext) _Category_id(ctx context.Context, field graphql.Coll
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Category",
+		Object:     "Mutation",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
+		directive0 := func(rctx context.Context) (interface{}, error) {
+			ctx = rctx // use context from middleware stack in children
+			return ec.resolvers.Mutation().SubmitDesignerApplication(rctx)
+		}
+		directive1 := func(ctx context.Context) (interface{}, error) {
+			if ec.directives.Authenticate == nil {
+				return nil, errors.New("directive authenticate is not implemented")
+			}
+			return ec.directives.Authenticate(ctx, nil, directive0, nil, nil, nil)
+		}
+
+		tmp, err := directive1(rctx)
+		if err != nil {
+			return nil, graphql.ErrorOnPath(ctx, err)
+		}
+		if tmp == nil {
+			return nil, nil
+		}
+		if data, ok := tmp.(*string); ok {
+			return data, nil
+		}
+		return nil, fmt.Errorf(`unexpected type %T from directive, should be *string`, tmp)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Category_name(ctx context.Context, field graphql.CollectedField, obj *Category) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_resolveDesignerApplication(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4819,34 +8618,62 @@ func (ec *executionContext) _Category_name(ctx context.Context, field graphql.Co
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Category",
+		Object:     "Mutation",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_resolveDesignerApplication_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		directive0 := func(rctx context.Context) (interface{}, error) {
+			ctx = rctx // use context from middleware stack in children
+			return ec.resolvers.Mutation().ResolveDesignerApplication(rctx, args["input"].(*ResolveDesignerApplicationInput))
+		}
+		directive1 := func(ctx context.Context) (interface{}, error) {
+			permissions, err := ec.unmarshalNString2ᚕstringᚄ(ctx, []interface{}{"designer_applications:resolve"})
+			if err != nil {
+				return nil, err
+			}
+			if ec.directives.Requires == nil {
+				return nil, errors.New("directive requires is not implemented")
+			}
+			return ec.directives.Requires(ctx, nil, directive0, permissions)
+		}
+
+		tmp, err := directive1(rctx)
+		if err != nil {
+			return nil, graphql.ErrorOnPath(ctx, err)
+		}
+		if tmp == nil {
+			return nil, nil
+		}
+		if data, ok := tmp.(*string); ok {
+			return data, nil
+		}
+		return nil, fmt.Errorf(`unexpected type %T from directive, should be *string`, tmp)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _CreateStripeCheckoutResponse_stripeCheckoutLink(ctx context.Context, field graphql.CollectedField, obj *CreateStripeCheckoutResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_retryJob(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4854,34 +8681,62 @@ func (ec *executionContext) _CreateStripeCheckoutResponse_stripeCheckoutLink(ctx
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "CreateStripeCheckoutResponse",
+		Object:     "Mutation",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_retryJob_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.StripeCheckoutLink, nil
+		directive0 := func(rctx context.Context) (interface{}, error) {
+			ctx = rctx // use context from middleware stack in children
+			return ec.resolvers.Mutation().RetryJob(rctx, args["input"].(RetryJobInput))
+		}
+		directive1 := func(ctx context.Context) (interface{}, error) {
+			permissions, err := ec.unmarshalNString2ᚕstringᚄ(ctx, []interface{}{"jobs:manage"})
+			if err != nil {
+				return nil, err
+			}
+			if ec.directives.Requires == nil {
+				return nil, errors.New("directive requires is not implemented")
+			}
+			return ec.directives.Requires(ctx, nil, directive0, permissions)
+		}
+
+		tmp, err := directive1(rctx)
+		if err != nil {
+			return nil, graphql.ErrorOnPath(ctx, err)
+		}
+		if tmp == nil {
+			return nil, nil
+		}
+		if data, ok := tmp.(*string); ok {
+			return data, nil
+		}
+		return nil, fmt.Errorf(`unexpected type %T from directive, should be *string`, tmp)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _CreateStripeCheckoutResponse_paymentIntentId(ctx context.Context, field graphql.CollectedField, obj *CreateStripeCheckoutResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_toggleCategory(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4889,17 +8744,48 @@ func (ec *executionContext) _CreateStripeCheckoutResponse_paymentIntentId(ctx co
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "CreateStripeCheckoutResponse",
+		Object:     "Mutation",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_toggleCategory_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.PaymentIntentID, nil
+		directive0 := func(rctx context.Context) (interface{}, error) {
+			ctx = rctx // use context from middleware stack in children
+			return ec.resolvers.Mutation().ToggleCategory(rctx, args["input"].(ToggleCategory))
+		}
+		directive1 := func(ctx context.Context) (interface{}, error) {
+			rules, err := ec.unmarshalORULE2ᚕserverᚋapiᚋgraphqlᚋgraphᚐRuleᚄ(ctx, []interface{}{"DESIGNER_OF"})
+			if err != nil {
+				return nil, err
+			}
+			if ec.directives.Authenticate == nil {
+				return nil, errors.New("directive authenticate is not implemented")
+			}
+			return ec.directives.Authenticate(ctx, nil, directive0, rules, nil, nil)
+		}
+
+		tmp, err := directive1(rctx)
+		if err != nil {
+			return nil, graphql.ErrorOnPath(ctx, err)
+		}
+		if tmp == nil {
+			return nil, nil
+		}
+		if data, ok := tmp.(*Category); ok {
+			return data, nil
+		}
+		return nil, fmt.Errorf(`unexpected type %T from directive, should be *server/api/graphql/graph.Category`, tmp)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4911,12 +8797,12 @@ func (ec *executionContext) _CreateStripeCheckoutResponse_paymentIntentId(ctx co
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*Category)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNCategory2ᚖserverᚋapiᚋgraphqlᚋgraphᚐCategory(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Currency_code(ctx context.Context, field graphql.CollectedField, obj *Currency) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_createIPFSHash(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4924,34 +8810,58 @@ func (ec *executionContext) _Currency_code(ctx context.Context, field graphql.Co
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Currency",
+		Object:     "Mutation",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_createIPFSHash_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Code, nil
+		directive0 := func(rctx context.Context) (interface{}, error) {
+			ctx = rctx // use context from middleware stack in children
+			return ec.resolvers.Mutation().CreateIPFSHash(rctx, args["input"].(CreateIPFSHashInput))
+		}
+		directive1 := func(ctx context.Context) (interface{}, error) {
+			if ec.directives.Authenticate == nil {
+				return nil, errors.New("directive authenticate is not implemented")
+			}
+			return ec.directives.Authenticate(ctx, nil, directive0, nil, nil, nil)
+		}
+
+		tmp, err := directive1(rctx)
+		if err != nil {
+			return nil, graphql.ErrorOnPath(ctx, err)
+		}
+		if tmp == nil {
+			return nil, nil
+		}
+		if data, ok := tmp.(*string); ok {
+			return data, nil
+		}
+		return nil, fmt.Errorf(`unexpected type %T from directive, should be *string`, tmp)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Currency_countries(ctx context.Context, field graphql.CollectedField, obj *Currency) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_setFilter(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4959,17 +8869,48 @@ func (ec *executionContext) _Currency_countries(ctx context.Context, field graph
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Currency",
+		Object:     "Mutation",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_setFilter_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Countries, nil
+		directive0 := func(rctx context.Context) (interface{}, error) {
+			ctx = rctx // use context from middleware stack in children
+			return ec.resolvers.Mutation().SetFilter(rctx, args["input"].(SaveFilter))
+		}
+		directive1 := func(ctx context.Context) (interface{}, error) {
+			rules, err := ec.unmarshalORULE2ᚕserverᚋapiᚋgraphqlᚋgraphᚐRuleᚄ(ctx, []interface{}{"DESIGNER_OF"})
+			if err != nil {
+				return nil, err
+			}
+			if ec.directives.Authenticate == nil {
+				return nil, errors.New("directive authenticate is not implemented")
+			}
+			return ec.directives.Authenticate(ctx, nil, directive0, rules, nil, nil)
+		}
+
+		tmp, err := directive1(rctx)
+		if err != nil {
+			return nil, graphql.ErrorOnPath(ctx, err)
+		}
+		if tmp == nil {
+			return nil, nil
+		}
+		if data, ok := tmp.(*string); ok {
+			return data, nil
+		}
+		return nil, fmt.Errorf(`unexpected type %T from directive, should be *string`, tmp)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4978,12 +8919,12 @@ func (ec *executionContext) _Currency_countries(ctx context.Context, field graph
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.([]string)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalOString2ᚕstringᚄ(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _DesignerApplication_userId(ctx context.Context, field graphql.CollectedField, obj *DesignerApplication) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_saveCreationIntent(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4991,34 +8932,62 @@ func (ec *executionContext) _DesignerApplication_userId(ctx context.Context, fie
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "DesignerApplication",
+		Object:     "Mutation",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_saveCreationIntent_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.UserID, nil
+		directive0 := func(rctx context.Context) (interface{}, error) {
+			ctx = rctx // use context from middleware stack in children
+			return ec.resolvers.Mutation().SaveCreationIntent(rctx, args["input"].(SaveCreationIntentInput))
+		}
+		directive1 := func(ctx context.Context) (interface{}, error) {
+			rules, err := ec.unmarshalORULE2ᚕserverᚋapiᚋgraphqlᚋgraphᚐRuleᚄ(ctx, []interface{}{"DESIGNER_ROLE"})
+			if err != nil {
+				return nil, err
+			}
+			if ec.directives.Authenticate == nil {
+				return nil, errors.New("directive authenticate is not implemented")
+			}
+			return ec.directives.Authenticate(ctx, nil, directive0, rules, nil, nil)
+		}
+
+		tmp, err := directive1(rctx)
+		if err != nil {
+			return nil, graphql.ErrorOnPath(ctx, err)
+		}
+		if tmp == nil {
+			return nil, nil
+		}
+		if data, ok := tmp.(*string); ok {
+			return data, nil
+		}
+		return nil, fmt.Errorf(`unexpected type %T from directive, should be *string`, tmp)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _DesignerApplication_user(ctx context.Context, field graphql.CollectedField, obj *DesignerApplication) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_updateOmnisendProducts(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5026,7 +8995,7 @@ func (ec *executionContext) _DesignerApplication_user(ctx context.Context, field
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "DesignerApplication",
+		Object:     "Mutation",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   true,
@@ -5035,25 +9004,46 @@ func (ec *executionContext) _DesignerApplication_user(ctx context.Context, field
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.DesignerApplication().User(rctx, obj)
+		directive0 := func(rctx context.Context) (interface{}, error) {
+			ctx = rctx // use context from middleware stack in children
+			return ec.resolvers.Mutation().UpdateOmnisendProducts(rctx)
+		}
+		directive1 := func(ctx context.Context) (interface{}, error) {
+			permissions, err := ec.unmarshalNString2ᚕstringᚄ(ctx, []interface{}{"omnisend:sync"})
+			if err != nil {
+				return nil, err
+			}
+			if ec.directives.Requires == nil {
+				return nil, errors.New("directive requires is not implemented")
+			}
+			return ec.directives.Requires(ctx, nil, directive0, permissions)
+		}
+
+		tmp, err := directive1(rctx)
+		if err != nil {
+			return nil, graphql.ErrorOnPath(ctx, err)
+		}
+		if tmp == nil {
+			return nil, nil
+		}
+		if data, ok := tmp.(*string); ok {
+			return data, nil
+		}
+		return nil, fmt.Errorf(`unexpected type %T from directive, should be *string`, tmp)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(*User)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNUser2ᚖserverᚋapiᚋgraphqlᚋgraphᚐUser(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _DesignerApplication_submitTime(ctx context.Context, field graphql.CollectedField, obj *DesignerApplication) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_updateOmnisendContacts(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5061,34 +9051,55 @@ func (ec *executionContext) _DesignerApplication_submitTime(ctx context.Context,
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "DesignerApplication",
+		Object:     "Mutation",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.SubmitTime, nil
+		directive0 := func(rctx context.Context) (interface{}, error) {
+			ctx = rctx // use context from middleware stack in children
+			return ec.resolvers.Mutation().UpdateOmnisendContacts(rctx)
+		}
+		directive1 := func(ctx context.Context) (interface{}, error) {
+			permissions, err := ec.unmarshalNString2ᚕstringᚄ(ctx, []interface{}{"omnisend:sync"})
+			if err != nil {
+				return nil, err
+			}
+			if ec.directives.Requires == nil {
+				return nil, errors.New("directive requires is not implemented")
+			}
+			return ec.directives.Requires(ctx, nil, directive0, permissions)
+		}
+
+		tmp, err := directive1(rctx)
+		if err != nil {
+			return nil, graphql.ErrorOnPath(ctx, err)
+		}
+		if tmp == nil {
+			return nil, nil
+		}
+		if data, ok := tmp.(*string); ok {
+			return data, nil
+		}
+		return nil, fmt.Errorf(`unexpected type %T from directive, should be *string`, tmp)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(time.Time)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _FromTo_address(ctx context.Context, field graphql.CollectedField, obj *FromTo) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_createRole(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5096,66 +9107,65 @@ func (ec *executionContext) _FromTo_address(ctx context.Context, field graphql.C
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "FromTo",
+		Object:     "Mutation",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Address, nil
-	})
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_createRole_args(ctx, rawArgs)
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		directive0 := func(rctx context.Context) (interface{}, error) {
+			ctx = rctx // use context from middleware stack in children
+			return ec.resolvers.Mutation().CreateRole(rctx, args["input"].(CreateRoleInput))
 		}
-		return graphql.Null
-	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
-}
-
-func (ec *executionContext) _FromTo_user(ctx context.Context, field graphql.CollectedField, obj *FromTo) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		directive1 := func(ctx context.Context) (interface{}, error) {
+			permissions, err := ec.unmarshalNString2ᚕstringᚄ(ctx, []interface{}{"roles:manage"})
+			if err != nil {
+				return nil, err
+			}
+			if ec.directives.Requires == nil {
+				return nil, errors.New("directive requires is not implemented")
+			}
+			return ec.directives.Requires(ctx, nil, directive0, permissions)
 		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "FromTo",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
-	}
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.User, nil
+		tmp, err := directive1(rctx)
+		if err != nil {
+			return nil, graphql.ErrorOnPath(ctx, err)
+		}
+		if tmp == nil {
+			return nil, nil
+		}
+		if data, ok := tmp.(*Role); ok {
+			return data, nil
+		}
+		return nil, fmt.Errorf(`unexpected type %T from directive, should be *server/api/graphql/graph.Role`, tmp)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*User)
+	res := resTmp.(*Role)
 	fc.Result = res
-	return ec.marshalOUser2ᚖserverᚋapiᚋgraphqlᚋgraphᚐUser(ctx, field.Selections, res)
+	return ec.marshalNRole2ᚖserverᚋapiᚋgraphqlᚋgraphᚐRole(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Mutation_login(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_deleteRole(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5172,15 +9182,39 @@ func (ec *executionContext) _Mutation_login(ctx context.Context, field graphql.C
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Mutation_login_args(ctx, rawArgs)
+	args, err := ec.field_Mutation_deleteRole_args(ctx, rawArgs)
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().Login(rctx, args["input"].(*LoginInput))
+		directive0 := func(rctx context.Context) (interface{}, error) {
+			ctx = rctx // use context from middleware stack in children
+			return ec.resolvers.Mutation().DeleteRole(rctx, args["input"].(DeleteRoleInput))
+		}
+		directive1 := func(ctx context.Context) (interface{}, error) {
+			permissions, err := ec.unmarshalNString2ᚕstringᚄ(ctx, []interface{}{"roles:manage"})
+			if err != nil {
+				return nil, err
+			}
+			if ec.directives.Requires == nil {
+				return nil, errors.New("directive requires is not implemented")
+			}
+			return ec.directives.Requires(ctx, nil, directive0, permissions)
+		}
+
+		tmp, err := directive1(rctx)
+		if err != nil {
+			return nil, graphql.ErrorOnPath(ctx, err)
+		}
+		if tmp == nil {
+			return nil, nil
+		}
+		if data, ok := tmp.(*string); ok {
+			return data, nil
+		}
+		return nil, fmt.Errorf(`unexpected type %T from directive, should be *string`, tmp)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5189,12 +9223,12 @@ func (ec *executionContext) _Mutation_login(ctx context.Context, field graphql.C
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*Authentication)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalOAuthentication2ᚖserverᚋapiᚋgraphqlᚋgraphᚐAuthentication(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Mutation_loginBlockchainInitialize(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_createPermission(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5210,23 +9244,57 @@ func (ec *executionContext) _Mutation_loginBlockchainInitialize(ctx context.Cont
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_createPermission_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().LoginBlockchainInitialize(rctx)
+		directive0 := func(rctx context.Context) (interface{}, error) {
+			ctx = rctx // use context from middleware stack in children
+			return ec.resolvers.Mutation().CreatePermission(rctx, args["input"].(CreatePermissionInput))
+		}
+		directive1 := func(ctx context.Context) (interface{}, error) {
+			permissions, err := ec.unmarshalNString2ᚕstringᚄ(ctx, []interface{}{"roles:manage"})
+			if err != nil {
+				return nil, err
+			}
+			if ec.directives.Requires == nil {
+				return nil, errors.New("directive requires is not implemented")
+			}
+			return ec.directives.Requires(ctx, nil, directive0, permissions)
+		}
+
+		tmp, err := directive1(rctx)
+		if err != nil {
+			return nil, graphql.ErrorOnPath(ctx, err)
+		}
+		if tmp == nil {
+			return nil, nil
+		}
+		if data, ok := tmp.(*Permission); ok {
+			return data, nil
+		}
+		return nil, fmt.Errorf(`unexpected type %T from directive, should be *server/api/graphql/graph.Permission`, tmp)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(*Permission)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNPermission2ᚖserverᚋapiᚋgraphqlᚋgraphᚐPermission(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Mutation_loginBlockchainEnd(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_deletePermission(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5243,15 +9311,39 @@ func (ec *executionContext) _Mutation_loginBlockchainEnd(ctx context.Context, fi
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Mutation_loginBlockchainEnd_args(ctx, rawArgs)
+	args, err := ec.field_Mutation_deletePermission_args(ctx, rawArgs)
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().LoginBlockchainEnd(rctx, args["input"].(*LoginBlockchainEndInput))
+		directive0 := func(rctx context.Context) (interface{}, error) {
+			ctx = rctx // use context from middleware stack in children
+			return ec.resolvers.Mutation().DeletePermission(rctx, args["input"].(DeletePermissionInput))
+		}
+		directive1 := func(ctx context.Context) (interface{}, error) {
+			permissions, err := ec.unmarshalNString2ᚕstringᚄ(ctx, []interface{}{"roles:manage"})
+			if err != nil {
+				return nil, err
+			}
+			if ec.directives.Requires == nil {
+				return nil, errors.New("directive requires is not implemented")
+			}
+			return ec.directives.Requires(ctx, nil, directive0, permissions)
+		}
+
+		tmp, err := directive1(rctx)
+		if err != nil {
+			return nil, graphql.ErrorOnPath(ctx, err)
+		}
+		if tmp == nil {
+			return nil, nil
+		}
+		if data, ok := tmp.(*string); ok {
+			return data, nil
+		}
+		return nil, fmt.Errorf(`unexpected type %T from directive, should be *string`, tmp)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5260,12 +9352,12 @@ func (ec *executionContext) _Mutation_loginBlockchainEnd(ctx context.Context, fi
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*Authentication)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalOAuthentication2ᚖserverᚋapiᚋgraphqlᚋgraphᚐAuthentication(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Mutation_logout(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_setRolePermission(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5281,16 +9373,27 @@ func (ec *executionContext) _Mutation_logout(ctx context.Context, field graphql.
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_setRolePermission_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		directive0 := func(rctx context.Context) (interface{}, error) {
 			ctx = rctx // use context from middleware stack in children
-			return ec.resolvers.Mutation().Logout(rctx)
+			return ec.resolvers.Mutation().SetRolePermission(rctx, args["input"].(SetRolePermissionInput))
 		}
-		directive1 := func(ctx context.Context) (interface{}, error) {
-			if ec.directives.Authenticate == nil {
-				return nil, errors.New("directive authenticate is not implemented")
+		directive1 := func(ctx context.Context) (interface{}, error) {
+			permissions, err := ec.unmarshalNString2ᚕstringᚄ(ctx, []interface{}{"roles:manage"})
+			if err != nil {
+				return nil, err
+			}
+			if ec.directives.Requires == nil {
+				return nil, errors.New("directive requires is not implemented")
 			}
-			return ec.directives.Authenticate(ctx, nil, directive0, nil, nil)
+			return ec.directives.Requires(ctx, nil, directive0, permissions)
 		}
 
 		tmp, err := directive1(rctx)
@@ -5317,7 +9420,7 @@ func (ec *executionContext) _Mutation_logout(ctx context.Context, field graphql.
 	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Mutation_forgotPasswordInitialize(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_upsertSale(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5334,29 +9437,56 @@ func (ec *executionContext) _Mutation_forgotPasswordInitialize(ctx context.Conte
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Mutation_forgotPasswordInitialize_args(ctx, rawArgs)
+	args, err := ec.field_Mutation_upsertSale_args(ctx, rawArgs)
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().ForgotPasswordInitialize(rctx, args["input"].(*ForgotPasswordInitialize))
+		directive0 := func(rctx context.Context) (interface{}, error) {
+			ctx = rctx // use context from middleware stack in children
+			return ec.resolvers.Mutation().UpsertSale(rctx, args["input"].(*UpsertSaleInput))
+		}
+		directive1 := func(ctx context.Context) (interface{}, error) {
+			rules, err := ec.unmarshalORULE2ᚕserverᚋapiᚋgraphqlᚋgraphᚐRuleᚄ(ctx, []interface{}{"DESIGNER_OF"})
+			if err != nil {
+				return nil, err
+			}
+			if ec.directives.Authenticate == nil {
+				return nil, errors.New("directive authenticate is not implemented")
+			}
+			return ec.directives.Authenticate(ctx, nil, directive0, rules, nil, nil)
+		}
+
+		tmp, err := directive1(rctx)
+		if err != nil {
+			return nil, graphql.ErrorOnPath(ctx, err)
+		}
+		if tmp == nil {
+			return nil, nil
+		}
+		if data, ok := tmp.(string); ok {
+			return data, nil
+		}
+		return nil, fmt.Errorf(`unexpected type %T from directive, should be string`, tmp)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Mutation_forgotPasswordEnd(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_createCard(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5373,7 +9503,7 @@ func (ec *executionContext) _Mutation_forgotPasswordEnd(ctx context.Context, fie
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Mutation_forgotPasswordEnd_args(ctx, rawArgs)
+	args, err := ec.field_Mutation_createCard_args(ctx, rawArgs)
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
@@ -5381,21 +9511,24 @@ func (ec *executionContext) _Mutation_forgotPasswordEnd(ctx context.Context, fie
 	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().ForgotPasswordEnd(rctx, args["input"].(*ForgotPasswordEnd))
+		return ec.resolvers.Mutation().CreateCard(rctx, args["input"].(*CreateCardInput))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Mutation_associateAddressInitialize(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_createPaymentIntent(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5412,7 +9545,7 @@ func (ec *executionContext) _Mutation_associateAddressInitialize(ctx context.Con
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Mutation_associateAddressInitialize_args(ctx, rawArgs)
+	args, err := ec.field_Mutation_createPaymentIntent_args(ctx, rawArgs)
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
@@ -5421,13 +9554,13 @@ func (ec *executionContext) _Mutation_associateAddressInitialize(ctx context.Con
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		directive0 := func(rctx context.Context) (interface{}, error) {
 			ctx = rctx // use context from middleware stack in children
-			return ec.resolvers.Mutation().AssociateAddressInitialize(rctx, args["input"].(*AssociateAddressInitialize))
+			return ec.resolvers.Mutation().CreatePaymentIntent(rctx, args["input"].(*CreatePaymentIntentInput))
 		}
 		directive1 := func(ctx context.Context) (interface{}, error) {
 			if ec.directives.Authenticate == nil {
 				return nil, errors.New("directive authenticate is not implemented")
 			}
-			return ec.directives.Authenticate(ctx, nil, directive0, nil, nil)
+			return ec.directives.Authenticate(ctx, nil, directive0, nil, nil, nil)
 		}
 
 		tmp, err := directive1(rctx)
@@ -5437,24 +9570,27 @@ func (ec *executionContext) _Mutation_associateAddressInitialize(ctx context.Con
 		if tmp == nil {
 			return nil, nil
 		}
-		if data, ok := tmp.(*string); ok {
+		if data, ok := tmp.(*PaymentIntent); ok {
 			return data, nil
 		}
-		return nil, fmt.Errorf(`unexpected type %T from directive, should be *string`, tmp)
+		return nil, fmt.Errorf(`unexpected type %T from directive, should be *server/api/graphql/graph.PaymentIntent`, tmp)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(*PaymentIntent)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNPaymentIntent2ᚖserverᚋapiᚋgraphqlᚋgraphᚐPaymentIntent(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Mutation_associateAddressEnd(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_createStripeCheckoutSession(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5471,7 +9607,7 @@ func (ec *executionContext) _Mutation_associateAddressEnd(ctx context.Context, f
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Mutation_associateAddressEnd_args(ctx, rawArgs)
+	args, err := ec.field_Mutation_createStripeCheckoutSession_args(ctx, rawArgs)
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
@@ -5480,13 +9616,13 @@ func (ec *executionContext) _Mutation_associateAddressEnd(ctx context.Context, f
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		directive0 := func(rctx context.Context) (interface{}, error) {
 			ctx = rctx // use context from middleware stack in children
-			return ec.resolvers.Mutation().AssociateAddressEnd(rctx, args["input"].(*AssociateAddressEnd))
+			return ec.resolvers.Mutation().CreateStripeCheckoutSession(rctx, args["input"].(*CreateStripeCheckoutLinkInput))
 		}
 		directive1 := func(ctx context.Context) (interface{}, error) {
 			if ec.directives.Authenticate == nil {
 				return nil, errors.New("directive authenticate is not implemented")
 			}
-			return ec.directives.Authenticate(ctx, nil, directive0, nil, nil)
+			return ec.directives.Authenticate(ctx, nil, directive0, nil, nil, nil)
 		}
 
 		tmp, err := directive1(rctx)
@@ -5496,24 +9632,27 @@ func (ec *executionContext) _Mutation_associateAddressEnd(ctx context.Context, f
 		if tmp == nil {
 			return nil, nil
 		}
-		if data, ok := tmp.(*string); ok {
+		if data, ok := tmp.(*CreateStripeCheckoutResponse); ok {
 			return data, nil
 		}
-		return nil, fmt.Errorf(`unexpected type %T from directive, should be *string`, tmp)
+		return nil, fmt.Errorf(`unexpected type %T from directive, should be *server/api/graphql/graph.CreateStripeCheckoutResponse`, tmp)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(*CreateStripeCheckoutResponse)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNCreateStripeCheckoutResponse2ᚖserverᚋapiᚋgraphqlᚋgraphᚐCreateStripeCheckoutResponse(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Mutation_createTransferAuthorization(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_fulfillPaymentIntent(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5530,7 +9669,7 @@ func (ec *executionContext) _Mutation_createTransferAuthorization(ctx context.Co
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Mutation_createTransferAuthorization_args(ctx, rawArgs)
+	args, err := ec.field_Mutation_fulfillPaymentIntent_args(ctx, rawArgs)
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
@@ -5539,13 +9678,13 @@ func (ec *executionContext) _Mutation_createTransferAuthorization(ctx context.Co
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		directive0 := func(rctx context.Context) (interface{}, error) {
 			ctx = rctx // use context from middleware stack in children
-			return ec.resolvers.Mutation().CreateTransferAuthorization(rctx, args["input"].(*CreateTransferAuthorizationInput))
+			return ec.resolvers.Mutation().FulfillPaymentIntent(rctx, args["input"].(*FulfillPaymentIntentInput))
 		}
 		directive1 := func(ctx context.Context) (interface{}, error) {
 			if ec.directives.Authenticate == nil {
 				return nil, errors.New("directive authenticate is not implemented")
 			}
-			return ec.directives.Authenticate(ctx, nil, directive0, nil, nil)
+			return ec.directives.Authenticate(ctx, nil, directive0, nil, nil, nil)
 		}
 
 		tmp, err := directive1(rctx)
@@ -5555,24 +9694,27 @@ func (ec *executionContext) _Mutation_createTransferAuthorization(ctx context.Co
 		if tmp == nil {
 			return nil, nil
 		}
-		if data, ok := tmp.(*AuthorizationTransferResponse); ok {
+		if data, ok := tmp.(string); ok {
 			return data, nil
 		}
-		return nil, fmt.Errorf(`unexpected type %T from directive, should be *server/api/graphql/graph.AuthorizationTransferResponse`, tmp)
+		return nil, fmt.Errorf(`unexpected type %T from directive, should be string`, tmp)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*AuthorizationTransferResponse)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOAuthorizationTransferResponse2ᚖserverᚋapiᚋgraphqlᚋgraphᚐAuthorizationTransferResponse(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Mutation_createBatchTransferAuthorization(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_upsertPayoutToken(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5589,7 +9731,7 @@ func (ec *executionContext) _Mutation_createBatchTransferAuthorization(ctx conte
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Mutation_createBatchTransferAuthorization_args(ctx, rawArgs)
+	args, err := ec.field_Mutation_upsertPayoutToken_args(ctx, rawArgs)
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
@@ -5598,13 +9740,13 @@ func (ec *executionContext) _Mutation_createBatchTransferAuthorization(ctx conte
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		directive0 := func(rctx context.Context) (interface{}, error) {
 			ctx = rctx // use context from middleware stack in children
-			return ec.resolvers.Mutation().CreateBatchTransferAuthorization(rctx, args["input"].(*CreateBatchTransferAuthorizationInput))
+			return ec.resolvers.Mutation().UpsertPayoutToken(rctx, args["input"].(UpsertPayoutTokenInput))
 		}
 		directive1 := func(ctx context.Context) (interface{}, error) {
 			if ec.directives.Authenticate == nil {
 				return nil, errors.New("directive authenticate is not implemented")
 			}
-			return ec.directives.Authenticate(ctx, nil, directive0, nil, nil)
+			return ec.directives.Authenticate(ctx, nil, directive0, nil, nil, nil)
 		}
 
 		tmp, err := directive1(rctx)
@@ -5614,24 +9756,27 @@ func (ec *executionContext) _Mutation_createBatchTransferAuthorization(ctx conte
 		if tmp == nil {
 			return nil, nil
 		}
-		if data, ok := tmp.(*AuthorizationBatchResponse); ok {
+		if data, ok := tmp.(string); ok {
 			return data, nil
 		}
-		return nil, fmt.Errorf(`unexpected type %T from directive, should be *server/api/graphql/graph.AuthorizationBatchResponse`, tmp)
+		return nil, fmt.Errorf(`unexpected type %T from directive, should be string`, tmp)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*AuthorizationBatchResponse)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOAuthorizationBatchResponse2ᚖserverᚋapiᚋgraphqlᚋgraphᚐAuthorizationBatchResponse(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Mutation_createBuyAuthorization(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_buy(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5648,49 +9793,32 @@ func (ec *executionContext) _Mutation_createBuyAuthorization(ctx context.Context
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Mutation_createBuyAuthorization_args(ctx, rawArgs)
+	args, err := ec.field_Mutation_buy_args(ctx, rawArgs)
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		directive0 := func(rctx context.Context) (interface{}, error) {
-			ctx = rctx // use context from middleware stack in children
-			return ec.resolvers.Mutation().CreateBuyAuthorization(rctx, args["input"].(*CreateBuyAuthorizationInput))
-		}
-		directive1 := func(ctx context.Context) (interface{}, error) {
-			if ec.directives.Authenticate == nil {
-				return nil, errors.New("directive authenticate is not implemented")
-			}
-			return ec.directives.Authenticate(ctx, nil, directive0, nil, nil)
-		}
-
-		tmp, err := directive1(rctx)
-		if err != nil {
-			return nil, graphql.ErrorOnPath(ctx, err)
-		}
-		if tmp == nil {
-			return nil, nil
-		}
-		if data, ok := tmp.(*AuthorizationBuyResponse); ok {
-			return data, nil
-		}
-		return nil, fmt.Errorf(`unexpected type %T from directive, should be *server/api/graphql/graph.AuthorizationBuyResponse`, tmp)
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().Buy(rctx, args["input"].(*BuyInput))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*AuthorizationBuyResponse)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOAuthorizationBuyResponse2ᚖserverᚋapiᚋgraphqlᚋgraphᚐAuthorizationBuyResponse(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Mutation_createBlogPost(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_subscribe(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5707,39 +9835,15 @@ func (ec *executionContext) _Mutation_createBlogPost(ctx context.Context, field
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Mutation_createBlogPost_args(ctx, rawArgs)
+	args, err := ec.field_Mutation_subscribe_args(ctx, rawArgs)
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		directive0 := func(rctx context.Context) (interface{}, error) {
-			ctx = rctx // use context from middleware stack in children
-			return ec.resolvers.Mutation().CreateBlogPost(rctx, args["input"].(*CreateBlogPostInput))
-		}
-		directive1 := func(ctx context.Context) (interface{}, error) {
-			rules, err := ec.unmarshalORULE2ᚕserverᚋapiᚋgraphqlᚋgraphᚐRuleᚄ(ctx, []interface{}{"ADMIN_ROLE"})
-			if err != nil {
-				return nil, err
-			}
-			if ec.directives.Authenticate == nil {
-				return nil, errors.New("directive authenticate is not implemented")
-			}
-			return ec.directives.Authenticate(ctx, nil, directive0, rules, nil)
-		}
-
-		tmp, err := directive1(rctx)
-		if err != nil {
-			return nil, graphql.ErrorOnPath(ctx, err)
-		}
-		if tmp == nil {
-			return nil, nil
-		}
-		if data, ok := tmp.(*string); ok {
-			return data, nil
-		}
-		return nil, fmt.Errorf(`unexpected type %T from directive, should be *string`, tmp)
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().Subscribe(rctx, args["input"].(*SubscriptionInput))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5753,7 +9857,7 @@ func (ec *executionContext) _Mutation_createBlogPost(ctx context.Context, field
 	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Mutation_deleteBlogPost(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_unsubscribe(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5770,39 +9874,15 @@ func (ec *executionContext) _Mutation_deleteBlogPost(ctx context.Context, field
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Mutation_deleteBlogPost_args(ctx, rawArgs)
+	args, err := ec.field_Mutation_unsubscribe_args(ctx, rawArgs)
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		directive0 := func(rctx context.Context) (interface{}, error) {
-			ctx = rctx // use context from middleware stack in children
-			return ec.resolvers.Mutation().DeleteBlogPost(rctx, args["input"].(*DeleteBlogPostInput))
-		}
-		directive1 := func(ctx context.Context) (interface{}, error) {
-			rules, err := ec.unmarshalORULE2ᚕserverᚋapiᚋgraphqlᚋgraphᚐRuleᚄ(ctx, []interface{}{"ADMIN_ROLE"})
-			if err != nil {
-				return nil, err
-			}
-			if ec.directives.Authenticate == nil {
-				return nil, errors.New("directive authenticate is not implemented")
-			}
-			return ec.directives.Authenticate(ctx, nil, directive0, rules, nil)
-		}
-
-		tmp, err := directive1(rctx)
-		if err != nil {
-			return nil, graphql.ErrorOnPath(ctx, err)
-		}
-		if tmp == nil {
-			return nil, nil
-		}
-		if data, ok := tmp.(*string); ok {
-			return data, nil
-		}
-		return nil, fmt.Errorf(`unexpected type %T from directive, should be *string`, tmp)
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().Unsubscribe(rctx, args["input"].(*UnsubscribeInput))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5816,7 +9896,7 @@ func (ec *executionContext) _Mutation_deleteBlogPost(ctx context.Context, field
 	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Mutation_submitDesignerApplication(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_createUser(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5832,43 +9912,33 @@ func (ec *executionContext) _Mutation_submitDesignerApplication(ctx context.Cont
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_createUser_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		directive0 := func(rctx context.Context) (interface{}, error) {
-			ctx = rctx // use context from middleware stack in children
-			return ec.resolvers.Mutation().SubmitDesignerApplication(rctx)
-		}
-		directive1 := func(ctx context.Context) (interface{}, error) {
-			if ec.directives.Authenticate == nil {
-				return nil, errors.New("directive authenticate is not implemented")
-			}
-			return ec.directives.Authenticate(ctx, nil, directive0, nil, nil)
-		}
-
-		tmp, err := directive1(rctx)
-		if err != nil {
-			return nil, graphql.ErrorOnPath(ctx, err)
-		}
-		if tmp == nil {
-			return nil, nil
-		}
-		if data, ok := tmp.(*string); ok {
-			return data, nil
-		}
-		return nil, fmt.Errorf(`unexpected type %T from directive, should be *string`, tmp)
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().CreateUser(rctx, args["input"].(CreateUserInput))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(*User)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNUser2ᚖserverᚋapiᚋgraphqlᚋgraphᚐUser(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Mutation_resolveDesignerApplication(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_confirmEmail(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5885,39 +9955,15 @@ func (ec *executionContext) _Mutation_resolveDesignerApplication(ctx context.Con
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Mutation_resolveDesignerApplication_args(ctx, rawArgs)
+	args, err := ec.field_Mutation_confirmEmail_args(ctx, rawArgs)
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		directive0 := func(rctx context.Context) (interface{}, error) {
-			ctx = rctx // use context from middleware stack in children
-			return ec.resolvers.Mutation().ResolveDesignerApplication(rctx, args["input"].(*ResolveDesignerApplicationInput))
-		}
-		directive1 := func(ctx context.Context) (interface{}, error) {
-			rules, err := ec.unmarshalORULE2ᚕserverᚋapiᚋgraphqlᚋgraphᚐRuleᚄ(ctx, []interface{}{"ADMIN_ROLE"})
-			if err != nil {
-				return nil, err
-			}
-			if ec.directives.Authenticate == nil {
-				return nil, errors.New("directive authenticate is not implemented")
-			}
-			return ec.directives.Authenticate(ctx, nil, directive0, rules, nil)
-		}
-
-		tmp, err := directive1(rctx)
-		if err != nil {
-			return nil, graphql.ErrorOnPath(ctx, err)
-		}
-		if tmp == nil {
-			return nil, nil
-		}
-		if data, ok := tmp.(*string); ok {
-			return data, nil
-		}
-		return nil, fmt.Errorf(`unexpected type %T from directive, should be *string`, tmp)
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().ConfirmEmail(rctx, args["input"].(ConfirmEmailInput))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5931,7 +9977,7 @@ func (ec *executionContext) _Mutation_resolveDesignerApplication(ctx context.Con
 	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Mutation_toggleCategory(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_resendConfirmationEmail(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5948,56 +9994,29 @@ func (ec *executionContext) _Mutation_toggleCategory(ctx context.Context, field
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Mutation_toggleCategory_args(ctx, rawArgs)
+	args, err := ec.field_Mutation_resendConfirmationEmail_args(ctx, rawArgs)
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		directive0 := func(rctx context.Context) (interface{}, error) {
-			ctx = rctx // use context from middleware stack in children
-			return ec.resolvers.Mutation().ToggleCategory(rctx, args["input"].(ToggleCategory))
-		}
-		directive1 := func(ctx context.Context) (interface{}, error) {
-			rules, err := ec.unmarshalORULE2ᚕserverᚋapiᚋgraphqlᚋgraphᚐRuleᚄ(ctx, []interface{}{"DESIGNER_OF"})
-			if err != nil {
-				return nil, err
-			}
-			if ec.directives.Authenticate == nil {
-				return nil, errors.New("directive authenticate is not implemented")
-			}
-			return ec.directives.Authenticate(ctx, nil, directive0, rules, nil)
-		}
-
-		tmp, err := directive1(rctx)
-		if err != nil {
-			return nil, graphql.ErrorOnPath(ctx, err)
-		}
-		if tmp == nil {
-			return nil, nil
-		}
-		if data, ok := tmp.(*Category); ok {
-			return data, nil
-		}
-		return nil, fmt.Errorf(`unexpected type %T from directive, should be *server/api/graphql/graph.Category`, tmp)
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().ResendConfirmationEmail(rctx, args["input"].(*ResendConfirmationEmailInput))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(*Category)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNCategory2ᚖserverᚋapiᚋgraphqlᚋgraphᚐCategory(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Mutation_createIPFSHash(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_requestEmailChange(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -6014,7 +10033,7 @@ func (ec *executionContext) _Mutation_createIPFSHash(ctx context.Context, field
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Mutation_createIPFSHash_args(ctx, rawArgs)
+	args, err := ec.field_Mutation_requestEmailChange_args(ctx, rawArgs)
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
@@ -6023,13 +10042,13 @@ func (ec *executionContext) _Mutation_createIPFSHash(ctx context.Context, field
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		directive0 := func(rctx context.Context) (interface{}, error) {
 			ctx = rctx // use context from middleware stack in children
-			return ec.resolvers.Mutation().CreateIPFSHash(rctx, args["input"].(CreateIPFSHashInput))
+			return ec.resolvers.Mutation().RequestEmailChange(rctx, args["input"].(RequestEmailChangeInput))
 		}
 		directive1 := func(ctx context.Context) (interface{}, error) {
 			if ec.directives.Authenticate == nil {
 				return nil, errors.New("directive authenticate is not implemented")
 			}
-			return ec.directives.Authenticate(ctx, nil, directive0, nil, nil)
+			return ec.directives.Authenticate(ctx, nil, directive0, nil, nil, nil)
 		}
 
 		tmp, err := directive1(rctx)
@@ -6056,7 +10075,7 @@ func (ec *executionContext) _Mutation_createIPFSHash(ctx context.Context, field
 	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Mutation_setFilter(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_confirmEmailChange(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -6073,7 +10092,7 @@ func (ec *executionContext) _Mutation_setFilter(ctx context.Context, field graph
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Mutation_setFilter_args(ctx, rawArgs)
+	args, err := ec.field_Mutation_confirmEmailChange_args(ctx, rawArgs)
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
@@ -6082,17 +10101,17 @@ func (ec *executionContext) _Mutation_setFilter(ctx context.Context, field graph
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		directive0 := func(rctx context.Context) (interface{}, error) {
 			ctx = rctx // use context from middleware stack in children
-			return ec.resolvers.Mutation().SetFilter(rctx, args["input"].(SaveFilter))
+			return ec.resolvers.Mutation().ConfirmEmailChange(rctx, args["input"].(ConfirmEmailChangeInput))
 		}
 		directive1 := func(ctx context.Context) (interface{}, error) {
-			rules, err := ec.unmarshalORULE2ᚕserverᚋapiᚋgraphqlᚋgraphᚐRuleᚄ(ctx, []interface{}{"DESIGNER_OF"})
+			enforce, err := ec.unmarshalOBoolean2ᚖbool(ctx, false)
 			if err != nil {
 				return nil, err
 			}
 			if ec.directives.Authenticate == nil {
 				return nil, errors.New("directive authenticate is not implemented")
 			}
-			return ec.directives.Authenticate(ctx, nil, directive0, rules, nil)
+			return ec.directives.Authenticate(ctx, nil, directive0, nil, enforce, nil)
 		}
 
 		tmp, err := directive1(rctx)
@@ -6119,7 +10138,7 @@ func (ec *executionContext) _Mutation_setFilter(ctx context.Context, field graph
 	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Mutation_saveCreationIntent(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_deleteMyAccount(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -6136,7 +10155,7 @@ func (ec *executionContext) _Mutation_saveCreationIntent(ctx context.Context, fi
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Mutation_saveCreationIntent_args(ctx, rawArgs)
+	args, err := ec.field_Mutation_deleteMyAccount_args(ctx, rawArgs)
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
@@ -6145,17 +10164,13 @@ func (ec *executionContext) _Mutation_saveCreationIntent(ctx context.Context, fi
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		directive0 := func(rctx context.Context) (interface{}, error) {
 			ctx = rctx // use context from middleware stack in children
-			return ec.resolvers.Mutation().SaveCreationIntent(rctx, args["input"].(SaveCreationIntentInput))
+			return ec.resolvers.Mutation().DeleteMyAccount(rctx, args["input"].(DeleteMyAccountInput))
 		}
 		directive1 := func(ctx context.Context) (interface{}, error) {
-			rules, err := ec.unmarshalORULE2ᚕserverᚋapiᚋgraphqlᚋgraphᚐRuleᚄ(ctx, []interface{}{"DESIGNER_ROLE"})
-			if err != nil {
-				return nil, err
-			}
 			if ec.directives.Authenticate == nil {
 				return nil, errors.New("directive authenticate is not implemented")
 			}
-			return ec.directives.Authenticate(ctx, nil, directive0, rules, nil)
+			return ec.directives.Authenticate(ctx, nil, directive0, nil, nil, nil)
 		}
 
 		tmp, err := directive1(rctx)
@@ -6182,7 +10197,7 @@ func (ec *executionContext) _Mutation_saveCreationIntent(ctx context.Context, fi
 	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Mutation_updateOmnisendProducts(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_cancelAccountDeletion(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -6201,17 +10216,13 @@ func (ec *executionContext) _Mutation_updateOmnisendProducts(ctx context.Context
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		directive0 := func(rctx context.Context) (interface{}, error) {
 			ctx = rctx // use context from middleware stack in children
-			return ec.resolvers.Mutation().UpdateOmnisendProducts(rctx)
+			return ec.resolvers.Mutation().CancelAccountDeletion(rctx)
 		}
 		directive1 := func(ctx context.Context) (interface{}, error) {
-			rules, err := ec.unmarshalORULE2ᚕserverᚋapiᚋgraphqlᚋgraphᚐRuleᚄ(ctx, []interface{}{"ADMIN_ROLE"})
-			if err != nil {
-				return nil, err
-			}
 			if ec.directives.Authenticate == nil {
 				return nil, errors.New("directive authenticate is not implemented")
 			}
-			return ec.directives.Authenticate(ctx, nil, directive0, rules, nil)
+			return ec.directives.Authenticate(ctx, nil, directive0, nil, nil, nil)
 		}
 
 		tmp, err := directive1(rctx)
@@ -6238,7 +10249,7 @@ func (ec *executionContext) _Mutation_updateOmnisendProducts(ctx context.Context
 	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Mutation_updateOmnisendContacts(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_exportMyData(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -6254,20 +10265,23 @@ func (ec *executionContext) _Mutation_updateOmnisendContacts(ctx context.Context
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_exportMyData_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		directive0 := func(rctx context.Context) (interface{}, error) {
 			ctx = rctx // use context from middleware stack in children
-			return ec.resolvers.Mutation().UpdateOmnisendContacts(rctx)
+			return ec.resolvers.Mutation().ExportMyData(rctx, args["input"].(ExportMyDataInput))
 		}
 		directive1 := func(ctx context.Context) (interface{}, error) {
-			rules, err := ec.unmarshalORULE2ᚕserverᚋapiᚋgraphqlᚋgraphᚐRuleᚄ(ctx, []interface{}{"ADMIN_ROLE"})
-			if err != nil {
-				return nil, err
-			}
 			if ec.directives.Authenticate == nil {
 				return nil, errors.New("directive authenticate is not implemented")
 			}
-			return ec.directives.Authenticate(ctx, nil, directive0, rules, nil)
+			return ec.directives.Authenticate(ctx, nil, directive0, nil, nil, nil)
 		}
 
 		tmp, err := directive1(rctx)
@@ -6294,7 +10308,7 @@ func (ec *executionContext) _Mutation_updateOmnisendContacts(ctx context.Context
 	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Mutation_upsertSale(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_setRole(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -6311,7 +10325,7 @@ func (ec *executionContext) _Mutation_upsertSale(ctx context.Context, field grap
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Mutation_upsertSale_args(ctx, rawArgs)
+	args, err := ec.field_Mutation_setRole_args(ctx, rawArgs)
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
@@ -6320,17 +10334,17 @@ func (ec *executionContext) _Mutation_upsertSale(ctx context.Context, field grap
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		directive0 := func(rctx context.Context) (interface{}, error) {
 			ctx = rctx // use context from middleware stack in children
-			return ec.resolvers.Mutation().UpsertSale(rctx, args["input"].(*UpsertSaleInput))
+			return ec.resolvers.Mutation().SetRole(rctx, args["input"].(*SetRoleInput))
 		}
 		directive1 := func(ctx context.Context) (interface{}, error) {
-			rules, err := ec.unmarshalORULE2ᚕserverᚋapiᚋgraphqlᚋgraphᚐRuleᚄ(ctx, []interface{}{"DESIGNER_OF"})
+			permissions, err := ec.unmarshalNString2ᚕstringᚄ(ctx, []interface{}{"users:assign_roles"})
 			if err != nil {
 				return nil, err
 			}
-			if ec.directives.Authenticate == nil {
-				return nil, errors.New("directive authenticate is not implemented")
+			if ec.directives.Requires == nil {
+				return nil, errors.New("directive requires is not implemented")
 			}
-			return ec.directives.Authenticate(ctx, nil, directive0, rules, nil)
+			return ec.directives.Requires(ctx, nil, directive0, permissions)
 		}
 
 		tmp, err := directive1(rctx)
@@ -6340,69 +10354,24 @@ func (ec *executionContext) _Mutation_upsertSale(ctx context.Context, field grap
 		if tmp == nil {
 			return nil, nil
 		}
-		if data, ok := tmp.(string); ok {
+		if data, ok := tmp.(*string); ok {
 			return data, nil
 		}
-		return nil, fmt.Errorf(`unexpected type %T from directive, should be string`, tmp)
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
-		return graphql.Null
-	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
-}
-
-func (ec *executionContext) _Mutation_createCard(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "Mutation",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   true,
-		IsResolver: true,
-	}
-
-	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Mutation_createCard_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	fc.Args = args
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().CreateCard(rctx, args["input"].(*CreateCardInput))
+		return nil, fmt.Errorf(`unexpected type %T from directive, should be *string`, tmp)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Mutation_createPaymentIntent(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_updateProfile(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -6419,7 +10388,7 @@ func (ec *executionContext) _Mutation_createPaymentIntent(ctx context.Context, f
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Mutation_createPaymentIntent_args(ctx, rawArgs)
+	args, err := ec.field_Mutation_updateProfile_args(ctx, rawArgs)
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
@@ -6428,13 +10397,13 @@ func (ec *executionContext) _Mutation_createPaymentIntent(ctx context.Context, f
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		directive0 := func(rctx context.Context) (interface{}, error) {
 			ctx = rctx // use context from middleware stack in children
-			return ec.resolvers.Mutation().CreatePaymentIntent(rctx, args["input"].(*CreatePaymentIntentInput))
+			return ec.resolvers.Mutation().UpdateProfile(rctx, args["input"].(*ProfileInput))
 		}
 		directive1 := func(ctx context.Context) (interface{}, error) {
 			if ec.directives.Authenticate == nil {
 				return nil, errors.New("directive authenticate is not implemented")
 			}
-			return ec.directives.Authenticate(ctx, nil, directive0, nil, nil)
+			return ec.directives.Authenticate(ctx, nil, directive0, nil, nil, nil)
 		}
 
 		tmp, err := directive1(rctx)
@@ -6444,27 +10413,24 @@ func (ec *executionContext) _Mutation_createPaymentIntent(ctx context.Context, f
 		if tmp == nil {
 			return nil, nil
 		}
-		if data, ok := tmp.(*PaymentIntent); ok {
+		if data, ok := tmp.(*string); ok {
 			return data, nil
 		}
-		return nil, fmt.Errorf(`unexpected type %T from directive, should be *server/api/graphql/graph.PaymentIntent`, tmp)
+		return nil, fmt.Errorf(`unexpected type %T from directive, should be *string`, tmp)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(*PaymentIntent)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNPaymentIntent2ᚖserverᚋapiᚋgraphqlᚋgraphᚐPaymentIntent(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Mutation_createStripeCheckoutSession(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_setLocale(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -6481,7 +10447,7 @@ func (ec *executionContext) _Mutation_createStripeCheckoutSession(ctx context.Co
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Mutation_createStripeCheckoutSession_args(ctx, rawArgs)
+	args, err := ec.field_Mutation_setLocale_args(ctx, rawArgs)
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
@@ -6490,13 +10456,13 @@ func (ec *executionContext) _Mutation_createStripeCheckoutSession(ctx context.Co
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		directive0 := func(rctx context.Context) (interface{}, error) {
 			ctx = rctx // use context from middleware stack in children
-			return ec.resolvers.Mutation().CreateStripeCheckoutSession(rctx, args["input"].(*CreateStripeCheckoutLinkInput))
+			return ec.resolvers.Mutation().SetLocale(rctx, args["input"].(SetLocaleInput))
 		}
 		directive1 := func(ctx context.Context) (interface{}, error) {
 			if ec.directives.Authenticate == nil {
 				return nil, errors.New("directive authenticate is not implemented")
 			}
-			return ec.directives.Authenticate(ctx, nil, directive0, nil, nil)
+			return ec.directives.Authenticate(ctx, nil, directive0, nil, nil, nil)
 		}
 
 		tmp, err := directive1(rctx)
@@ -6506,27 +10472,24 @@ func (ec *executionContext) _Mutation_createStripeCheckoutSession(ctx context.Co
 		if tmp == nil {
 			return nil, nil
 		}
-		if data, ok := tmp.(*CreateStripeCheckoutResponse); ok {
+		if data, ok := tmp.(*string); ok {
 			return data, nil
 		}
-		return nil, fmt.Errorf(`unexpected type %T from directive, should be *server/api/graphql/graph.CreateStripeCheckoutResponse`, tmp)
+		return nil, fmt.Errorf(`unexpected type %T from directive, should be *string`, tmp)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(*CreateStripeCheckoutResponse)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNCreateStripeCheckoutResponse2ᚖserverᚋapiᚋgraphqlᚋgraphᚐCreateStripeCheckoutResponse(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Mutation_fulfillPaymentIntent(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_assignOffChainNfts(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -6543,7 +10506,7 @@ func (ec *executionContext) _Mutation_fulfillPaymentIntent(ctx context.Context,
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Mutation_fulfillPaymentIntent_args(ctx, rawArgs)
+	args, err := ec.field_Mutation_assignOffChainNfts_args(ctx, rawArgs)
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
@@ -6552,13 +10515,17 @@ func (ec *executionContext) _Mutation_fulfillPaymentIntent(ctx context.Context,
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		directive0 := func(rctx context.Context) (interface{}, error) {
 			ctx = rctx // use context from middleware stack in children
-			return ec.resolvers.Mutation().FulfillPaymentIntent(rctx, args["input"].(*FulfillPaymentIntentInput))
+			return ec.resolvers.Mutation().AssignOffChainNfts(rctx, args["input"].(AssignOffChainNftsInput))
 		}
 		directive1 := func(ctx context.Context) (interface{}, error) {
-			if ec.directives.Authenticate == nil {
-				return nil, errors.New("directive authenticate is not implemented")
+			permissions, err := ec.unmarshalNString2ᚕstringᚄ(ctx, []interface{}{"nfts:assign_offchain"})
+			if err != nil {
+				return nil, err
 			}
-			return ec.directives.Authenticate(ctx, nil, directive0, nil, nil)
+			if ec.directives.Requires == nil {
+				return nil, errors.New("directive requires is not implemented")
+			}
+			return ec.directives.Requires(ctx, nil, directive0, permissions)
 		}
 
 		tmp, err := directive1(rctx)
@@ -6568,27 +10535,24 @@ func (ec *executionContext) _Mutation_fulfillPaymentIntent(ctx context.Context,
 		if tmp == nil {
 			return nil, nil
 		}
-		if data, ok := tmp.(string); ok {
+		if data, ok := tmp.(*string); ok {
 			return data, nil
 		}
-		return nil, fmt.Errorf(`unexpected type %T from directive, should be string`, tmp)
+		return nil, fmt.Errorf(`unexpected type %T from directive, should be *string`, tmp)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Mutation_upsertPayoutToken(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Nft_id(ctx context.Context, field graphql.CollectedField, obj *Nft) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -6596,44 +10560,52 @@ func (ec *executionContext) _Mutation_upsertPayoutToken(ctx context.Context, fie
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "Nft",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Mutation_upsertPayoutToken_args(ctx, rawArgs)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID, nil
+	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
-	fc.Args = args
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		directive0 := func(rctx context.Context) (interface{}, error) {
-			ctx = rctx // use context from middleware stack in children
-			return ec.resolvers.Mutation().UpsertPayoutToken(rctx, args["input"].(UpsertPayoutTokenInput))
-		}
-		directive1 := func(ctx context.Context) (interface{}, error) {
-			if ec.directives.Authenticate == nil {
-				return nil, errors.New("directive authenticate is not implemented")
-			}
-			return ec.directives.Authenticate(ctx, nil, directive0, nil, nil)
-		}
-
-		tmp, err := directive1(rctx)
-		if err != nil {
-			return nil, graphql.ErrorOnPath(ctx, err)
-		}
-		if tmp == nil {
-			return nil, nil
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
 		}
-		if data, ok := tmp.(string); ok {
-			return data, nil
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Nft_totalSupply(ctx context.Context, field graphql.CollectedField, obj *Nft) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
 		}
-		return nil, fmt.Errorf(`unexpected type %T from directive, should be string`, tmp)
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Nft",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.TotalSupply, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6645,12 +10617,12 @@ func (ec *executionContext) _Mutation_upsertPayoutToken(ctx context.Context, fie
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Mutation_buy(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Nft_creator(ctx context.Context, field graphql.CollectedField, obj *Nft) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -6658,24 +10630,17 @@ func (ec *executionContext) _Mutation_buy(ctx context.Context, field graphql.Col
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "Nft",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Mutation_buy_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().Buy(rctx, args["input"].(*BuyInput))
+		return obj.Creator, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6692,7 +10657,7 @@ func (ec *executionContext) _Mutation_buy(ctx context.Context, field graphql.Col
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Mutation_subscribe(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Nft_creatorUser(ctx context.Context, field graphql.CollectedField, obj *Nft) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -6700,7 +10665,7 @@ func (ec *executionContext) _Mutation_subscribe(ctx context.Context, field graph
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "Nft",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   true,
@@ -6708,16 +10673,9 @@ func (ec *executionContext) _Mutation_subscribe(ctx context.Context, field graph
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Mutation_subscribe_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().Subscribe(rctx, args["input"].(*SubscriptionInput))
+		return ec.resolvers.Nft().CreatorUser(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6726,12 +10684,12 @@ func (ec *executionContext) _Mutation_subscribe(ctx context.Context, field graph
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(*User)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalOUser2ᚖserverᚋapiᚋgraphqlᚋgraphᚐUser(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Mutation_unsubscribe(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Nft_blockCreation(ctx context.Context, field graphql.CollectedField, obj *Nft) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -6739,38 +10697,34 @@ func (ec *executionContext) _Mutation_unsubscribe(ctx context.Context, field gra
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "Nft",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Mutation_unsubscribe_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().Unsubscribe(rctx, args["input"].(*UnsubscribeInput))
+		return obj.BlockCreation, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Mutation_createUser(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Nft_transfers(ctx context.Context, field graphql.CollectedField, obj *Nft) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -6778,7 +10732,7 @@ func (ec *executionContext) _Mutation_createUser(ctx context.Context, field grap
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "Nft",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   true,
@@ -6786,33 +10740,23 @@ func (ec *executionContext) _Mutation_createUser(ctx context.Context, field grap
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Mutation_createUser_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().CreateUser(rctx, args["input"].(CreateUserInput))
+		return ec.resolvers.Nft().Transfers(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(*User)
+	res := resTmp.([]*Transfer)
 	fc.Result = res
-	return ec.marshalNUser2ᚖserverᚋapiᚋgraphqlᚋgraphᚐUser(ctx, field.Selections, res)
+	return ec.marshalOTransfer2ᚕᚖserverᚋapiᚋgraphqlᚋgraphᚐTransferᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Mutation_confirmEmail(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Nft_owners(ctx context.Context, field graphql.CollectedField, obj *Nft) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -6820,7 +10764,7 @@ func (ec *executionContext) _Mutation_confirmEmail(ctx context.Context, field gr
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "Nft",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   true,
@@ -6828,16 +10772,9 @@ func (ec *executionContext) _Mutation_confirmEmail(ctx context.Context, field gr
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Mutation_confirmEmail_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().ConfirmEmail(rctx, args["input"].(ConfirmEmailInput))
+		return ec.resolvers.Nft().Owners(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6846,12 +10783,12 @@ func (ec *executionContext) _Mutation_confirmEmail(ctx context.Context, field gr
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.([]*Owner)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalOOwner2ᚕᚖserverᚋapiᚋgraphqlᚋgraphᚐOwnerᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Mutation_resendConfirmationEmail(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Nft_categories(ctx context.Context, field graphql.CollectedField, obj *Nft) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -6859,7 +10796,7 @@ func (ec *executionContext) _Mutation_resendConfirmationEmail(ctx context.Contex
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "Nft",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   true,
@@ -6867,30 +10804,26 @@ func (ec *executionContext) _Mutation_resendConfirmationEmail(ctx context.Contex
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Mutation_resendConfirmationEmail_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().ResendConfirmationEmail(rctx, args["input"].(*ResendConfirmationEmailInput))
+		return ec.resolvers.Nft().Categories(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.([]*Category)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNCategory2ᚕᚖserverᚋapiᚋgraphqlᚋgraphᚐCategory(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Mutation_setRole(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Nft_sale(ctx context.Context, field graphql.CollectedField, obj *Nft) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -6898,7 +10831,7 @@ func (ec *executionContext) _Mutation_setRole(ctx context.Context, field graphql
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "Nft",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   true,
@@ -6906,40 +10839,9 @@ func (ec *executionContext) _Mutation_setRole(ctx context.Context, field graphql
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Mutation_setRole_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		directive0 := func(rctx context.Context) (interface{}, error) {
-			ctx = rctx // use context from middleware stack in children
-			return ec.resolvers.Mutation().SetRole(rctx, args["input"].(*SetRoleInput))
-		}
-		directive1 := func(ctx context.Context) (interface{}, error) {
-			rules, err := ec.unmarshalORULE2ᚕserverᚋapiᚋgraphqlᚋgraphᚐRuleᚄ(ctx, []interface{}{"ADMIN_ROLE"})
-			if err != nil {
-				return nil, err
-			}
-			if ec.directives.Authenticate == nil {
-				return nil, errors.New("directive authenticate is not implemented")
-			}
-			return ec.directives.Authenticate(ctx, nil, directive0, rules, nil)
-		}
-
-		tmp, err := directive1(rctx)
-		if err != nil {
-			return nil, graphql.ErrorOnPath(ctx, err)
-		}
-		if tmp == nil {
-			return nil, nil
-		}
-		if data, ok := tmp.(*string); ok {
-			return data, nil
-		}
-		return nil, fmt.Errorf(`unexpected type %T from directive, should be *string`, tmp)
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Nft().Sale(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6948,12 +10850,12 @@ func (ec *executionContext) _Mutation_setRole(ctx context.Context, field graphql
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(*Sale)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalOSale2ᚖserverᚋapiᚋgraphqlᚋgraphᚐSale(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Mutation_updateProfile(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Nft_filterUrl(ctx context.Context, field graphql.CollectedField, obj *Nft) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -6961,7 +10863,7 @@ func (ec *executionContext) _Mutation_updateProfile(ctx context.Context, field g
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "Nft",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   true,
@@ -6969,36 +10871,9 @@ func (ec *executionContext) _Mutation_updateProfile(ctx context.Context, field g
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Mutation_updateProfile_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		directive0 := func(rctx context.Context) (interface{}, error) {
-			ctx = rctx // use context from middleware stack in children
-			return ec.resolvers.Mutation().UpdateProfile(rctx, args["input"].(*ProfileInput))
-		}
-		directive1 := func(ctx context.Context) (interface{}, error) {
-			if ec.directives.Authenticate == nil {
-				return nil, errors.New("directive authenticate is not implemented")
-			}
-			return ec.directives.Authenticate(ctx, nil, directive0, nil, nil)
-		}
-
-		tmp, err := directive1(rctx)
-		if err != nil {
-			return nil, graphql.ErrorOnPath(ctx, err)
-		}
-		if tmp == nil {
-			return nil, nil
-		}
-		if data, ok := tmp.(*string); ok {
-			return data, nil
-		}
-		return nil, fmt.Errorf(`unexpected type %T from directive, should be *string`, tmp)
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Nft().FilterURL(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7012,7 +10887,7 @@ func (ec *executionContext) _Mutation_updateProfile(ctx context.Context, field g
 	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Mutation_assignOffChainNfts(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Nft_owned(ctx context.Context, field graphql.CollectedField, obj *Nft) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -7020,7 +10895,7 @@ func (ec *executionContext) _Mutation_assignOffChainNfts(ctx context.Context, fi
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "Nft",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   true,
@@ -7028,40 +10903,9 @@ func (ec *executionContext) _Mutation_assignOffChainNfts(ctx context.Context, fi
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Mutation_assignOffChainNfts_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		directive0 := func(rctx context.Context) (interface{}, error) {
-			ctx = rctx // use context from middleware stack in children
-			return ec.resolvers.Mutation().AssignOffChainNfts(rctx, args["input"].(AssignOffChainNftsInput))
-		}
-		directive1 := func(ctx context.Context) (interface{}, error) {
-			rules, err := ec.unmarshalORULE2ᚕserverᚋapiᚋgraphqlᚋgraphᚐRuleᚄ(ctx, []interface{}{"ADMIN_ROLE"})
-			if err != nil {
-				return nil, err
-			}
-			if ec.directives.Authenticate == nil {
-				return nil, errors.New("directive authenticate is not implemented")
-			}
-			return ec.directives.Authenticate(ctx, nil, directive0, rules, nil)
-		}
-
-		tmp, err := directive1(rctx)
-		if err != nil {
-			return nil, graphql.ErrorOnPath(ctx, err)
-		}
-		if tmp == nil {
-			return nil, nil
-		}
-		if data, ok := tmp.(*string); ok {
-			return data, nil
-		}
-		return nil, fmt.Errorf(`unexpected type %T from directive, should be *string`, tmp)
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Nft().Owned(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7070,12 +10914,12 @@ func (ec *executionContext) _Mutation_assignOffChainNfts(ctx context.Context, fi
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(*UserHasNfts)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalOUserHasNfts2ᚖserverᚋapiᚋgraphqlᚋgraphᚐUserHasNfts(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Nft_id(ctx context.Context, field graphql.CollectedField, obj *Nft) (ret graphql.Marshaler) {
+func (ec *executionContext) _Nft_name(ctx context.Context, field graphql.CollectedField, obj *Nft) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -7093,7 +10937,7 @@ func (ec *executionContext) _Nft_id(ctx context.Context, field graphql.Collected
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
+		return obj.Name, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7105,12 +10949,12 @@ func (ec *executionContext) _Nft_id(ctx context.Context, field graphql.Collected
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Nft_totalSupply(ctx context.Context, field graphql.CollectedField, obj *Nft) (ret graphql.Marshaler) {
+func (ec *executionContext) _Nft_description(ctx context.Context, field graphql.CollectedField, obj *Nft) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -7128,7 +10972,7 @@ func (ec *executionContext) _Nft_totalSupply(ctx context.Context, field graphql.
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TotalSupply, nil
+		return obj.Description, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7140,12 +10984,12 @@ func (ec *executionContext) _Nft_totalSupply(ctx context.Context, field graphql.
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Nft_creator(ctx context.Context, field graphql.CollectedField, obj *Nft) (ret graphql.Marshaler) {
+func (ec *executionContext) _Nft_externalUrl(ctx context.Context, field graphql.CollectedField, obj *Nft) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -7163,7 +11007,7 @@ func (ec *executionContext) _Nft_creator(ctx context.Context, field graphql.Coll
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Creator, nil
+		return obj.ExternalURL, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7180,7 +11024,7 @@ func (ec *executionContext) _Nft_creator(ctx context.Context, field graphql.Coll
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Nft_creatorUser(ctx context.Context, field graphql.CollectedField, obj *Nft) (ret graphql.Marshaler) {
+func (ec *executionContext) _Nft_image(ctx context.Context, field graphql.CollectedField, obj *Nft) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -7191,28 +11035,31 @@ func (ec *executionContext) _Nft_creatorUser(ctx context.Context, field graphql.
 		Object:     "Nft",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Nft().CreatorUser(rctx, obj)
+		return obj.Image, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*User)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOUser2ᚖserverᚋapiᚋgraphqlᚋgraphᚐUser(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Nft_blockCreation(ctx context.Context, field graphql.CollectedField, obj *Nft) (ret graphql.Marshaler) {
+func (ec *executionContext) _Nft_animationUrl(ctx context.Context, field graphql.CollectedField, obj *Nft) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -7230,7 +11077,7 @@ func (ec *executionContext) _Nft_blockCreation(ctx context.Context, field graphq
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.BlockCreation, nil
+		return obj.AnimationURL, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7242,12 +11089,12 @@ func (ec *executionContext) _Nft_blockCreation(ctx context.Context, field graphq
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Nft_transfers(ctx context.Context, field graphql.CollectedField, obj *Nft) (ret graphql.Marshaler) {
+func (ec *executionContext) _Nft_attributes(ctx context.Context, field graphql.CollectedField, obj *Nft) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -7265,7 +11112,7 @@ func (ec *executionContext) _Nft_transfers(ctx context.Context, field graphql.Co
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Nft().Transfers(rctx, obj)
+		return ec.resolvers.Nft().Attributes(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7274,12 +11121,12 @@ func (ec *executionContext) _Nft_transfers(ctx context.Context, field graphql.Co
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.([]*Transfer)
+	res := resTmp.([]*Attribute)
 	fc.Result = res
-	return ec.marshalOTransfer2ᚕᚖserverᚋapiᚋgraphqlᚋgraphᚐTransferᚄ(ctx, field.Selections, res)
+	return ec.marshalOAttribute2ᚕᚖserverᚋapiᚋgraphqlᚋgraphᚐAttributeᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Nft_owners(ctx context.Context, field graphql.CollectedField, obj *Nft) (ret graphql.Marshaler) {
+func (ec *executionContext) _OffchainNft_id(ctx context.Context, field graphql.CollectedField, obj *OffchainNft) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -7287,31 +11134,34 @@ func (ec *executionContext) _Nft_owners(ctx context.Context, field graphql.Colle
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Nft",
+		Object:     "OffchainNft",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Nft().Owners(rctx, obj)
+		return obj.ID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]*Owner)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalOOwner2ᚕᚖserverᚋapiᚋgraphqlᚋgraphᚐOwnerᚄ(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Nft_categories(ctx context.Context, field graphql.CollectedField, obj *Nft) (ret graphql.Marshaler) {
+func (ec *executionContext) _OffchainNft_amount(ctx context.Context, field graphql.CollectedField, obj *OffchainNft) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -7319,17 +11169,17 @@ func (ec *executionContext) _Nft_categories(ctx context.Context, field graphql.C
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Nft",
+		Object:     "OffchainNft",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Nft().Categories(rctx, obj)
+		return obj.Amount, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7341,12 +11191,12 @@ func (ec *executionContext) _Nft_categories(ctx context.Context, field graphql.C
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*Category)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNCategory2ᚕᚖserverᚋapiᚋgraphqlᚋgraphᚐCategory(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Nft_sale(ctx context.Context, field graphql.CollectedField, obj *Nft) (ret graphql.Marshaler) {
+func (ec *executionContext) _Owner_user(ctx context.Context, field graphql.CollectedField, obj *Owner) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -7354,17 +11204,17 @@ func (ec *executionContext) _Nft_sale(ctx context.Context, field graphql.Collect
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Nft",
+		Object:     "Owner",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Nft().Sale(rctx, obj)
+		return obj.User, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7373,12 +11223,12 @@ func (ec *executionContext) _Nft_sale(ctx context.Context, field graphql.Collect
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*Sale)
+	res := resTmp.(*User)
 	fc.Result = res
-	return ec.marshalOSale2ᚖserverᚋapiᚋgraphqlᚋgraphᚐSale(ctx, field.Selections, res)
+	return ec.marshalOUser2ᚖserverᚋapiᚋgraphqlᚋgraphᚐUser(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Nft_filterUrl(ctx context.Context, field graphql.CollectedField, obj *Nft) (ret graphql.Marshaler) {
+func (ec *executionContext) _Owner_addresses(ctx context.Context, field graphql.CollectedField, obj *Owner) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -7386,17 +11236,17 @@ func (ec *executionContext) _Nft_filterUrl(ctx context.Context, field graphql.Co
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Nft",
+		Object:     "Owner",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Nft().FilterURL(rctx, obj)
+		return obj.Addresses, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7405,12 +11255,12 @@ func (ec *executionContext) _Nft_filterUrl(ctx context.Context, field graphql.Co
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.([]string)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalOString2ᚕstringᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Nft_owned(ctx context.Context, field graphql.CollectedField, obj *Nft) (ret graphql.Marshaler) {
+func (ec *executionContext) _Owner_amount(ctx context.Context, field graphql.CollectedField, obj *Owner) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -7418,31 +11268,34 @@ func (ec *executionContext) _Nft_owned(ctx context.Context, field graphql.Collec
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Nft",
+		Object:     "Owner",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Nft().Owned(rctx, obj)
+		return obj.Amount, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*UserHasNfts)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalOUserHasNfts2ᚖserverᚋapiᚋgraphqlᚋgraphᚐUserHasNfts(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Nft_name(ctx context.Context, field graphql.CollectedField, obj *Nft) (ret graphql.Marshaler) {
+func (ec *executionContext) _PageInfo_hasNextPage(ctx context.Context, field graphql.CollectedField, obj *PageInfo) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -7450,7 +11303,7 @@ func (ec *executionContext) _Nft_name(ctx context.Context, field graphql.Collect
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Nft",
+		Object:     "PageInfo",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -7460,7 +11313,7 @@ func (ec *executionContext) _Nft_name(ctx context.Context, field graphql.Collect
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.HasNextPage, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7472,12 +11325,12 @@ func (ec *executionContext) _Nft_name(ctx context.Context, field graphql.Collect
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Nft_description(ctx context.Context, field graphql.CollectedField, obj *Nft) (ret graphql.Marshaler) {
+func (ec *executionContext) _PageInfo_hasPreviousPage(ctx context.Context, field graphql.CollectedField, obj *PageInfo) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -7485,7 +11338,7 @@ func (ec *executionContext) _Nft_description(ctx context.Context, field graphql.
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Nft",
+		Object:     "PageInfo",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -7495,7 +11348,7 @@ func (ec *executionContext) _Nft_description(ctx context.Context, field graphql.
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Description, nil
+		return obj.HasPreviousPage, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7507,12 +11360,12 @@ func (ec *executionContext) _Nft_description(ctx context.Context, field graphql.
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Nft_externalUrl(ctx context.Context, field graphql.CollectedField, obj *Nft) (ret graphql.Marshaler) {
+func (ec *executionContext) _PageInfo_startCursor(ctx context.Context, field graphql.CollectedField, obj *PageInfo) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -7520,7 +11373,7 @@ func (ec *executionContext) _Nft_externalUrl(ctx context.Context, field graphql.
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Nft",
+		Object:     "PageInfo",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -7530,24 +11383,21 @@ func (ec *executionContext) _Nft_externalUrl(ctx context.Context, field graphql.
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ExternalURL, nil
+		return obj.StartCursor, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Nft_image(ctx context.Context, field graphql.CollectedField, obj *Nft) (ret graphql.Marshaler) {
+func (ec *executionContext) _PageInfo_endCursor(ctx context.Context, field graphql.CollectedField, obj *PageInfo) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -7555,7 +11405,7 @@ func (ec *executionContext) _Nft_image(ctx context.Context, field graphql.Collec
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Nft",
+		Object:     "PageInfo",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -7565,24 +11415,21 @@ func (ec *executionContext) _Nft_image(ctx context.Context, field graphql.Collec
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Image, nil
+		return obj.EndCursor, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Nft_animationUrl(ctx context.Context, field graphql.CollectedField, obj *Nft) (ret graphql.Marshaler) {
+func (ec *executionContext) _PaymentIntent_id(ctx context.Context, field graphql.CollectedField, obj *PaymentIntent) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -7590,7 +11437,7 @@ func (ec *executionContext) _Nft_animationUrl(ctx context.Context, field graphql
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Nft",
+		Object:     "PaymentIntent",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -7600,7 +11447,7 @@ func (ec *executionContext) _Nft_animationUrl(ctx context.Context, field graphql
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.AnimationURL, nil
+		return obj.ID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7617,7 +11464,7 @@ func (ec *executionContext) _Nft_animationUrl(ctx context.Context, field graphql
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Nft_attributes(ctx context.Context, field graphql.CollectedField, obj *Nft) (ret graphql.Marshaler) {
+func (ec *executionContext) _PaymentIntent_clientSecret(ctx context.Context, field graphql.CollectedField, obj *PaymentIntent) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -7625,31 +11472,34 @@ func (ec *executionContext) _Nft_attributes(ctx context.Context, field graphql.C
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Nft",
+		Object:     "PaymentIntent",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Nft().Attributes(rctx, obj)
+		return obj.ClientSecret, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]*Attribute)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOAttribute2ᚕᚖserverᚋapiᚋgraphqlᚋgraphᚐAttributeᚄ(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _OffchainNft_id(ctx context.Context, field graphql.CollectedField, obj *OffchainNft) (ret graphql.Marshaler) {
+func (ec *executionContext) _Permission_id(ctx context.Context, field graphql.CollectedField, obj *Permission) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -7657,7 +11507,7 @@ func (ec *executionContext) _OffchainNft_id(ctx context.Context, field graphql.C
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "OffchainNft",
+		Object:     "Permission",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -7684,7 +11534,7 @@ func (ec *executionContext) _OffchainNft_id(ctx context.Context, field graphql.C
 	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _OffchainNft_amount(ctx context.Context, field graphql.CollectedField, obj *OffchainNft) (ret graphql.Marshaler) {
+func (ec *executionContext) _Permission_name(ctx context.Context, field graphql.CollectedField, obj *Permission) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -7692,7 +11542,7 @@ func (ec *executionContext) _OffchainNft_amount(ctx context.Context, field graph
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "OffchainNft",
+		Object:     "Permission",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -7702,7 +11552,7 @@ func (ec *executionContext) _OffchainNft_amount(ctx context.Context, field graph
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Amount, nil
+		return obj.Name, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7714,12 +11564,12 @@ func (ec *executionContext) _OffchainNft_amount(ctx context.Context, field graph
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Owner_user(ctx context.Context, field graphql.CollectedField, obj *Owner) (ret graphql.Marshaler) {
+func (ec *executionContext) _Permission_description(ctx context.Context, field graphql.CollectedField, obj *Permission) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -7727,7 +11577,7 @@ func (ec *executionContext) _Owner_user(ctx context.Context, field graphql.Colle
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Owner",
+		Object:     "Permission",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -7737,21 +11587,24 @@ func (ec *executionContext) _Owner_user(ctx context.Context, field graphql.Colle
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.User, nil
+		return obj.Description, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*User)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOUser2ᚖserverᚋapiᚋgraphqlᚋgraphᚐUser(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Owner_addresses(ctx context.Context, field graphql.CollectedField, obj *Owner) (ret graphql.Marshaler) {
+func (ec *executionContext) _Profile_image(ctx context.Context, field graphql.CollectedField, obj *Profile) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -7759,7 +11612,7 @@ func (ec *executionContext) _Owner_addresses(ctx context.Context, field graphql.
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Owner",
+		Object:     "Profile",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -7769,7 +11622,7 @@ func (ec *executionContext) _Owner_addresses(ctx context.Context, field graphql.
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Addresses, nil
+		return obj.Image, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7778,12 +11631,12 @@ func (ec *executionContext) _Owner_addresses(ctx context.Context, field graphql.
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.([]string)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalOString2ᚕstringᚄ(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Owner_amount(ctx context.Context, field graphql.CollectedField, obj *Owner) (ret graphql.Marshaler) {
+func (ec *executionContext) _Profile_description(ctx context.Context, field graphql.CollectedField, obj *Profile) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -7791,7 +11644,7 @@ func (ec *executionContext) _Owner_amount(ctx context.Context, field graphql.Col
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Owner",
+		Object:     "Profile",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -7801,24 +11654,21 @@ func (ec *executionContext) _Owner_amount(ctx context.Context, field graphql.Col
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Amount, nil
+		return obj.Description, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _PaymentIntent_id(ctx context.Context, field graphql.CollectedField, obj *PaymentIntent) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_myAPIKeys(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -7826,17 +11676,37 @@ func (ec *executionContext) _PaymentIntent_id(ctx context.Context, field graphql
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "PaymentIntent",
+		Object:     "Query",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
+		directive0 := func(rctx context.Context) (interface{}, error) {
+			ctx = rctx // use context from middleware stack in children
+			return ec.resolvers.Query().MyAPIKeys(rctx)
+		}
+		directive1 := func(ctx context.Context) (interface{}, error) {
+			if ec.directives.Authenticate == nil {
+				return nil, errors.New("directive authenticate is not implemented")
+			}
+			return ec.directives.Authenticate(ctx, nil, directive0, nil, nil, nil)
+		}
+
+		tmp, err := directive1(rctx)
+		if err != nil {
+			return nil, graphql.ErrorOnPath(ctx, err)
+		}
+		if tmp == nil {
+			return nil, nil
+		}
+		if data, ok := tmp.([]*APIKey); ok {
+			return data, nil
+		}
+		return nil, fmt.Errorf(`unexpected type %T from directive, should be []*server/api/graphql/graph.APIKey`, tmp)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7848,12 +11718,12 @@ func (ec *executionContext) _PaymentIntent_id(ctx context.Context, field graphql
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.([]*APIKey)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNAPIKey2ᚕᚖserverᚋapiᚋgraphqlᚋgraphᚐAPIKeyᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _PaymentIntent_clientSecret(ctx context.Context, field graphql.CollectedField, obj *PaymentIntent) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_blogPosts(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -7861,34 +11731,38 @@ func (ec *executionContext) _PaymentIntent_clientSecret(ctx context.Context, fie
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "PaymentIntent",
+		Object:     "Query",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query_blogPosts_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ClientSecret, nil
+		return ec.resolvers.Query().BlogPosts(rctx, args["filter"].(BlogPostsFilter))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.([]*BlogPost)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOBlogPost2ᚕᚖserverᚋapiᚋgraphqlᚋgraphᚐBlogPostᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Profile_image(ctx context.Context, field graphql.CollectedField, obj *Profile) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_categories(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -7896,31 +11770,34 @@ func (ec *executionContext) _Profile_image(ctx context.Context, field graphql.Co
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Profile",
+		Object:     "Query",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Image, nil
+		return ec.resolvers.Query().Categories(rctx)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.([]*Category)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNCategory2ᚕᚖserverᚋapiᚋgraphqlᚋgraphᚐCategoryᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Profile_description(ctx context.Context, field graphql.CollectedField, obj *Profile) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_sendEvent(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -7928,17 +11805,48 @@ func (ec *executionContext) _Profile_description(ctx context.Context, field grap
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Profile",
+		Object:     "Query",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query_sendEvent_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Description, nil
+		directive0 := func(rctx context.Context) (interface{}, error) {
+			ctx = rctx // use context from middleware stack in children
+			return ec.resolvers.Query().SendEvent(rctx, args["input"].(*SendEventInput))
+		}
+		directive1 := func(ctx context.Context) (interface{}, error) {
+			enforce, err := ec.unmarshalOBoolean2ᚖbool(ctx, false)
+			if err != nil {
+				return nil, err
+			}
+			if ec.directives.Authenticate == nil {
+				return nil, errors.New("directive authenticate is not implemented")
+			}
+			return ec.directives.Authenticate(ctx, nil, directive0, nil, enforce, nil)
+		}
+
+		tmp, err := directive1(rctx)
+		if err != nil {
+			return nil, graphql.ErrorOnPath(ctx, err)
+		}
+		if tmp == nil {
+			return nil, nil
+		}
+		if data, ok := tmp.(*string); ok {
+			return data, nil
+		}
+		return nil, fmt.Errorf(`unexpected type %T from directive, should be *string`, tmp)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7952,7 +11860,7 @@ func (ec *executionContext) _Profile_description(ctx context.Context, field grap
 	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query_blogPosts(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_designerApplications(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -7969,15 +11877,43 @@ func (ec *executionContext) _Query_blogPosts(ctx context.Context, field graphql.
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Query_blogPosts_args(ctx, rawArgs)
+	args, err := ec.field_Query_designerApplications_args(ctx, rawArgs)
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().BlogPosts(rctx, args["filter"].(BlogPostsFilter))
+		directive0 := func(rctx context.Context) (interface{}, error) {
+			ctx = rctx // use context from middleware stack in children
+			return ec.resolvers.Query().DesignerApplications(rctx, args["filter"].(DesignerApplicationsFilter))
+		}
+		directive1 := func(ctx context.Context) (interface{}, error) {
+			rules, err := ec.unmarshalORULE2ᚕserverᚋapiᚋgraphqlᚋgraphᚐRuleᚄ(ctx, []interface{}{"ADMIN_ROLE", "APPLICANT_OF"})
+			if err != nil {
+				return nil, err
+			}
+			match, err := ec.unmarshalORULE_MATCH2ᚖserverᚋapiᚋgraphqlᚋgraphᚐRuleMatch(ctx, "ANY")
+			if err != nil {
+				return nil, err
+			}
+			if ec.directives.Authenticate == nil {
+				return nil, errors.New("directive authenticate is not implemented")
+			}
+			return ec.directives.Authenticate(ctx, nil, directive0, rules, nil, match)
+		}
+
+		tmp, err := directive1(rctx)
+		if err != nil {
+			return nil, graphql.ErrorOnPath(ctx, err)
+		}
+		if tmp == nil {
+			return nil, nil
+		}
+		if data, ok := tmp.([]*DesignerApplication); ok {
+			return data, nil
+		}
+		return nil, fmt.Errorf(`unexpected type %T from directive, should be []*server/api/graphql/graph.DesignerApplication`, tmp)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7986,12 +11922,12 @@ func (ec *executionContext) _Query_blogPosts(ctx context.Context, field graphql.
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.([]*BlogPost)
+	res := resTmp.([]*DesignerApplication)
 	fc.Result = res
-	return ec.marshalOBlogPost2ᚕᚖserverᚋapiᚋgraphqlᚋgraphᚐBlogPostᚄ(ctx, field.Selections, res)
+	return ec.marshalODesignerApplication2ᚕᚖserverᚋapiᚋgraphqlᚋgraphᚐDesignerApplication(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query_categories(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_deadJobs(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -8007,9 +11943,40 @@ func (ec *executionContext) _Query_categories(ctx context.Context, field graphql
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query_deadJobs_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().Categories(rctx)
+		directive0 := func(rctx context.Context) (interface{}, error) {
+			ctx = rctx // use context from middleware stack in children
+			return ec.resolvers.Query().DeadJobs(rctx, args["pagination"].(*Pagination))
+		}
+		directive1 := func(ctx context.Context) (interface{}, error) {
+			permissions, err := ec.unmarshalNString2ᚕstringᚄ(ctx, []interface{}{"jobs:manage"})
+			if err != nil {
+				return nil, err
+			}
+			if ec.directives.Requires == nil {
+				return nil, errors.New("directive requires is not implemented")
+			}
+			return ec.directives.Requires(ctx, nil, directive0, permissions)
+		}
+
+		tmp, err := directive1(rctx)
+		if err != nil {
+			return nil, graphql.ErrorOnPath(ctx, err)
+		}
+		if tmp == nil {
+			return nil, nil
+		}
+		if data, ok := tmp.([]*Job); ok {
+			return data, nil
+		}
+		return nil, fmt.Errorf(`unexpected type %T from directive, should be []*server/api/graphql/graph.Job`, tmp)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8021,12 +11988,12 @@ func (ec *executionContext) _Query_categories(ctx context.Context, field graphql
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*Category)
+	res := resTmp.([]*Job)
 	fc.Result = res
-	return ec.marshalNCategory2ᚕᚖserverᚋapiᚋgraphqlᚋgraphᚐCategoryᚄ(ctx, field.Selections, res)
+	return ec.marshalNJob2ᚕᚖserverᚋapiᚋgraphqlᚋgraphᚐJobᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query_sendEvent(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_sendSuccessfulBuyEmail(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -8043,7 +12010,7 @@ func (ec *executionContext) _Query_sendEvent(ctx context.Context, field graphql.
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Query_sendEvent_args(ctx, rawArgs)
+	args, err := ec.field_Query_sendSuccessfulBuyEmail_args(ctx, rawArgs)
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
@@ -8052,17 +12019,13 @@ func (ec *executionContext) _Query_sendEvent(ctx context.Context, field graphql.
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		directive0 := func(rctx context.Context) (interface{}, error) {
 			ctx = rctx // use context from middleware stack in children
-			return ec.resolvers.Query().SendEvent(rctx, args["input"].(*SendEventInput))
+			return ec.resolvers.Query().SendSuccessfulBuyEmail(rctx, args["input"].(SendSuccessfulBuyEmailInput))
 		}
 		directive1 := func(ctx context.Context) (interface{}, error) {
-			enforce, err := ec.unmarshalOBoolean2ᚖbool(ctx, false)
-			if err != nil {
-				return nil, err
-			}
 			if ec.directives.Authenticate == nil {
 				return nil, errors.New("directive authenticate is not implemented")
 			}
-			return ec.directives.Authenticate(ctx, nil, directive0, nil, enforce)
+			return ec.directives.Authenticate(ctx, nil, directive0, nil, nil, nil)
 		}
 
 		tmp, err := directive1(rctx)
@@ -8089,7 +12052,7 @@ func (ec *executionContext) _Query_sendEvent(ctx context.Context, field graphql.
 	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query_designerApplications(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_emailTemplates(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -8105,30 +12068,50 @@ func (ec *executionContext) _Query_designerApplications(ctx context.Context, fie
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Query_designerApplications_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().DesignerApplications(rctx, args["filter"].(DesignerApplicationsFilter))
+		directive0 := func(rctx context.Context) (interface{}, error) {
+			ctx = rctx // use context from middleware stack in children
+			return ec.resolvers.Query().EmailTemplates(rctx)
+		}
+		directive1 := func(ctx context.Context) (interface{}, error) {
+			permissions, err := ec.unmarshalNString2ᚕstringᚄ(ctx, []interface{}{"emails:preview"})
+			if err != nil {
+				return nil, err
+			}
+			if ec.directives.Requires == nil {
+				return nil, errors.New("directive requires is not implemented")
+			}
+			return ec.directives.Requires(ctx, nil, directive0, permissions)
+		}
+
+		tmp, err := directive1(rctx)
+		if err != nil {
+			return nil, graphql.ErrorOnPath(ctx, err)
+		}
+		if tmp == nil {
+			return nil, nil
+		}
+		if data, ok := tmp.([]string); ok {
+			return data, nil
+		}
+		return nil, fmt.Errorf(`unexpected type %T from directive, should be []string`, tmp)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]*DesignerApplication)
+	res := resTmp.([]string)
 	fc.Result = res
-	return ec.marshalODesignerApplication2ᚕᚖserverᚋapiᚋgraphqlᚋgraphᚐDesignerApplication(ctx, field.Selections, res)
+	return ec.marshalNString2ᚕstringᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query_sendSuccessfulBuyEmail(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_previewEmail(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -8145,7 +12128,7 @@ func (ec *executionContext) _Query_sendSuccessfulBuyEmail(ctx context.Context, f
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Query_sendSuccessfulBuyEmail_args(ctx, rawArgs)
+	args, err := ec.field_Query_previewEmail_args(ctx, rawArgs)
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
@@ -8154,13 +12137,17 @@ func (ec *executionContext) _Query_sendSuccessfulBuyEmail(ctx context.Context, f
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		directive0 := func(rctx context.Context) (interface{}, error) {
 			ctx = rctx // use context from middleware stack in children
-			return ec.resolvers.Query().SendSuccessfulBuyEmail(rctx, args["input"].(SendSuccessfulBuyEmailInput))
+			return ec.resolvers.Query().PreviewEmail(rctx, args["input"].(PreviewEmailInput))
 		}
 		directive1 := func(ctx context.Context) (interface{}, error) {
-			if ec.directives.Authenticate == nil {
-				return nil, errors.New("directive authenticate is not implemented")
+			permissions, err := ec.unmarshalNString2ᚕstringᚄ(ctx, []interface{}{"emails:preview"})
+			if err != nil {
+				return nil, err
+			}
+			if ec.directives.Requires == nil {
+				return nil, errors.New("directive requires is not implemented")
 			}
-			return ec.directives.Authenticate(ctx, nil, directive0, nil, nil)
+			return ec.directives.Requires(ctx, nil, directive0, permissions)
 		}
 
 		tmp, err := directive1(rctx)
@@ -8170,21 +12157,24 @@ func (ec *executionContext) _Query_sendSuccessfulBuyEmail(ctx context.Context, f
 		if tmp == nil {
 			return nil, nil
 		}
-		if data, ok := tmp.(*string); ok {
+		if data, ok := tmp.(*EmailPreview); ok {
 			return data, nil
 		}
-		return nil, fmt.Errorf(`unexpected type %T from directive, should be *string`, tmp)
+		return nil, fmt.Errorf(`unexpected type %T from directive, should be *server/api/graphql/graph.EmailPreview`, tmp)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(*EmailPreview)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNEmailPreview2ᚖserverᚋapiᚋgraphqlᚋgraphᚐEmailPreview(ctx, field.Selections, res)
 }
 
 func (ec *executionContext) _Query_nfts(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
@@ -8223,7 +12213,7 @@ func (ec *executionContext) _Query_nfts(ctx context.Context, field graphql.Colle
 			if ec.directives.Authenticate == nil {
 				return nil, errors.New("directive authenticate is not implemented")
 			}
-			return ec.directives.Authenticate(ctx, nil, directive0, nil, enforce)
+			return ec.directives.Authenticate(ctx, nil, directive0, nil, enforce, nil)
 		}
 
 		tmp, err := directive1(rctx)
@@ -8284,9 +12274,75 @@ func (ec *executionContext) _Query_roles(ctx context.Context, field graphql.Coll
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.([]*Role)
+	res := resTmp.([]*Role)
+	fc.Result = res
+	return ec.marshalORole2ᚕᚖserverᚋapiᚋgraphqlᚋgraphᚐRoleᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Query_permissions(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query_permissions_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		directive0 := func(rctx context.Context) (interface{}, error) {
+			ctx = rctx // use context from middleware stack in children
+			return ec.resolvers.Query().Permissions(rctx, args["filter"].(*PermissionsFilter))
+		}
+		directive1 := func(ctx context.Context) (interface{}, error) {
+			permissions, err := ec.unmarshalNString2ᚕstringᚄ(ctx, []interface{}{"roles:manage"})
+			if err != nil {
+				return nil, err
+			}
+			if ec.directives.Requires == nil {
+				return nil, errors.New("directive requires is not implemented")
+			}
+			return ec.directives.Requires(ctx, nil, directive0, permissions)
+		}
+
+		tmp, err := directive1(rctx)
+		if err != nil {
+			return nil, graphql.ErrorOnPath(ctx, err)
+		}
+		if tmp == nil {
+			return nil, nil
+		}
+		if data, ok := tmp.([]*Permission); ok {
+			return data, nil
+		}
+		return nil, fmt.Errorf(`unexpected type %T from directive, should be []*server/api/graphql/graph.Permission`, tmp)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*Permission)
 	fc.Result = res
-	return ec.marshalORole2ᚕᚖserverᚋapiᚋgraphqlᚋgraphᚐRoleᚄ(ctx, field.Selections, res)
+	return ec.marshalNPermission2ᚕᚖserverᚋapiᚋgraphqlᚋgraphᚐPermissionᚄ(ctx, field.Selections, res)
 }
 
 func (ec *executionContext) _Query_createStripeAccountLink(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
@@ -8321,7 +12377,7 @@ func (ec *executionContext) _Query_createStripeAccountLink(ctx context.Context,
 			if ec.directives.Authenticate == nil {
 				return nil, errors.New("directive authenticate is not implemented")
 			}
-			return ec.directives.Authenticate(ctx, nil, directive0, nil, nil)
+			return ec.directives.Authenticate(ctx, nil, directive0, nil, nil, nil)
 		}
 
 		tmp, err := directive1(rctx)
@@ -8376,7 +12432,7 @@ func (ec *executionContext) _Query_getBankAccountRequirements(ctx context.Contex
 			if ec.directives.Authenticate == nil {
 				return nil, errors.New("directive authenticate is not implemented")
 			}
-			return ec.directives.Authenticate(ctx, nil, directive0, nil, nil)
+			return ec.directives.Authenticate(ctx, nil, directive0, nil, nil, nil)
 		}
 
 		tmp, err := directive1(rctx)
@@ -8428,7 +12484,7 @@ func (ec *executionContext) _Query_getBankAccount(ctx context.Context, field gra
 			if ec.directives.Authenticate == nil {
 				return nil, errors.New("directive authenticate is not implemented")
 			}
-			return ec.directives.Authenticate(ctx, nil, directive0, nil, nil)
+			return ec.directives.Authenticate(ctx, nil, directive0, nil, nil, nil)
 		}
 
 		tmp, err := directive1(rctx)
@@ -8530,7 +12586,7 @@ func (ec *executionContext) _Query_users(ctx context.Context, field graphql.Coll
 			if ec.directives.Authenticate == nil {
 				return nil, errors.New("directive authenticate is not implemented")
 			}
-			return ec.directives.Authenticate(ctx, nil, directive0, nil, enforce)
+			return ec.directives.Authenticate(ctx, nil, directive0, nil, enforce, nil)
 		}
 
 		tmp, err := directive1(rctx)
@@ -8560,6 +12616,72 @@ func (ec *executionContext) _Query_users(ctx context.Context, field graphql.Coll
 	return ec.marshalNUsersResult2ᚖserverᚋapiᚋgraphqlᚋgraphᚐUsersResult(ctx, field.Selections, res)
 }
 
+func (ec *executionContext) _Query_usersConnection(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query_usersConnection_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		directive0 := func(rctx context.Context) (interface{}, error) {
+			ctx = rctx // use context from middleware stack in children
+			return ec.resolvers.Query().UsersConnection(rctx, args["filter"].(UsersFilter), args["first"].(*int), args["after"].(*string), args["last"].(*int), args["before"].(*string))
+		}
+		directive1 := func(ctx context.Context) (interface{}, error) {
+			enforce, err := ec.unmarshalOBoolean2ᚖbool(ctx, false)
+			if err != nil {
+				return nil, err
+			}
+			if ec.directives.Authenticate == nil {
+				return nil, errors.New("directive authenticate is not implemented")
+			}
+			return ec.directives.Authenticate(ctx, nil, directive0, nil, enforce, nil)
+		}
+
+		tmp, err := directive1(rctx)
+		if err != nil {
+			return nil, graphql.ErrorOnPath(ctx, err)
+		}
+		if tmp == nil {
+			return nil, nil
+		}
+		if data, ok := tmp.(*UserConnection); ok {
+			return data, nil
+		}
+		return nil, fmt.Errorf(`unexpected type %T from directive, should be *server/api/graphql/graph.UserConnection`, tmp)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*UserConnection)
+	fc.Result = res
+	return ec.marshalNUserConnection2ᚖserverᚋapiᚋgraphqlᚋgraphᚐUserConnection(ctx, field.Selections, res)
+}
+
 func (ec *executionContext) _Query_offchainNfts(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
@@ -8585,7 +12707,7 @@ func (ec *executionContext) _Query_offchainNfts(ctx context.Context, field graph
 			if ec.directives.Authenticate == nil {
 				return nil, errors.New("directive authenticate is not implemented")
 			}
-			return ec.directives.Authenticate(ctx, nil, directive0, nil, nil)
+			return ec.directives.Authenticate(ctx, nil, directive0, nil, nil, nil)
 		}
 
 		tmp, err := directive1(rctx)
@@ -9429,14 +13551,22 @@ func (ec *executionContext) _User_firstName(ctx context.Context, field graphql.C
 			return obj.FirstName, nil
 		}
 		directive1 := func(ctx context.Context) (interface{}, error) {
-			rules, err := ec.unmarshalOPROTECTED_RULE2ᚕserverᚋapiᚋgraphqlᚋgraphᚐProtectedRuleᚄ(ctx, []interface{}{"ADMIN"})
+			rules, err := ec.unmarshalOPROTECTED_RULE2ᚕserverᚋapiᚋgraphqlᚋgraphᚐProtectedRuleᚄ(ctx, []interface{}{"SELF"})
+			if err != nil {
+				return nil, err
+			}
+			permissions, err := ec.unmarshalOString2ᚕstringᚄ(ctx, []interface{}{"users:read_pii"})
+			if err != nil {
+				return nil, err
+			}
+			nullable, err := ec.unmarshalOBoolean2ᚖbool(ctx, true)
 			if err != nil {
 				return nil, err
 			}
 			if ec.directives.Protected == nil {
 				return nil, errors.New("directive protected is not implemented")
 			}
-			return ec.directives.Protected(ctx, obj, directive0, rules)
+			return ec.directives.Protected(ctx, obj, directive0, rules, nil, permissions, nullable)
 		}
 
 		tmp, err := directive1(rctx)
@@ -9446,24 +13576,21 @@ func (ec *executionContext) _User_firstName(ctx context.Context, field graphql.C
 		if tmp == nil {
 			return nil, nil
 		}
-		if data, ok := tmp.(string); ok {
+		if data, ok := tmp.(*string); ok {
 			return data, nil
 		}
-		return nil, fmt.Errorf(`unexpected type %T from directive, should be string`, tmp)
+		return nil, fmt.Errorf(`unexpected type %T from directive, should be *string`, tmp)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
 func (ec *executionContext) _User_lastName(ctx context.Context, field graphql.CollectedField, obj *User) (ret graphql.Marshaler) {
@@ -9488,14 +13615,22 @@ func (ec *executionContext) _User_lastName(ctx context.Context, field graphql.Co
 			return obj.LastName, nil
 		}
 		directive1 := func(ctx context.Context) (interface{}, error) {
-			rules, err := ec.unmarshalOPROTECTED_RULE2ᚕserverᚋapiᚋgraphqlᚋgraphᚐProtectedRuleᚄ(ctx, []interface{}{"ADMIN"})
+			rules, err := ec.unmarshalOPROTECTED_RULE2ᚕserverᚋapiᚋgraphqlᚋgraphᚐProtectedRuleᚄ(ctx, []interface{}{"SELF"})
+			if err != nil {
+				return nil, err
+			}
+			permissions, err := ec.unmarshalOString2ᚕstringᚄ(ctx, []interface{}{"users:read_pii"})
+			if err != nil {
+				return nil, err
+			}
+			nullable, err := ec.unmarshalOBoolean2ᚖbool(ctx, true)
 			if err != nil {
 				return nil, err
 			}
 			if ec.directives.Protected == nil {
 				return nil, errors.New("directive protected is not implemented")
 			}
-			return ec.directives.Protected(ctx, obj, directive0, rules)
+			return ec.directives.Protected(ctx, obj, directive0, rules, nil, permissions, nullable)
 		}
 
 		tmp, err := directive1(rctx)
@@ -9505,24 +13640,21 @@ func (ec *executionContext) _User_lastName(ctx context.Context, field graphql.Co
 		if tmp == nil {
 			return nil, nil
 		}
-		if data, ok := tmp.(string); ok {
+		if data, ok := tmp.(*string); ok {
 			return data, nil
 		}
-		return nil, fmt.Errorf(`unexpected type %T from directive, should be string`, tmp)
+		return nil, fmt.Errorf(`unexpected type %T from directive, should be *string`, tmp)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
 func (ec *executionContext) _User_email(ctx context.Context, field graphql.CollectedField, obj *User) (ret graphql.Marshaler) {
@@ -9547,14 +13679,22 @@ func (ec *executionContext) _User_email(ctx context.Context, field graphql.Colle
 			return obj.Email, nil
 		}
 		directive1 := func(ctx context.Context) (interface{}, error) {
-			rules, err := ec.unmarshalOPROTECTED_RULE2ᚕserverᚋapiᚋgraphqlᚋgraphᚐProtectedRuleᚄ(ctx, []interface{}{"ADMIN"})
+			rules, err := ec.unmarshalOPROTECTED_RULE2ᚕserverᚋapiᚋgraphqlᚋgraphᚐProtectedRuleᚄ(ctx, []interface{}{"SELF"})
+			if err != nil {
+				return nil, err
+			}
+			permissions, err := ec.unmarshalOString2ᚕstringᚄ(ctx, []interface{}{"users:read_pii"})
+			if err != nil {
+				return nil, err
+			}
+			nullable, err := ec.unmarshalOBoolean2ᚖbool(ctx, true)
 			if err != nil {
 				return nil, err
 			}
 			if ec.directives.Protected == nil {
 				return nil, errors.New("directive protected is not implemented")
 			}
-			return ec.directives.Protected(ctx, obj, directive0, rules)
+			return ec.directives.Protected(ctx, obj, directive0, rules, nil, permissions, nullable)
 		}
 
 		tmp, err := directive1(rctx)
@@ -9564,24 +13704,21 @@ func (ec *executionContext) _User_email(ctx context.Context, field graphql.Colle
 		if tmp == nil {
 			return nil, nil
 		}
-		if data, ok := tmp.(string); ok {
+		if data, ok := tmp.(*string); ok {
 			return data, nil
 		}
-		return nil, fmt.Errorf(`unexpected type %T from directive, should be string`, tmp)
+		return nil, fmt.Errorf(`unexpected type %T from directive, should be *string`, tmp)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
 func (ec *executionContext) _User_preferredName(ctx context.Context, field graphql.CollectedField, obj *User) (ret graphql.Marshaler) {
@@ -9669,21 +13806,162 @@ func (ec *executionContext) _User_owned(ctx context.Context, field graphql.Colle
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.User().Owned(rctx, obj)
+		return ec.resolvers.User().Owned(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]*UserHasNfts)
+	fc.Result = res
+	return ec.marshalOUserHasNfts2ᚕᚖserverᚋapiᚋgraphqlᚋgraphᚐUserHasNftsᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _User_designed(ctx context.Context, field graphql.CollectedField, obj *User) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "User",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_User_designed_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.User().Designed(rctx, obj, args["filter"].(*DesignedFieldFilter))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]*Nft)
+	fc.Result = res
+	return ec.marshalONft2ᚕᚖserverᚋapiᚋgraphqlᚋgraphᚐNftᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _User_roles(ctx context.Context, field graphql.CollectedField, obj *User) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "User",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.User().Roles(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]*Role)
+	fc.Result = res
+	return ec.marshalORole2ᚕᚖserverᚋapiᚋgraphqlᚋgraphᚐRoleᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _User_profile(ctx context.Context, field graphql.CollectedField, obj *User) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "User",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.User().Profile(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*Profile)
+	fc.Result = res
+	return ec.marshalNProfile2ᚖserverᚋapiᚋgraphqlᚋgraphᚐProfile(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _User_address(ctx context.Context, field graphql.CollectedField, obj *User) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "User",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.User().Address(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]*UserHasNfts)
+	res := resTmp.(*Address)
 	fc.Result = res
-	return ec.marshalOUserHasNfts2ᚕᚖserverᚋapiᚋgraphqlᚋgraphᚐUserHasNftsᚄ(ctx, field.Selections, res)
+	return ec.marshalNAddress2ᚖserverᚋapiᚋgraphqlᚋgraphᚐAddress(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _User_designed(ctx context.Context, field graphql.CollectedField, obj *User) (ret graphql.Marshaler) {
+func (ec *executionContext) _User_hasCompleteProfile(ctx context.Context, field graphql.CollectedField, obj *User) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -9694,35 +13972,31 @@ func (ec *executionContext) _User_designed(ctx context.Context, field graphql.Co
 		Object:     "User",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_User_designed_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.User().Designed(rctx, obj, args["filter"].(*DesignedFieldFilter))
+		return obj.HasCompleteProfile, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]*Nft)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalONft2ᚕᚖserverᚋapiᚋgraphqlᚋgraphᚐNftᚄ(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _User_roles(ctx context.Context, field graphql.CollectedField, obj *User) (ret graphql.Marshaler) {
+func (ec *executionContext) _User_hasBankAccount(ctx context.Context, field graphql.CollectedField, obj *User) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -9733,28 +14007,31 @@ func (ec *executionContext) _User_roles(ctx context.Context, field graphql.Colle
 		Object:     "User",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.User().Roles(rctx, obj)
+		return obj.HasBankAccount, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]*Role)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalORole2ᚕᚖserverᚋapiᚋgraphqlᚋgraphᚐRoleᚄ(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _User_profile(ctx context.Context, field graphql.CollectedField, obj *User) (ret graphql.Marshaler) {
+func (ec *executionContext) _User_hasUploadedOneNft(ctx context.Context, field graphql.CollectedField, obj *User) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -9765,14 +14042,14 @@ func (ec *executionContext) _User_profile(ctx context.Context, field graphql.Col
 		Object:     "User",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.User().Profile(rctx, obj)
+		return obj.HasUploadedOneNft, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -9784,12 +14061,12 @@ func (ec *executionContext) _User_profile(ctx context.Context, field graphql.Col
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*Profile)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalNProfile2ᚖserverᚋapiᚋgraphqlᚋgraphᚐProfile(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _User_address(ctx context.Context, field graphql.CollectedField, obj *User) (ret graphql.Marshaler) {
+func (ec *executionContext) _User_stripeTransferCapabilityStatus(ctx context.Context, field graphql.CollectedField, obj *User) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -9800,14 +14077,14 @@ func (ec *executionContext) _User_address(ctx context.Context, field graphql.Col
 		Object:     "User",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.User().Address(rctx, obj)
+		return obj.StripeTransferCapabilityStatus, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -9819,12 +14096,12 @@ func (ec *executionContext) _User_address(ctx context.Context, field graphql.Col
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*Address)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNAddress2ᚖserverᚋapiᚋgraphqlᚋgraphᚐAddress(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _User_hasCompleteProfile(ctx context.Context, field graphql.CollectedField, obj *User) (ret graphql.Marshaler) {
+func (ec *executionContext) _UserConnection_edges(ctx context.Context, field graphql.CollectedField, obj *UserConnection) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -9832,7 +14109,7 @@ func (ec *executionContext) _User_hasCompleteProfile(ctx context.Context, field
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "User",
+		Object:     "UserConnection",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -9842,7 +14119,7 @@ func (ec *executionContext) _User_hasCompleteProfile(ctx context.Context, field
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.HasCompleteProfile, nil
+		return obj.Edges, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -9854,12 +14131,12 @@ func (ec *executionContext) _User_hasCompleteProfile(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.([]*UserEdge)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNUserEdge2ᚕᚖserverᚋapiᚋgraphqlᚋgraphᚐUserEdgeᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _User_hasBankAccount(ctx context.Context, field graphql.CollectedField, obj *User) (ret graphql.Marshaler) {
+func (ec *executionContext) _UserConnection_pageInfo(ctx context.Context, field graphql.CollectedField, obj *UserConnection) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -9867,7 +14144,7 @@ func (ec *executionContext) _User_hasBankAccount(ctx context.Context, field grap
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "User",
+		Object:     "UserConnection",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -9877,7 +14154,7 @@ func (ec *executionContext) _User_hasBankAccount(ctx context.Context, field grap
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.HasBankAccount, nil
+		return obj.PageInfo, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -9889,12 +14166,12 @@ func (ec *executionContext) _User_hasBankAccount(ctx context.Context, field grap
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(*PageInfo)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNPageInfo2ᚖserverᚋapiᚋgraphqlᚋgraphᚐPageInfo(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _User_hasUploadedOneNft(ctx context.Context, field graphql.CollectedField, obj *User) (ret graphql.Marshaler) {
+func (ec *executionContext) _UserEdge_cursor(ctx context.Context, field graphql.CollectedField, obj *UserEdge) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -9902,7 +14179,7 @@ func (ec *executionContext) _User_hasUploadedOneNft(ctx context.Context, field g
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "User",
+		Object:     "UserEdge",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -9912,7 +14189,7 @@ func (ec *executionContext) _User_hasUploadedOneNft(ctx context.Context, field g
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.HasUploadedOneNft, nil
+		return obj.Cursor, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -9924,12 +14201,12 @@ func (ec *executionContext) _User_hasUploadedOneNft(ctx context.Context, field g
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _User_stripeTransferCapabilityStatus(ctx context.Context, field graphql.CollectedField, obj *User) (ret graphql.Marshaler) {
+func (ec *executionContext) _UserEdge_node(ctx context.Context, field graphql.CollectedField, obj *UserEdge) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -9937,7 +14214,7 @@ func (ec *executionContext) _User_stripeTransferCapabilityStatus(ctx context.Con
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "User",
+		Object:     "UserEdge",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -9947,7 +14224,7 @@ func (ec *executionContext) _User_stripeTransferCapabilityStatus(ctx context.Con
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.StripeTransferCapabilityStatus, nil
+		return obj.Node, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -9959,9 +14236,9 @@ func (ec *executionContext) _User_stripeTransferCapabilityStatus(ctx context.Con
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*User)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNUser2ᚖserverᚋapiᚋgraphqlᚋgraphᚐUser(ctx, field.Selections, res)
 }
 
 func (ec *executionContext) _UserHasNfts_address(ctx context.Context, field graphql.CollectedField, obj *UserHasNfts) (ret graphql.Marshaler) {
@@ -11588,6 +15865,29 @@ func (ec *executionContext) unmarshalInputBuyInput(ctx context.Context, obj inte
 	return it, nil
 }
 
+func (ec *executionContext) unmarshalInputConfirmEmailChangeInput(ctx context.Context, obj interface{}) (ConfirmEmailChangeInput, error) {
+	var it ConfirmEmailChangeInput
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	for k, v := range asMap {
+		switch k {
+		case "key":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("key"))
+			it.Key, err = ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
 func (ec *executionContext) unmarshalInputConfirmEmailInput(ctx context.Context, obj interface{}) (ConfirmEmailInput, error) {
 	var it ConfirmEmailInput
 	asMap := map[string]interface{}{}
@@ -11611,6 +15911,37 @@ func (ec *executionContext) unmarshalInputConfirmEmailInput(ctx context.Context,
 	return it, nil
 }
 
+func (ec *executionContext) unmarshalInputCreateAPIKeyInput(ctx context.Context, obj interface{}) (CreateAPIKeyInput, error) {
+	var it CreateAPIKeyInput
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	for k, v := range asMap {
+		switch k {
+		case "name":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
+			it.Name, err = ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "scopes":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("scopes"))
+			it.Scopes, err = ec.unmarshalNAPIKeyScope2ᚕserverᚋapiᚋgraphqlᚋgraphᚐAPIKeyScopeᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
 func (ec *executionContext) unmarshalInputCreateBatchTransferAuthorizationInput(ctx context.Context, obj interface{}) (CreateBatchTransferAuthorizationInput, error) {
 	var it CreateBatchTransferAuthorizationInput
 	asMap := map[string]interface{}{}
@@ -11861,6 +16192,74 @@ func (ec *executionContext) unmarshalInputCreatePaymentIntentInput(ctx context.C
 	return it, nil
 }
 
+func (ec *executionContext) unmarshalInputCreatePermissionInput(ctx context.Context, obj interface{}) (CreatePermissionInput, error) {
+	var it CreatePermissionInput
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	for k, v := range asMap {
+		switch k {
+		case "name":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
+			directive0 := func(ctx context.Context) (interface{}, error) { return ec.unmarshalNString2string(ctx, v) }
+			directive1 := func(ctx context.Context) (interface{}, error) {
+				if ec.directives.Lowercase == nil {
+					return nil, errors.New("directive lowercase is not implemented")
+				}
+				return ec.directives.Lowercase(ctx, obj, directive0)
+			}
+
+			tmp, err := directive1(ctx)
+			if err != nil {
+				return it, graphql.ErrorOnPath(ctx, err)
+			}
+			if data, ok := tmp.(string); ok {
+				it.Name = data
+			} else {
+				err := fmt.Errorf(`unexpected type %T from directive, should be string`, tmp)
+				return it, graphql.ErrorOnPath(ctx, err)
+			}
+		case "description":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("description"))
+			it.Description, err = ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputCreateRoleInput(ctx context.Context, obj interface{}) (CreateRoleInput, error) {
+	var it CreateRoleInput
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	for k, v := range asMap {
+		switch k {
+		case "name":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
+			it.Name, err = ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
 func (ec *executionContext) unmarshalInputCreateStripeAccountLinkInput(ctx context.Context, obj interface{}) (CreateStripeAccountLinkInput, error) {
 	var it CreateStripeAccountLinkInput
 	asMap := map[string]interface{}{}
@@ -12020,32 +16419,117 @@ func (ec *executionContext) unmarshalInputCreateUserInput(ctx context.Context, o
 		case "lastName":
 			var err error
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("lastName"))
-			it.LastName, err = ec.unmarshalNString2string(ctx, v)
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("lastName"))
+			it.LastName, err = ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "preferredName":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("preferredName"))
+			it.PreferredName, err = ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "applyAsDesigner":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("applyAsDesigner"))
+			it.ApplyAsDesigner, err = ec.unmarshalNBoolean2bool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "country":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("country"))
+			it.Country, err = ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "locale":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("locale"))
+			it.Locale, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputDeleteBlogPostInput(ctx context.Context, obj interface{}) (DeleteBlogPostInput, error) {
+	var it DeleteBlogPostInput
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	for k, v := range asMap {
+		switch k {
+		case "id":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+			it.ID, err = ec.unmarshalNInt2int(ctx, v)
 			if err != nil {
 				return it, err
 			}
-		case "preferredName":
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputDeleteMyAccountInput(ctx context.Context, obj interface{}) (DeleteMyAccountInput, error) {
+	var it DeleteMyAccountInput
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	for k, v := range asMap {
+		switch k {
+		case "password":
 			var err error
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("preferredName"))
-			it.PreferredName, err = ec.unmarshalNString2string(ctx, v)
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("password"))
+			it.Password, err = ec.unmarshalOString2ᚖstring(ctx, v)
 			if err != nil {
 				return it, err
 			}
-		case "applyAsDesigner":
+		case "reauthenticationKey":
 			var err error
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("applyAsDesigner"))
-			it.ApplyAsDesigner, err = ec.unmarshalNBoolean2bool(ctx, v)
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("reauthenticationKey"))
+			it.ReauthenticationKey, err = ec.unmarshalOString2ᚖstring(ctx, v)
 			if err != nil {
 				return it, err
 			}
-		case "country":
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputDeletePermissionInput(ctx context.Context, obj interface{}) (DeletePermissionInput, error) {
+	var it DeletePermissionInput
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	for k, v := range asMap {
+		switch k {
+		case "id":
 			var err error
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("country"))
-			it.Country, err = ec.unmarshalNString2string(ctx, v)
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+			it.ID, err = ec.unmarshalNInt2int(ctx, v)
 			if err != nil {
 				return it, err
 			}
@@ -12055,8 +16539,8 @@ func (ec *executionContext) unmarshalInputCreateUserInput(ctx context.Context, o
 	return it, nil
 }
 
-func (ec *executionContext) unmarshalInputDeleteBlogPostInput(ctx context.Context, obj interface{}) (DeleteBlogPostInput, error) {
-	var it DeleteBlogPostInput
+func (ec *executionContext) unmarshalInputDeleteRoleInput(ctx context.Context, obj interface{}) (DeleteRoleInput, error) {
+	var it DeleteRoleInput
 	asMap := map[string]interface{}{}
 	for k, v := range obj.(map[string]interface{}) {
 		asMap[k] = v
@@ -12242,6 +16726,37 @@ func (ec *executionContext) unmarshalInputDesignerApplicationsUsersFilter(ctx co
 	return it, nil
 }
 
+func (ec *executionContext) unmarshalInputExportMyDataInput(ctx context.Context, obj interface{}) (ExportMyDataInput, error) {
+	var it ExportMyDataInput
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	for k, v := range asMap {
+		switch k {
+		case "password":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("password"))
+			it.Password, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "reauthenticationKey":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("reauthenticationKey"))
+			it.ReauthenticationKey, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
 func (ec *executionContext) unmarshalInputForgotPasswordEnd(ctx context.Context, obj interface{}) (ForgotPasswordEnd, error) {
 	var it ForgotPasswordEnd
 	asMap := map[string]interface{}{}
@@ -12403,6 +16918,29 @@ func (ec *executionContext) unmarshalInputLoginBlockchainEndInput(ctx context.Co
 	return it, nil
 }
 
+func (ec *executionContext) unmarshalInputLoginBlockchainInitializeInput(ctx context.Context, obj interface{}) (LoginBlockchainInitializeInput, error) {
+	var it LoginBlockchainInitializeInput
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	for k, v := range asMap {
+		switch k {
+		case "address":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("address"))
+			it.Address, err = ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
 func (ec *executionContext) unmarshalInputLoginInput(ctx context.Context, obj interface{}) (LoginInput, error) {
 	var it LoginInput
 	asMap := map[string]interface{}{}
@@ -12448,6 +16986,29 @@ func (ec *executionContext) unmarshalInputLoginInput(ctx context.Context, obj in
 	return it, nil
 }
 
+func (ec *executionContext) unmarshalInputLoginPasskeyEndInput(ctx context.Context, obj interface{}) (LoginPasskeyEndInput, error) {
+	var it LoginPasskeyEndInput
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	for k, v := range asMap {
+		switch k {
+		case "credential":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("credential"))
+			it.Credential, err = ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
 func (ec *executionContext) unmarshalInputNftsFilter(ctx context.Context, obj interface{}) (NftsFilter, error) {
 	var it NftsFilter
 	asMap := map[string]interface{}{}
@@ -12617,6 +17178,60 @@ func (ec *executionContext) unmarshalInputPagination(ctx context.Context, obj in
 	return it, nil
 }
 
+func (ec *executionContext) unmarshalInputPermissionsFilter(ctx context.Context, obj interface{}) (PermissionsFilter, error) {
+	var it PermissionsFilter
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	for k, v := range asMap {
+		switch k {
+		case "roleId":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("roleId"))
+			it.RoleID, err = ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputPreviewEmailInput(ctx context.Context, obj interface{}) (PreviewEmailInput, error) {
+	var it PreviewEmailInput
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	for k, v := range asMap {
+		switch k {
+		case "template":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("template"))
+			it.Template, err = ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "locale":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("locale"))
+			it.Locale, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
 func (ec *executionContext) unmarshalInputProfileInput(ctx context.Context, obj interface{}) (ProfileInput, error) {
 	var it ProfileInput
 	asMap := map[string]interface{}{}
@@ -12648,6 +17263,82 @@ func (ec *executionContext) unmarshalInputProfileInput(ctx context.Context, obj
 	return it, nil
 }
 
+func (ec *executionContext) unmarshalInputRegisterPasskeyEndInput(ctx context.Context, obj interface{}) (RegisterPasskeyEndInput, error) {
+	var it RegisterPasskeyEndInput
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	for k, v := range asMap {
+		switch k {
+		case "credential":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("credential"))
+			it.Credential, err = ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputRequestEmailChangeInput(ctx context.Context, obj interface{}) (RequestEmailChangeInput, error) {
+	var it RequestEmailChangeInput
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	for k, v := range asMap {
+		switch k {
+		case "newEmail":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("newEmail"))
+			directive0 := func(ctx context.Context) (interface{}, error) { return ec.unmarshalNString2string(ctx, v) }
+			directive1 := func(ctx context.Context) (interface{}, error) {
+				if ec.directives.Lowercase == nil {
+					return nil, errors.New("directive lowercase is not implemented")
+				}
+				return ec.directives.Lowercase(ctx, obj, directive0)
+			}
+
+			tmp, err := directive1(ctx)
+			if err != nil {
+				return it, graphql.ErrorOnPath(ctx, err)
+			}
+			if data, ok := tmp.(string); ok {
+				it.NewEmail = data
+			} else {
+				err := fmt.Errorf(`unexpected type %T from directive, should be string`, tmp)
+				return it, graphql.ErrorOnPath(ctx, err)
+			}
+		case "password":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("password"))
+			it.Password, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "reauthenticationKey":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("reauthenticationKey"))
+			it.ReauthenticationKey, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
 func (ec *executionContext) unmarshalInputResendConfirmationEmailInput(ctx context.Context, obj interface{}) (ResendConfirmationEmailInput, error) {
 	var it ResendConfirmationEmailInput
 	asMap := map[string]interface{}{}
@@ -12707,8 +17398,54 @@ func (ec *executionContext) unmarshalInputResolveDesignerApplicationInput(ctx co
 		case "approve":
 			var err error
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("approve"))
-			it.Approve, err = ec.unmarshalNBoolean2bool(ctx, v)
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("approve"))
+			it.Approve, err = ec.unmarshalNBoolean2bool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputRetryJobInput(ctx context.Context, obj interface{}) (RetryJobInput, error) {
+	var it RetryJobInput
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	for k, v := range asMap {
+		switch k {
+		case "id":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+			it.ID, err = ec.unmarshalNInt2int(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputRevokeAPIKeyInput(ctx context.Context, obj interface{}) (RevokeAPIKeyInput, error) {
+	var it RevokeAPIKeyInput
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	for k, v := range asMap {
+		switch k {
+		case "id":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+			it.ID, err = ec.unmarshalNInt2int(ctx, v)
 			if err != nil {
 				return it, err
 			}
@@ -13000,6 +17737,29 @@ func (ec *executionContext) unmarshalInputSendSuccessfulBuyEmailInput(ctx contex
 	return it, nil
 }
 
+func (ec *executionContext) unmarshalInputSetLocaleInput(ctx context.Context, obj interface{}) (SetLocaleInput, error) {
+	var it SetLocaleInput
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	for k, v := range asMap {
+		switch k {
+		case "locale":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("locale"))
+			it.Locale, err = ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
 func (ec *executionContext) unmarshalInputSetRoleInput(ctx context.Context, obj interface{}) (SetRoleInput, error) {
 	var it SetRoleInput
 	asMap := map[string]interface{}{}
@@ -13039,6 +17799,45 @@ func (ec *executionContext) unmarshalInputSetRoleInput(ctx context.Context, obj
 	return it, nil
 }
 
+func (ec *executionContext) unmarshalInputSetRolePermissionInput(ctx context.Context, obj interface{}) (SetRolePermissionInput, error) {
+	var it SetRolePermissionInput
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	for k, v := range asMap {
+		switch k {
+		case "roleId":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("roleId"))
+			it.RoleID, err = ec.unmarshalNInt2int(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "permissionId":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("permissionId"))
+			it.PermissionID, err = ec.unmarshalNInt2int(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "activate":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("activate"))
+			it.Activate, err = ec.unmarshalNBoolean2bool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
 func (ec *executionContext) unmarshalInputSignatureInput(ctx context.Context, obj interface{}) (SignatureInput, error) {
 	var it SignatureInput
 	asMap := map[string]interface{}{}
@@ -13078,6 +17877,74 @@ func (ec *executionContext) unmarshalInputSignatureInput(ctx context.Context, ob
 	return it, nil
 }
 
+func (ec *executionContext) unmarshalInputSocialLoginEndInput(ctx context.Context, obj interface{}) (SocialLoginEndInput, error) {
+	var it SocialLoginEndInput
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	for k, v := range asMap {
+		switch k {
+		case "state":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("state"))
+			it.State, err = ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "code":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("code"))
+			it.Code, err = ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputSocialLoginInitializeInput(ctx context.Context, obj interface{}) (SocialLoginInitializeInput, error) {
+	var it SocialLoginInitializeInput
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	for k, v := range asMap {
+		switch k {
+		case "provider":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("provider"))
+			directive0 := func(ctx context.Context) (interface{}, error) { return ec.unmarshalNString2string(ctx, v) }
+			directive1 := func(ctx context.Context) (interface{}, error) {
+				if ec.directives.Lowercase == nil {
+					return nil, errors.New("directive lowercase is not implemented")
+				}
+				return ec.directives.Lowercase(ctx, obj, directive0)
+			}
+
+			tmp, err := directive1(ctx)
+			if err != nil {
+				return it, graphql.ErrorOnPath(ctx, err)
+			}
+			if data, ok := tmp.(string); ok {
+				it.Provider = data
+			} else {
+				err := fmt.Errorf(`unexpected type %T from directive, should be string`, tmp)
+				return it, graphql.ErrorOnPath(ctx, err)
+			}
+		}
+	}
+
+	return it, nil
+}
+
 func (ec *executionContext) unmarshalInputSubscriptionInput(ctx context.Context, obj interface{}) (SubscriptionInput, error) {
 	var it SubscriptionInput
 	asMap := map[string]interface{}{}
@@ -13216,6 +18083,90 @@ func (ec *executionContext) unmarshalInputToggleCategory(ctx context.Context, ob
 	return it, nil
 }
 
+func (ec *executionContext) unmarshalInputUnlinkSocialLoginInput(ctx context.Context, obj interface{}) (UnlinkSocialLoginInput, error) {
+	var it UnlinkSocialLoginInput
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	for k, v := range asMap {
+		switch k {
+		case "provider":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("provider"))
+			directive0 := func(ctx context.Context) (interface{}, error) { return ec.unmarshalNString2string(ctx, v) }
+			directive1 := func(ctx context.Context) (interface{}, error) {
+				if ec.directives.Lowercase == nil {
+					return nil, errors.New("directive lowercase is not implemented")
+				}
+				return ec.directives.Lowercase(ctx, obj, directive0)
+			}
+
+			tmp, err := directive1(ctx)
+			if err != nil {
+				return it, graphql.ErrorOnPath(ctx, err)
+			}
+			if data, ok := tmp.(string); ok {
+				it.Provider = data
+			} else {
+				err := fmt.Errorf(`unexpected type %T from directive, should be string`, tmp)
+				return it, graphql.ErrorOnPath(ctx, err)
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputUnlockLoginInput(ctx context.Context, obj interface{}) (UnlockLoginInput, error) {
+	var it UnlockLoginInput
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	for k, v := range asMap {
+		switch k {
+		case "email":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("email"))
+			directive0 := func(ctx context.Context) (interface{}, error) { return ec.unmarshalOString2ᚖstring(ctx, v) }
+			directive1 := func(ctx context.Context) (interface{}, error) {
+				if ec.directives.Lowercase == nil {
+					return nil, errors.New("directive lowercase is not implemented")
+				}
+				return ec.directives.Lowercase(ctx, obj, directive0)
+			}
+
+			tmp, err := directive1(ctx)
+			if err != nil {
+				return it, graphql.ErrorOnPath(ctx, err)
+			}
+			if data, ok := tmp.(*string); ok {
+				it.Email = data
+			} else if tmp == nil {
+				it.Email = nil
+			} else {
+				err := fmt.Errorf(`unexpected type %T from directive, should be *string`, tmp)
+				return it, graphql.ErrorOnPath(ctx, err)
+			}
+		case "ip":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("ip"))
+			it.IP, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
 func (ec *executionContext) unmarshalInputUnsubscribeInput(ctx context.Context, obj interface{}) (UnsubscribeInput, error) {
 	var it UnsubscribeInput
 	asMap := map[string]interface{}{}
@@ -13497,6 +18448,55 @@ func (ec *executionContext) unmarshalInputUsersOrderBy(ctx context.Context, obj
 
 // region    **************************** object.gotpl ****************************
 
+var aPIKeyImplementors = []string{"APIKey"}
+
+func (ec *executionContext) _APIKey(ctx context.Context, sel ast.SelectionSet, obj *APIKey) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, aPIKeyImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("APIKey")
+		case "id":
+			out.Values[i] = ec._APIKey_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "name":
+			out.Values[i] = ec._APIKey_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "prefix":
+			out.Values[i] = ec._APIKey_prefix(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "scopes":
+			out.Values[i] = ec._APIKey_scopes(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "creationTime":
+			out.Values[i] = ec._APIKey_creationTime(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "lastUsedTime":
+			out.Values[i] = ec._APIKey_lastUsedTime(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
 var addressImplementors = []string{"Address"}
 
 func (ec *executionContext) _Address(ctx context.Context, sel ast.SelectionSet, obj *Address) graphql.Marshaler {
@@ -13977,24 +18977,56 @@ func (ec *executionContext) _Category(ctx context.Context, sel ast.SelectionSet,
 	return out
 }
 
-var createStripeCheckoutResponseImplementors = []string{"CreateStripeCheckoutResponse"}
+var createStripeCheckoutResponseImplementors = []string{"CreateStripeCheckoutResponse"}
+
+func (ec *executionContext) _CreateStripeCheckoutResponse(ctx context.Context, sel ast.SelectionSet, obj *CreateStripeCheckoutResponse) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, createStripeCheckoutResponseImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("CreateStripeCheckoutResponse")
+		case "stripeCheckoutLink":
+			out.Values[i] = ec._CreateStripeCheckoutResponse_stripeCheckoutLink(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "paymentIntentId":
+			out.Values[i] = ec._CreateStripeCheckoutResponse_paymentIntentId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var createdAPIKeyImplementors = []string{"CreatedAPIKey"}
 
-func (ec *executionContext) _CreateStripeCheckoutResponse(ctx context.Context, sel ast.SelectionSet, obj *CreateStripeCheckoutResponse) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, createStripeCheckoutResponseImplementors)
+func (ec *executionContext) _CreatedAPIKey(ctx context.Context, sel ast.SelectionSet, obj *CreatedAPIKey) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, createdAPIKeyImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	var invalids uint32
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("CreateStripeCheckoutResponse")
-		case "stripeCheckoutLink":
-			out.Values[i] = ec._CreateStripeCheckoutResponse_stripeCheckoutLink(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("CreatedAPIKey")
+		case "apiKey":
+			out.Values[i] = ec._CreatedAPIKey_apiKey(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "paymentIntentId":
-			out.Values[i] = ec._CreateStripeCheckoutResponse_paymentIntentId(ctx, field, obj)
+		case "key":
+			out.Values[i] = ec._CreatedAPIKey_key(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
@@ -14084,6 +19116,48 @@ func (ec *executionContext) _DesignerApplication(ctx context.Context, sel ast.Se
 	return out
 }
 
+var emailPreviewImplementors = []string{"EmailPreview"}
+
+func (ec *executionContext) _EmailPreview(ctx context.Context, sel ast.SelectionSet, obj *EmailPreview) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, emailPreviewImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("EmailPreview")
+		case "from":
+			out.Values[i] = ec._EmailPreview_from(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "subject":
+			out.Values[i] = ec._EmailPreview_subject(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "html":
+			out.Values[i] = ec._EmailPreview_html(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "text":
+			out.Values[i] = ec._EmailPreview_text(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
 var fromToImplementors = []string{"FromTo"}
 
 func (ec *executionContext) _FromTo(ctx context.Context, sel ast.SelectionSet, obj *FromTo) graphql.Marshaler {
@@ -14113,6 +19187,60 @@ func (ec *executionContext) _FromTo(ctx context.Context, sel ast.SelectionSet, o
 	return out
 }
 
+var jobImplementors = []string{"Job"}
+
+func (ec *executionContext) _Job(ctx context.Context, sel ast.SelectionSet, obj *Job) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, jobImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Job")
+		case "id":
+			out.Values[i] = ec._Job_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "type":
+			out.Values[i] = ec._Job_type(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "payload":
+			out.Values[i] = ec._Job_payload(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "attempts":
+			out.Values[i] = ec._Job_attempts(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "lastError":
+			out.Values[i] = ec._Job_lastError(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "creationTime":
+			out.Values[i] = ec._Job_creationTime(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "deadTime":
+			out.Values[i] = ec._Job_deadTime(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
 var mutationImplementors = []string{"Mutation"}
 
 func (ec *executionContext) _Mutation(ctx context.Context, sel ast.SelectionSet) graphql.Marshaler {
@@ -14128,22 +19256,49 @@ func (ec *executionContext) _Mutation(ctx context.Context, sel ast.SelectionSet)
 		switch field.Name {
 		case "__typename":
 			out.Values[i] = graphql.MarshalString("Mutation")
+		case "createAPIKey":
+			out.Values[i] = ec._Mutation_createAPIKey(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "revokeAPIKey":
+			out.Values[i] = ec._Mutation_revokeAPIKey(ctx, field)
 		case "login":
 			out.Values[i] = ec._Mutation_login(ctx, field)
 		case "loginBlockchainInitialize":
 			out.Values[i] = ec._Mutation_loginBlockchainInitialize(ctx, field)
 		case "loginBlockchainEnd":
 			out.Values[i] = ec._Mutation_loginBlockchainEnd(ctx, field)
+		case "loginPasskeyInitialize":
+			out.Values[i] = ec._Mutation_loginPasskeyInitialize(ctx, field)
+		case "loginPasskeyEnd":
+			out.Values[i] = ec._Mutation_loginPasskeyEnd(ctx, field)
+		case "socialLoginInitialize":
+			out.Values[i] = ec._Mutation_socialLoginInitialize(ctx, field)
+		case "socialLoginEnd":
+			out.Values[i] = ec._Mutation_socialLoginEnd(ctx, field)
+		case "unlinkSocialLogin":
+			out.Values[i] = ec._Mutation_unlinkSocialLogin(ctx, field)
+		case "socialReauthenticateInitialize":
+			out.Values[i] = ec._Mutation_socialReauthenticateInitialize(ctx, field)
+		case "socialReauthenticateEnd":
+			out.Values[i] = ec._Mutation_socialReauthenticateEnd(ctx, field)
 		case "logout":
 			out.Values[i] = ec._Mutation_logout(ctx, field)
 		case "forgotPasswordInitialize":
 			out.Values[i] = ec._Mutation_forgotPasswordInitialize(ctx, field)
 		case "forgotPasswordEnd":
 			out.Values[i] = ec._Mutation_forgotPasswordEnd(ctx, field)
+		case "unlockLogin":
+			out.Values[i] = ec._Mutation_unlockLogin(ctx, field)
 		case "associateAddressInitialize":
 			out.Values[i] = ec._Mutation_associateAddressInitialize(ctx, field)
 		case "associateAddressEnd":
 			out.Values[i] = ec._Mutation_associateAddressEnd(ctx, field)
+		case "registerPasskeyInitialize":
+			out.Values[i] = ec._Mutation_registerPasskeyInitialize(ctx, field)
+		case "registerPasskeyEnd":
+			out.Values[i] = ec._Mutation_registerPasskeyEnd(ctx, field)
 		case "createTransferAuthorization":
 			out.Values[i] = ec._Mutation_createTransferAuthorization(ctx, field)
 		case "createBatchTransferAuthorization":
@@ -14158,6 +19313,8 @@ func (ec *executionContext) _Mutation(ctx context.Context, sel ast.SelectionSet)
 			out.Values[i] = ec._Mutation_submitDesignerApplication(ctx, field)
 		case "resolveDesignerApplication":
 			out.Values[i] = ec._Mutation_resolveDesignerApplication(ctx, field)
+		case "retryJob":
+			out.Values[i] = ec._Mutation_retryJob(ctx, field)
 		case "toggleCategory":
 			out.Values[i] = ec._Mutation_toggleCategory(ctx, field)
 			if out.Values[i] == graphql.Null {
@@ -14173,6 +19330,22 @@ func (ec *executionContext) _Mutation(ctx context.Context, sel ast.SelectionSet)
 			out.Values[i] = ec._Mutation_updateOmnisendProducts(ctx, field)
 		case "updateOmnisendContacts":
 			out.Values[i] = ec._Mutation_updateOmnisendContacts(ctx, field)
+		case "createRole":
+			out.Values[i] = ec._Mutation_createRole(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "deleteRole":
+			out.Values[i] = ec._Mutation_deleteRole(ctx, field)
+		case "createPermission":
+			out.Values[i] = ec._Mutation_createPermission(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "deletePermission":
+			out.Values[i] = ec._Mutation_deletePermission(ctx, field)
+		case "setRolePermission":
+			out.Values[i] = ec._Mutation_setRolePermission(ctx, field)
 		case "upsertSale":
 			out.Values[i] = ec._Mutation_upsertSale(ctx, field)
 			if out.Values[i] == graphql.Null {
@@ -14221,10 +19394,22 @@ func (ec *executionContext) _Mutation(ctx context.Context, sel ast.SelectionSet)
 			out.Values[i] = ec._Mutation_confirmEmail(ctx, field)
 		case "resendConfirmationEmail":
 			out.Values[i] = ec._Mutation_resendConfirmationEmail(ctx, field)
+		case "requestEmailChange":
+			out.Values[i] = ec._Mutation_requestEmailChange(ctx, field)
+		case "confirmEmailChange":
+			out.Values[i] = ec._Mutation_confirmEmailChange(ctx, field)
+		case "deleteMyAccount":
+			out.Values[i] = ec._Mutation_deleteMyAccount(ctx, field)
+		case "cancelAccountDeletion":
+			out.Values[i] = ec._Mutation_cancelAccountDeletion(ctx, field)
+		case "exportMyData":
+			out.Values[i] = ec._Mutation_exportMyData(ctx, field)
 		case "setRole":
 			out.Values[i] = ec._Mutation_setRole(ctx, field)
 		case "updateProfile":
 			out.Values[i] = ec._Mutation_updateProfile(ctx, field)
+		case "setLocale":
+			out.Values[i] = ec._Mutation_setLocale(ctx, field)
 		case "assignOffChainNfts":
 			out.Values[i] = ec._Mutation_assignOffChainNfts(ctx, field)
 		default:
@@ -14459,6 +19644,42 @@ func (ec *executionContext) _Owner(ctx context.Context, sel ast.SelectionSet, ob
 	return out
 }
 
+var pageInfoImplementors = []string{"PageInfo"}
+
+func (ec *executionContext) _PageInfo(ctx context.Context, sel ast.SelectionSet, obj *PageInfo) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, pageInfoImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("PageInfo")
+		case "hasNextPage":
+			out.Values[i] = ec._PageInfo_hasNextPage(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "hasPreviousPage":
+			out.Values[i] = ec._PageInfo_hasPreviousPage(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "startCursor":
+			out.Values[i] = ec._PageInfo_startCursor(ctx, field, obj)
+		case "endCursor":
+			out.Values[i] = ec._PageInfo_endCursor(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
 var paymentIntentImplementors = []string{"PaymentIntent"}
 
 func (ec *executionContext) _PaymentIntent(ctx context.Context, sel ast.SelectionSet, obj *PaymentIntent) graphql.Marshaler {
@@ -14491,6 +19712,43 @@ func (ec *executionContext) _PaymentIntent(ctx context.Context, sel ast.Selectio
 	return out
 }
 
+var permissionImplementors = []string{"Permission"}
+
+func (ec *executionContext) _Permission(ctx context.Context, sel ast.SelectionSet, obj *Permission) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, permissionImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Permission")
+		case "id":
+			out.Values[i] = ec._Permission_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "name":
+			out.Values[i] = ec._Permission_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "description":
+			out.Values[i] = ec._Permission_description(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
 var profileImplementors = []string{"Profile"}
 
 func (ec *executionContext) _Profile(ctx context.Context, sel ast.SelectionSet, obj *Profile) graphql.Marshaler {
@@ -14532,6 +19790,20 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 		switch field.Name {
 		case "__typename":
 			out.Values[i] = graphql.MarshalString("Query")
+		case "myAPIKeys":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_myAPIKeys(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
 		case "blogPosts":
 			field := field
 			out.Concurrently(i, func() (res graphql.Marshaler) {
@@ -14579,6 +19851,20 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 				res = ec._Query_designerApplications(ctx, field)
 				return res
 			})
+		case "deadJobs":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_deadJobs(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
 		case "sendSuccessfulBuyEmail":
 			field := field
 			out.Concurrently(i, func() (res graphql.Marshaler) {
@@ -14590,6 +19876,34 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 				res = ec._Query_sendSuccessfulBuyEmail(ctx, field)
 				return res
 			})
+		case "emailTemplates":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_emailTemplates(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "previewEmail":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_previewEmail(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
 		case "nfts":
 			field := field
 			out.Concurrently(i, func() (res graphql.Marshaler) {
@@ -14612,6 +19926,20 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 				res = ec._Query_roles(ctx, field)
 				return res
 			})
+		case "permissions":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_permissions(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
 		case "createStripeAccountLink":
 			field := field
 			out.Concurrently(i, func() (res graphql.Marshaler) {
@@ -14673,6 +20001,20 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 				}
 				return res
 			})
+		case "usersConnection":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_usersConnection(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
 		case "offchainNfts":
 			field := field
 			out.Concurrently(i, func() (res graphql.Marshaler) {
@@ -14945,19 +20287,10 @@ func (ec *executionContext) _User(ctx context.Context, sel ast.SelectionSet, obj
 			}
 		case "firstName":
 			out.Values[i] = ec._User_firstName(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
-			}
 		case "lastName":
 			out.Values[i] = ec._User_lastName(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
-			}
 		case "email":
 			out.Values[i] = ec._User_email(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
-			}
 		case "preferredName":
 			out.Values[i] = ec._User_preferredName(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
@@ -15048,12 +20381,76 @@ func (ec *executionContext) _User(ctx context.Context, sel ast.SelectionSet, obj
 		case "hasUploadedOneNft":
 			out.Values[i] = ec._User_hasUploadedOneNft(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "stripeTransferCapabilityStatus":
+			out.Values[i] = ec._User_stripeTransferCapabilityStatus(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var userConnectionImplementors = []string{"UserConnection"}
+
+func (ec *executionContext) _UserConnection(ctx context.Context, sel ast.SelectionSet, obj *UserConnection) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, userConnectionImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("UserConnection")
+		case "edges":
+			out.Values[i] = ec._UserConnection_edges(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "pageInfo":
+			out.Values[i] = ec._UserConnection_pageInfo(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var userEdgeImplementors = []string{"UserEdge"}
+
+func (ec *executionContext) _UserEdge(ctx context.Context, sel ast.SelectionSet, obj *UserEdge) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, userEdgeImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("UserEdge")
+		case "cursor":
+			out.Values[i] = ec._UserEdge_cursor(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
 			}
-		case "stripeTransferCapabilityStatus":
-			out.Values[i] = ec._User_stripeTransferCapabilityStatus(ctx, field, obj)
+		case "node":
+			out.Values[i] = ec._UserEdge_node(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
+				invalids++
 			}
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
@@ -15428,6 +20825,135 @@ func (ec *executionContext) ___Type(ctx context.Context, sel ast.SelectionSet, o
 
 // region    ***************************** type.gotpl *****************************
 
+func (ec *executionContext) marshalNAPIKey2ᚕᚖserverᚋapiᚋgraphqlᚋgraphᚐAPIKeyᚄ(ctx context.Context, sel ast.SelectionSet, v []*APIKey) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNAPIKey2ᚖserverᚋapiᚋgraphqlᚋgraphᚐAPIKey(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNAPIKey2ᚖserverᚋapiᚋgraphqlᚋgraphᚐAPIKey(ctx context.Context, sel ast.SelectionSet, v *APIKey) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._APIKey(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNAPIKeyScope2serverᚋapiᚋgraphqlᚋgraphᚐAPIKeyScope(ctx context.Context, v interface{}) (APIKeyScope, error) {
+	var res APIKeyScope
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNAPIKeyScope2serverᚋapiᚋgraphqlᚋgraphᚐAPIKeyScope(ctx context.Context, sel ast.SelectionSet, v APIKeyScope) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) unmarshalNAPIKeyScope2ᚕserverᚋapiᚋgraphqlᚋgraphᚐAPIKeyScopeᚄ(ctx context.Context, v interface{}) ([]APIKeyScope, error) {
+	var vSlice []interface{}
+	if v != nil {
+		if tmp1, ok := v.([]interface{}); ok {
+			vSlice = tmp1
+		} else {
+			vSlice = []interface{}{v}
+		}
+	}
+	var err error
+	res := make([]APIKeyScope, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNAPIKeyScope2serverᚋapiᚋgraphqlᚋgraphᚐAPIKeyScope(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (ec *executionContext) marshalNAPIKeyScope2ᚕserverᚋapiᚋgraphqlᚋgraphᚐAPIKeyScopeᚄ(ctx context.Context, sel ast.SelectionSet, v []APIKeyScope) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNAPIKeyScope2serverᚋapiᚋgraphqlᚋgraphᚐAPIKeyScope(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
 func (ec *executionContext) marshalNAddress2serverᚋapiᚋgraphqlᚋgraphᚐAddress(ctx context.Context, sel ast.SelectionSet, v Address) graphql.Marshaler {
 	return ec._Address(ctx, sel, &v)
 }
@@ -15618,16 +21144,36 @@ func (ec *executionContext) marshalNCategory2ᚖserverᚋapiᚋgraphqlᚋgraph
 	return ec._Category(ctx, sel, v)
 }
 
+func (ec *executionContext) unmarshalNConfirmEmailChangeInput2serverᚋapiᚋgraphqlᚋgraphᚐConfirmEmailChangeInput(ctx context.Context, v interface{}) (ConfirmEmailChangeInput, error) {
+	res, err := ec.unmarshalInputConfirmEmailChangeInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
 func (ec *executionContext) unmarshalNConfirmEmailInput2serverᚋapiᚋgraphqlᚋgraphᚐConfirmEmailInput(ctx context.Context, v interface{}) (ConfirmEmailInput, error) {
 	res, err := ec.unmarshalInputConfirmEmailInput(ctx, v)
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
+func (ec *executionContext) unmarshalNCreateAPIKeyInput2serverᚋapiᚋgraphqlᚋgraphᚐCreateAPIKeyInput(ctx context.Context, v interface{}) (CreateAPIKeyInput, error) {
+	res, err := ec.unmarshalInputCreateAPIKeyInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
 func (ec *executionContext) unmarshalNCreateIPFSHashInput2serverᚋapiᚋgraphqlᚋgraphᚐCreateIPFSHashInput(ctx context.Context, v interface{}) (CreateIPFSHashInput, error) {
 	res, err := ec.unmarshalInputCreateIPFSHashInput(ctx, v)
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
+func (ec *executionContext) unmarshalNCreatePermissionInput2serverᚋapiᚋgraphqlᚋgraphᚐCreatePermissionInput(ctx context.Context, v interface{}) (CreatePermissionInput, error) {
+	res, err := ec.unmarshalInputCreatePermissionInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalNCreateRoleInput2serverᚋapiᚋgraphqlᚋgraphᚐCreateRoleInput(ctx context.Context, v interface{}) (CreateRoleInput, error) {
+	res, err := ec.unmarshalInputCreateRoleInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
 func (ec *executionContext) marshalNCreateStripeCheckoutResponse2serverᚋapiᚋgraphqlᚋgraphᚐCreateStripeCheckoutResponse(ctx context.Context, sel ast.SelectionSet, v CreateStripeCheckoutResponse) graphql.Marshaler {
 	return ec._CreateStripeCheckoutResponse(ctx, sel, &v)
 }
@@ -15647,6 +21193,20 @@ func (ec *executionContext) unmarshalNCreateUserInput2serverᚋapiᚋgraphqlᚋg
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
+func (ec *executionContext) marshalNCreatedAPIKey2serverᚋapiᚋgraphqlᚋgraphᚐCreatedAPIKey(ctx context.Context, sel ast.SelectionSet, v CreatedAPIKey) graphql.Marshaler {
+	return ec._CreatedAPIKey(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNCreatedAPIKey2ᚖserverᚋapiᚋgraphqlᚋgraphᚐCreatedAPIKey(ctx context.Context, sel ast.SelectionSet, v *CreatedAPIKey) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._CreatedAPIKey(ctx, sel, v)
+}
+
 func (ec *executionContext) marshalNCurrency2ᚖserverᚋapiᚋgraphqlᚋgraphᚐCurrency(ctx context.Context, sel ast.SelectionSet, v *Currency) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
@@ -15657,11 +21217,40 @@ func (ec *executionContext) marshalNCurrency2ᚖserverᚋapiᚋgraphqlᚋgraph
 	return ec._Currency(ctx, sel, v)
 }
 
+func (ec *executionContext) unmarshalNDeleteMyAccountInput2serverᚋapiᚋgraphqlᚋgraphᚐDeleteMyAccountInput(ctx context.Context, v interface{}) (DeleteMyAccountInput, error) {
+	res, err := ec.unmarshalInputDeleteMyAccountInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalNDeletePermissionInput2serverᚋapiᚋgraphqlᚋgraphᚐDeletePermissionInput(ctx context.Context, v interface{}) (DeletePermissionInput, error) {
+	res, err := ec.unmarshalInputDeletePermissionInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalNDeleteRoleInput2serverᚋapiᚋgraphqlᚋgraphᚐDeleteRoleInput(ctx context.Context, v interface{}) (DeleteRoleInput, error) {
+	res, err := ec.unmarshalInputDeleteRoleInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
 func (ec *executionContext) unmarshalNDesignerApplicationsFilter2serverᚋapiᚋgraphqlᚋgraphᚐDesignerApplicationsFilter(ctx context.Context, v interface{}) (DesignerApplicationsFilter, error) {
 	res, err := ec.unmarshalInputDesignerApplicationsFilter(ctx, v)
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
+func (ec *executionContext) marshalNEmailPreview2serverᚋapiᚋgraphqlᚋgraphᚐEmailPreview(ctx context.Context, sel ast.SelectionSet, v EmailPreview) graphql.Marshaler {
+	return ec._EmailPreview(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNEmailPreview2ᚖserverᚋapiᚋgraphqlᚋgraphᚐEmailPreview(ctx context.Context, sel ast.SelectionSet, v *EmailPreview) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._EmailPreview(ctx, sel, v)
+}
+
 func (ec *executionContext) unmarshalNEventType2serverᚋapiᚋgraphqlᚋgraphᚐEventType(ctx context.Context, v interface{}) (EventType, error) {
 	var res EventType
 	err := res.UnmarshalGQL(v)
@@ -15672,6 +21261,11 @@ func (ec *executionContext) marshalNEventType2serverᚋapiᚋgraphqlᚋgraphᚐE
 	return v
 }
 
+func (ec *executionContext) unmarshalNExportMyDataInput2serverᚋapiᚋgraphqlᚋgraphᚐExportMyDataInput(ctx context.Context, v interface{}) (ExportMyDataInput, error) {
+	res, err := ec.unmarshalInputExportMyDataInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
 func (ec *executionContext) unmarshalNFloat2float64(ctx context.Context, v interface{}) (float64, error) {
 	res, err := graphql.UnmarshalFloat(v)
 	return res, graphql.ErrorOnPath(ctx, err)
@@ -15684,47 +21278,111 @@ func (ec *executionContext) marshalNFloat2float64(ctx context.Context, sel ast.S
 			ec.Errorf(ctx, "must not be null")
 		}
 	}
-	return res
-}
+	return res
+}
+
+func (ec *executionContext) marshalNFromTo2ᚖserverᚋapiᚋgraphqlᚋgraphᚐFromTo(ctx context.Context, sel ast.SelectionSet, v *FromTo) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._FromTo(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNID2string(ctx context.Context, v interface{}) (string, error) {
+	res, err := graphql.UnmarshalID(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNID2string(ctx context.Context, sel ast.SelectionSet, v string) graphql.Marshaler {
+	res := graphql.MarshalID(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+	}
+	return res
+}
+
+func (ec *executionContext) unmarshalNInt2int(ctx context.Context, v interface{}) (int, error) {
+	res, err := graphql.UnmarshalInt(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNInt2int(ctx context.Context, sel ast.SelectionSet, v int) graphql.Marshaler {
+	res := graphql.MarshalInt(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+	}
+	return res
+}
+
+func (ec *executionContext) marshalNJob2ᚕᚖserverᚋapiᚋgraphqlᚋgraphᚐJobᚄ(ctx context.Context, sel ast.SelectionSet, v []*Job) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNJob2ᚖserverᚋapiᚋgraphqlᚋgraphᚐJob(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
 
-func (ec *executionContext) marshalNFromTo2ᚖserverᚋapiᚋgraphqlᚋgraphᚐFromTo(ctx context.Context, sel ast.SelectionSet, v *FromTo) graphql.Marshaler {
-	if v == nil {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			ec.Errorf(ctx, "must not be null")
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
 		}
-		return graphql.Null
 	}
-	return ec._FromTo(ctx, sel, v)
-}
 
-func (ec *executionContext) unmarshalNID2string(ctx context.Context, v interface{}) (string, error) {
-	res, err := graphql.UnmarshalID(v)
-	return res, graphql.ErrorOnPath(ctx, err)
+	return ret
 }
 
-func (ec *executionContext) marshalNID2string(ctx context.Context, sel ast.SelectionSet, v string) graphql.Marshaler {
-	res := graphql.MarshalID(v)
-	if res == graphql.Null {
+func (ec *executionContext) marshalNJob2ᚖserverᚋapiᚋgraphqlᚋgraphᚐJob(ctx context.Context, sel ast.SelectionSet, v *Job) graphql.Marshaler {
+	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "must not be null")
 		}
+		return graphql.Null
 	}
-	return res
+	return ec._Job(ctx, sel, v)
 }
 
-func (ec *executionContext) unmarshalNInt2int(ctx context.Context, v interface{}) (int, error) {
-	res, err := graphql.UnmarshalInt(v)
+func (ec *executionContext) unmarshalNLoginBlockchainInitializeInput2serverᚋapiᚋgraphqlᚋgraphᚐLoginBlockchainInitializeInput(ctx context.Context, v interface{}) (LoginBlockchainInitializeInput, error) {
+	res, err := ec.unmarshalInputLoginBlockchainInitializeInput(ctx, v)
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalNInt2int(ctx context.Context, sel ast.SelectionSet, v int) graphql.Marshaler {
-	res := graphql.MarshalInt(v)
-	if res == graphql.Null {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			ec.Errorf(ctx, "must not be null")
-		}
-	}
-	return res
+func (ec *executionContext) unmarshalNLoginPasskeyEndInput2serverᚋapiᚋgraphqlᚋgraphᚐLoginPasskeyEndInput(ctx context.Context, v interface{}) (LoginPasskeyEndInput, error) {
+	res, err := ec.unmarshalInputLoginPasskeyEndInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
 }
 
 func (ec *executionContext) marshalNNft2serverᚋapiᚋgraphqlᚋgraphᚐNft(ctx context.Context, sel ast.SelectionSet, v Nft) graphql.Marshaler {
@@ -15776,6 +21434,16 @@ func (ec *executionContext) marshalNPROTECTED_RULE2serverᚋapiᚋgraphqlᚋgrap
 	return v
 }
 
+func (ec *executionContext) marshalNPageInfo2ᚖserverᚋapiᚋgraphqlᚋgraphᚐPageInfo(ctx context.Context, sel ast.SelectionSet, v *PageInfo) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._PageInfo(ctx, sel, v)
+}
+
 func (ec *executionContext) marshalNPaymentIntent2serverᚋapiᚋgraphqlᚋgraphᚐPaymentIntent(ctx context.Context, sel ast.SelectionSet, v PaymentIntent) graphql.Marshaler {
 	return ec._PaymentIntent(ctx, sel, &v)
 }
@@ -15790,6 +21458,69 @@ func (ec *executionContext) marshalNPaymentIntent2ᚖserverᚋapiᚋgraphqlᚋgr
 	return ec._PaymentIntent(ctx, sel, v)
 }
 
+func (ec *executionContext) marshalNPermission2serverᚋapiᚋgraphqlᚋgraphᚐPermission(ctx context.Context, sel ast.SelectionSet, v Permission) graphql.Marshaler {
+	return ec._Permission(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNPermission2ᚕᚖserverᚋapiᚋgraphqlᚋgraphᚐPermissionᚄ(ctx context.Context, sel ast.SelectionSet, v []*Permission) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNPermission2ᚖserverᚋapiᚋgraphqlᚋgraphᚐPermission(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNPermission2ᚖserverᚋapiᚋgraphqlᚋgraphᚐPermission(ctx context.Context, sel ast.SelectionSet, v *Permission) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._Permission(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNPreviewEmailInput2serverᚋapiᚋgraphqlᚋgraphᚐPreviewEmailInput(ctx context.Context, v interface{}) (PreviewEmailInput, error) {
+	res, err := ec.unmarshalInputPreviewEmailInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
 func (ec *executionContext) marshalNProfile2serverᚋapiᚋgraphqlᚋgraphᚐProfile(ctx context.Context, sel ast.SelectionSet, v Profile) graphql.Marshaler {
 	return ec._Profile(ctx, sel, &v)
 }
@@ -15814,6 +21545,30 @@ func (ec *executionContext) marshalNRULE2serverᚋapiᚋgraphqlᚋgraphᚐRule(c
 	return v
 }
 
+func (ec *executionContext) unmarshalNRegisterPasskeyEndInput2serverᚋapiᚋgraphqlᚋgraphᚐRegisterPasskeyEndInput(ctx context.Context, v interface{}) (RegisterPasskeyEndInput, error) {
+	res, err := ec.unmarshalInputRegisterPasskeyEndInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalNRequestEmailChangeInput2serverᚋapiᚋgraphqlᚋgraphᚐRequestEmailChangeInput(ctx context.Context, v interface{}) (RequestEmailChangeInput, error) {
+	res, err := ec.unmarshalInputRequestEmailChangeInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalNRetryJobInput2serverᚋapiᚋgraphqlᚋgraphᚐRetryJobInput(ctx context.Context, v interface{}) (RetryJobInput, error) {
+	res, err := ec.unmarshalInputRetryJobInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalNRevokeAPIKeyInput2serverᚋapiᚋgraphqlᚋgraphᚐRevokeAPIKeyInput(ctx context.Context, v interface{}) (RevokeAPIKeyInput, error) {
+	res, err := ec.unmarshalInputRevokeAPIKeyInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNRole2serverᚋapiᚋgraphqlᚋgraphᚐRole(ctx context.Context, sel ast.SelectionSet, v Role) graphql.Marshaler {
+	return ec._Role(ctx, sel, &v)
+}
+
 func (ec *executionContext) marshalNRole2ᚖserverᚋapiᚋgraphqlᚋgraphᚐRole(ctx context.Context, sel ast.SelectionSet, v *Role) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
@@ -15844,6 +21599,16 @@ func (ec *executionContext) unmarshalNSendSuccessfulBuyEmailInput2serverᚋapi
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
+func (ec *executionContext) unmarshalNSetLocaleInput2serverᚋapiᚋgraphqlᚋgraphᚐSetLocaleInput(ctx context.Context, v interface{}) (SetLocaleInput, error) {
+	res, err := ec.unmarshalInputSetLocaleInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalNSetRolePermissionInput2serverᚋapiᚋgraphqlᚋgraphᚐSetRolePermissionInput(ctx context.Context, v interface{}) (SetRolePermissionInput, error) {
+	res, err := ec.unmarshalInputSetRolePermissionInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
 func (ec *executionContext) marshalNSignature2ᚖserverᚋapiᚋgraphqlᚋgraphᚐSignature(ctx context.Context, sel ast.SelectionSet, v *Signature) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
@@ -15854,6 +21619,16 @@ func (ec *executionContext) marshalNSignature2ᚖserverᚋapiᚋgraphqlᚋgraph
 	return ec._Signature(ctx, sel, v)
 }
 
+func (ec *executionContext) unmarshalNSocialLoginEndInput2serverᚋapiᚋgraphqlᚋgraphᚐSocialLoginEndInput(ctx context.Context, v interface{}) (SocialLoginEndInput, error) {
+	res, err := ec.unmarshalInputSocialLoginEndInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalNSocialLoginInitializeInput2serverᚋapiᚋgraphqlᚋgraphᚐSocialLoginInitializeInput(ctx context.Context, v interface{}) (SocialLoginInitializeInput, error) {
+	res, err := ec.unmarshalInputSocialLoginInitializeInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
 func (ec *executionContext) unmarshalNString2string(ctx context.Context, v interface{}) (string, error) {
 	res, err := graphql.UnmarshalString(v)
 	return res, graphql.ErrorOnPath(ctx, err)
@@ -15869,6 +21644,42 @@ func (ec *executionContext) marshalNString2string(ctx context.Context, sel ast.S
 	return res
 }
 
+func (ec *executionContext) unmarshalNString2ᚕstringᚄ(ctx context.Context, v interface{}) ([]string, error) {
+	var vSlice []interface{}
+	if v != nil {
+		if tmp1, ok := v.([]interface{}); ok {
+			vSlice = tmp1
+		} else {
+			vSlice = []interface{}{v}
+		}
+	}
+	var err error
+	res := make([]string, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNString2string(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (ec *executionContext) marshalNString2ᚕstringᚄ(ctx context.Context, sel ast.SelectionSet, v []string) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	for i := range v {
+		ret[i] = ec.marshalNString2string(ctx, sel, v[i])
+	}
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
 func (ec *executionContext) unmarshalNSubscriptionsFilter2serverᚋapiᚋgraphqlᚋgraphᚐSubscriptionsFilter(ctx context.Context, v interface{}) (SubscriptionsFilter, error) {
 	res, err := ec.unmarshalInputSubscriptionsFilter(ctx, v)
 	return res, graphql.ErrorOnPath(ctx, err)
@@ -15904,6 +21715,16 @@ func (ec *executionContext) marshalNTransfer2ᚖserverᚋapiᚋgraphqlᚋgraph
 	return ec._Transfer(ctx, sel, v)
 }
 
+func (ec *executionContext) unmarshalNUnlinkSocialLoginInput2serverᚋapiᚋgraphqlᚋgraphᚐUnlinkSocialLoginInput(ctx context.Context, v interface{}) (UnlinkSocialLoginInput, error) {
+	res, err := ec.unmarshalInputUnlinkSocialLoginInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalNUnlockLoginInput2serverᚋapiᚋgraphqlᚋgraphᚐUnlockLoginInput(ctx context.Context, v interface{}) (UnlockLoginInput, error) {
+	res, err := ec.unmarshalInputUnlockLoginInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
 func (ec *executionContext) unmarshalNUpload2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚐUpload(ctx context.Context, v interface{}) (graphql.Upload, error) {
 	res, err := graphql.UnmarshalUpload(v)
 	return res, graphql.ErrorOnPath(ctx, err)
@@ -15938,6 +21759,74 @@ func (ec *executionContext) marshalNUser2ᚖserverᚋapiᚋgraphqlᚋgraphᚐUse
 	return ec._User(ctx, sel, v)
 }
 
+func (ec *executionContext) marshalNUserConnection2serverᚋapiᚋgraphqlᚋgraphᚐUserConnection(ctx context.Context, sel ast.SelectionSet, v UserConnection) graphql.Marshaler {
+	return ec._UserConnection(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNUserConnection2ᚖserverᚋapiᚋgraphqlᚋgraphᚐUserConnection(ctx context.Context, sel ast.SelectionSet, v *UserConnection) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._UserConnection(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNUserEdge2ᚕᚖserverᚋapiᚋgraphqlᚋgraphᚐUserEdgeᚄ(ctx context.Context, sel ast.SelectionSet, v []*UserEdge) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNUserEdge2ᚖserverᚋapiᚋgraphqlᚋgraphᚐUserEdge(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNUserEdge2ᚖserverᚋapiᚋgraphqlᚋgraphᚐUserEdge(ctx context.Context, sel ast.SelectionSet, v *UserEdge) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._UserEdge(ctx, sel, v)
+}
+
 func (ec *executionContext) marshalNUserHasNfts2ᚖserverᚋapiᚋgraphqlᚋgraphᚐUserHasNfts(ctx context.Context, sel ast.SelectionSet, v *UserHasNfts) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
@@ -17004,6 +22893,14 @@ func (ec *executionContext) unmarshalOPagination2ᚖserverᚋapiᚋgraphqlᚋgra
 	return &res, graphql.ErrorOnPath(ctx, err)
 }
 
+func (ec *executionContext) unmarshalOPermissionsFilter2ᚖserverᚋapiᚋgraphqlᚋgraphᚐPermissionsFilter(ctx context.Context, v interface{}) (*PermissionsFilter, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := ec.unmarshalInputPermissionsFilter(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
 func (ec *executionContext) unmarshalOProfileInput2ᚖserverᚋapiᚋgraphqlᚋgraphᚐProfileInput(ctx context.Context, v interface{}) (*ProfileInput, error) {
 	if v == nil {
 		return nil, nil
@@ -17083,6 +22980,22 @@ func (ec *executionContext) marshalORULE2ᚕserverᚋapiᚋgraphqlᚋgraphᚐRul
 	return ret
 }
 
+func (ec *executionContext) unmarshalORULE_MATCH2ᚖserverᚋapiᚋgraphqlᚋgraphᚐRuleMatch(ctx context.Context, v interface{}) (*RuleMatch, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var res = new(RuleMatch)
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalORULE_MATCH2ᚖserverᚋapiᚋgraphqlᚋgraphᚐRuleMatch(ctx context.Context, sel ast.SelectionSet, v *RuleMatch) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return v
+}
+
 func (ec *executionContext) unmarshalOResendConfirmationEmailInput2ᚖserverᚋapiᚋgraphqlᚋgraphᚐResendConfirmationEmailInput(ctx context.Context, v interface{}) (*ResendConfirmationEmailInput, error) {
 	if v == nil {
 		return nil, nil
@@ -17267,6 +23180,21 @@ func (ec *executionContext) unmarshalOSubscriptionsUserFilter2ᚖserverᚋapiᚋ
 	return &res, graphql.ErrorOnPath(ctx, err)
 }
 
+func (ec *executionContext) unmarshalOTime2ᚖtimeᚐTime(ctx context.Context, v interface{}) (*time.Time, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := graphql.UnmarshalTime(v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOTime2ᚖtimeᚐTime(ctx context.Context, sel ast.SelectionSet, v *time.Time) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return graphql.MarshalTime(*v)
+}
+
 func (ec *executionContext) marshalOTransfer2ᚕᚖserverᚋapiᚋgraphqlᚋgraphᚐTransferᚄ(ctx context.Context, sel ast.SelectionSet, v []*Transfer) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
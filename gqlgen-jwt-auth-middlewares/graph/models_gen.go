@@ -11,6 +11,16 @@ import (
 	"github.com/99designs/gqlgen/graphql"
 )
 
+type APIKey struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+	//  Start of the key to tell keys apart, the key itself is only returned at creation
+	Prefix       string        `json:"prefix"`
+	Scopes       []APIKeyScope `json:"scopes"`
+	CreationTime time.Time     `json:"creationTime"`
+	LastUsedTime *time.Time    `json:"lastUsedTime"`
+}
+
 type Address struct {
 	CountryCode string `json:"countryCode"`
 	Country     string `json:"country"`
@@ -129,10 +139,19 @@ type Category struct {
 	Name string `json:"name"`
 }
 
+type ConfirmEmailChangeInput struct {
+	Key string `json:"key"`
+}
+
 type ConfirmEmailInput struct {
 	Key string `json:"key"`
 }
 
+type CreateAPIKeyInput struct {
+	Name   string        `json:"name"`
+	Scopes []APIKeyScope `json:"scopes"`
+}
+
 type CreateBatchTransferAuthorizationInput struct {
 	Ids     []int `json:"ids"`
 	Amounts []int `json:"amounts"`
@@ -173,6 +192,16 @@ type CreatePaymentIntentInput struct {
 	Amount int `json:"amount"`
 }
 
+type CreatePermissionInput struct {
+	//  resource:action
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+type CreateRoleInput struct {
+	Name string `json:"name"`
+}
+
 type CreateStripeAccountLinkInput struct {
 	ReturnURL  string `json:"returnUrl"`
 	RefreshURL string `json:"refreshUrl"`
@@ -204,6 +233,14 @@ type CreateUserInput struct {
 	ApplyAsDesigner bool   `json:"applyAsDesigner"`
 	//  ISO 3166-1 alpha-2
 	Country string `json:"country"`
+	//  Language of the emails, the one of the country is used when it's not set
+	Locale *string `json:"locale"`
+}
+
+type CreatedAPIKey struct {
+	APIKey *APIKey `json:"apiKey"`
+	//  Sent as Authorization: Bearer <key>, it can't be retrieved again
+	Key string `json:"key"`
 }
 
 type Currency struct {
@@ -215,6 +252,21 @@ type DeleteBlogPostInput struct {
 	ID int `json:"id"`
 }
 
+type DeleteMyAccountInput struct {
+	//  Required unless reauthenticationKey is set
+	Password *string `json:"password"`
+	//  Returned by socialReauthenticateEnd, for users that have no password
+	ReauthenticationKey *string `json:"reauthenticationKey"`
+}
+
+type DeletePermissionInput struct {
+	ID int `json:"id"`
+}
+
+type DeleteRoleInput struct {
+	ID int `json:"id"`
+}
+
 type DesignedFieldFilter struct {
 	OnSale *bool `json:"onSale"`
 }
@@ -244,6 +296,20 @@ type DesignerApplicationsUsersFilter struct {
 	Email *string `json:"email"`
 }
 
+type EmailPreview struct {
+	From    string `json:"from"`
+	Subject string `json:"subject"`
+	HTML    string `json:"html"`
+	Text    string `json:"text"`
+}
+
+type ExportMyDataInput struct {
+	//  Required unless reauthenticationKey is set
+	Password *string `json:"password"`
+	//  Returned by socialReauthenticateEnd, for users that have no password
+	ReauthenticationKey *string `json:"reauthenticationKey"`
+}
+
 type ForgotPasswordEnd struct {
 	NewPassword string `json:"newPassword"`
 	Key         string `json:"key"`
@@ -268,16 +334,36 @@ type InitialCategoryInput struct {
 	CategoryID int `json:"categoryId"`
 }
 
+type Job struct {
+	ID           int        `json:"id"`
+	Type         string     `json:"type"`
+	Payload      string     `json:"payload"`
+	Attempts     int        `json:"attempts"`
+	LastError    string     `json:"lastError"`
+	CreationTime time.Time  `json:"creationTime"`
+	DeadTime     *time.Time `json:"deadTime"`
+}
+
 type LoginBlockchainEndInput struct {
+	//  The exact message returned by loginBlockchainInitialize
 	Message       string `json:"message"`
 	SignedMessage string `json:"signedMessage"`
 }
 
+type LoginBlockchainInitializeInput struct {
+	Address string `json:"address"`
+}
+
 type LoginInput struct {
 	Email    string `json:"email"`
 	Password string `json:"password"`
 }
 
+type LoginPasskeyEndInput struct {
+	//  JSON encoded PublicKeyCredential returned by navigator.credentials.get
+	Credential string `json:"credential"`
+}
+
 type Nft struct {
 	ID            int          `json:"id"`
 	TotalSupply   int          `json:"totalSupply"`
@@ -324,6 +410,14 @@ type Owner struct {
 	Amount    int      `json:"amount"`
 }
 
+// Relay page info of a connection, the cursors are null on an empty page
+type PageInfo struct {
+	HasNextPage     bool    `json:"hasNextPage"`
+	HasPreviousPage bool    `json:"hasPreviousPage"`
+	StartCursor     *string `json:"startCursor"`
+	EndCursor       *string `json:"endCursor"`
+}
+
 type Pagination struct {
 	Limit int `json:"limit"`
 	Page  int `json:"page"`
@@ -334,6 +428,23 @@ type PaymentIntent struct {
 	ClientSecret string `json:"clientSecret"`
 }
 
+// Named resource:action, granted to users through their roles
+type Permission struct {
+	ID          int    `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+type PermissionsFilter struct {
+	RoleID *int `json:"roleId"`
+}
+
+type PreviewEmailInput struct {
+	Template string `json:"template"`
+	//  Falls back to the default locale if the template isn't translated
+	Locale *string `json:"locale"`
+}
+
 type Profile struct {
 	Image       *string `json:"image"`
 	Description *string `json:"description"`
@@ -344,6 +455,19 @@ type ProfileInput struct {
 	Description *string         `json:"description"`
 }
 
+type RegisterPasskeyEndInput struct {
+	//  JSON encoded PublicKeyCredential returned by navigator.credentials.create
+	Credential string `json:"credential"`
+}
+
+type RequestEmailChangeInput struct {
+	NewEmail string `json:"newEmail"`
+	//  Required unless reauthenticationKey is set
+	Password *string `json:"password"`
+	//  Returned by socialReauthenticateEnd, for users that have no password
+	ReauthenticationKey *string `json:"reauthenticationKey"`
+}
+
 type ResendConfirmationEmailInput struct {
 	Email *string `json:"email"`
 }
@@ -358,6 +482,14 @@ type Response struct {
 	Message *string `json:"message"`
 }
 
+type RetryJobInput struct {
+	ID int `json:"id"`
+}
+
+type RevokeAPIKeyInput struct {
+	ID int `json:"id"`
+}
+
 type Role struct {
 	ID    int     `json:"id"`
 	Name  string  `json:"name"`
@@ -414,12 +546,22 @@ type SendSuccessfulBuyEmailInput struct {
 	TxHash string `json:"txHash"`
 }
 
+type SetLocaleInput struct {
+	Locale string `json:"locale"`
+}
+
 type SetRoleInput struct {
 	UserID   int  `json:"userId"`
 	RoleID   int  `json:"roleId"`
 	Activate bool `json:"activate"`
 }
 
+type SetRolePermissionInput struct {
+	RoleID       int  `json:"roleId"`
+	PermissionID int  `json:"permissionId"`
+	Activate     bool `json:"activate"`
+}
+
 type Signature struct {
 	R string `json:"r"`
 	S string `json:"s"`
@@ -432,6 +574,17 @@ type SignatureInput struct {
 	V string `json:"v"`
 }
 
+type SocialLoginEndInput struct {
+	//  state and code query parameters the provider redirected back with
+	State string `json:"state"`
+	Code  string `json:"code"`
+}
+
+type SocialLoginInitializeInput struct {
+	//  Name of a configured provider, e.g. google or github
+	Provider string `json:"provider"`
+}
+
 type SubscriptionInput struct {
 	Email              string `json:"email"`
 	SubscriptionTypeID int    `json:"subscriptionTypeId"`
@@ -461,6 +614,15 @@ type Transfer struct {
 	Nft    *Nft    `json:"nft"`
 }
 
+type UnlinkSocialLoginInput struct {
+	Provider string `json:"provider"`
+}
+
+type UnlockLoginInput struct {
+	Email *string `json:"email"`
+	IP    *string `json:"ip"`
+}
+
 type UnsubscribeInput struct {
 	Email              string `json:"email"`
 	SubscriptionTypeID int    `json:"subscriptionTypeId"`
@@ -478,10 +640,11 @@ type UpsertSaleInput struct {
 }
 
 type User struct {
-	ID            int            `json:"id"`
-	FirstName     string         `json:"firstName"`
-	LastName      string         `json:"lastName"`
-	Email         string         `json:"email"`
+	ID int `json:"id"`
+	//  Personal data, null with an error for other users than admins and the user themselves
+	FirstName     *string        `json:"firstName"`
+	LastName      *string        `json:"lastName"`
+	Email         *string        `json:"email"`
 	PreferredName string         `json:"preferredName"`
 	Addresses     []string       `json:"addresses"`
 	Owned         []*UserHasNfts `json:"owned"`
@@ -496,6 +659,16 @@ type User struct {
 	StripeTransferCapabilityStatus string `json:"stripeTransferCapabilityStatus"`
 }
 
+type UserConnection struct {
+	Edges    []*UserEdge `json:"edges"`
+	PageInfo *PageInfo   `json:"pageInfo"`
+}
+
+type UserEdge struct {
+	Cursor string `json:"cursor"`
+	Node   *User  `json:"node"`
+}
+
 type UserHasNfts struct {
 	Address  string `json:"address"`
 	Nft      *Nft   `json:"nft"`
@@ -533,6 +706,48 @@ type UsersResult struct {
 	Count int     `json:"count"`
 }
 
+// Mutations require the WRITE scope, queries the READ one
+type APIKeyScope string
+
+const (
+	APIKeyScopeRead  APIKeyScope = "READ"
+	APIKeyScopeWrite APIKeyScope = "WRITE"
+)
+
+var AllAPIKeyScope = []APIKeyScope{
+	APIKeyScopeRead,
+	APIKeyScopeWrite,
+}
+
+func (e APIKeyScope) IsValid() bool {
+	switch e {
+	case APIKeyScopeRead, APIKeyScopeWrite:
+		return true
+	}
+	return false
+}
+
+func (e APIKeyScope) String() string {
+	return string(e)
+}
+
+func (e *APIKeyScope) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = APIKeyScope(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid APIKeyScope", str)
+	}
+	return nil
+}
+
+func (e APIKeyScope) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type EventType string
 
 const (
@@ -630,16 +845,20 @@ func (e OrderDirection) MarshalGQL(w io.Writer) {
 type ProtectedRule string
 
 const (
+	//  The logged user is an admin
 	ProtectedRuleAdmin ProtectedRule = "ADMIN"
+	//  The parent is the User of the logged user
+	ProtectedRuleSelf ProtectedRule = "SELF"
 )
 
 var AllProtectedRule = []ProtectedRule{
 	ProtectedRuleAdmin,
+	ProtectedRuleSelf,
 }
 
 func (e ProtectedRule) IsValid() bool {
 	switch e {
-	case ProtectedRuleAdmin:
+	case ProtectedRuleAdmin, ProtectedRuleSelf:
 		return true
 	}
 	return false
@@ -666,14 +885,20 @@ func (e ProtectedRule) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+// Role rules pass for the users with the role, the other ones for the users owning the resource whose ID is in the
+// arguments of the field, see directives.OwnershipRule
 type Rule string
 
 const (
 	RuleAdminRole    Rule = "ADMIN_ROLE"
 	RuleDesignerRole Rule = "DESIGNER_ROLE"
 	RuleUserRole     Rule = "USER_ROLE"
-	RuleDesignerOf   Rule = "DESIGNER_OF"
-	RuleOwnerOf      Rule = "OWNER_OF"
+	//  Designed the NFT of input.nftId
+	RuleDesignerOf Rule = "DESIGNER_OF"
+	//  Holds the NFT of input.nftId, on-chain or off-chain
+	RuleOwnerOf Rule = "OWNER_OF"
+	//  Submitted the designer application of filter.user.id
+	RuleApplicantOf Rule = "APPLICANT_OF"
 )
 
 var AllRule = []Rule{
@@ -682,11 +907,12 @@ var AllRule = []Rule{
 	RuleUserRole,
 	RuleDesignerOf,
 	RuleOwnerOf,
+	RuleApplicantOf,
 }
 
 func (e Rule) IsValid() bool {
 	switch e {
-	case RuleAdminRole, RuleDesignerRole, RuleUserRole, RuleDesignerOf, RuleOwnerOf:
+	case RuleAdminRole, RuleDesignerRole, RuleUserRole, RuleDesignerOf, RuleOwnerOf, RuleApplicantOf:
 		return true
 	}
 	return false
@@ -712,3 +938,45 @@ func (e *Rule) UnmarshalGQL(v interface{}) error {
 func (e Rule) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
+
+// How the rules of @authenticate are composed, every rule has to pass by default
+type RuleMatch string
+
+const (
+	RuleMatchAll RuleMatch = "ALL"
+	RuleMatchAny RuleMatch = "ANY"
+)
+
+var AllRuleMatch = []RuleMatch{
+	RuleMatchAll,
+	RuleMatchAny,
+}
+
+func (e RuleMatch) IsValid() bool {
+	switch e {
+	case RuleMatchAll, RuleMatchAny:
+		return true
+	}
+	return false
+}
+
+func (e RuleMatch) String() string {
+	return string(e)
+}
+
+func (e *RuleMatch) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = RuleMatch(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid RULE_MATCH", str)
+	}
+	return nil
+}
+
+func (e RuleMatch) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
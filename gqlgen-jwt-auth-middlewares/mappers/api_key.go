@@ -0,0 +1,30 @@
+package mappers
+
+import (
+	"server/api/graphql/graph"
+	"server/internal/user"
+)
+
+func APIKeyToGraph(apiKeyDB *user.APIKey) *graph.APIKey {
+	scopes := make([]graph.APIKeyScope, 0)
+	for _, scope := range apiKeyDB.ScopeList() {
+		scopes = append(scopes, graph.APIKeyScope(scope))
+	}
+
+	return &graph.APIKey{
+		ID:           apiKeyDB.ID,
+		Name:         apiKeyDB.Name,
+		Prefix:       apiKeyDB.Prefix,
+		Scopes:       scopes,
+		CreationTime: apiKeyDB.CreationTime,
+		LastUsedTime: apiKeyDB.LastUsedTime,
+	}
+}
+
+func APIKeysToGraph(apiKeysDB []*user.APIKey) []*graph.APIKey {
+	apiKeys := make([]*graph.APIKey, len(apiKeysDB))
+	for i, apiKeyDB := range apiKeysDB {
+		apiKeys[i] = APIKeyToGraph(apiKeyDB)
+	}
+	return apiKeys
+}
@@ -0,0 +1,26 @@
+package mappers
+
+import (
+	"server/api/graphql/graph"
+	"server/internal/outbox"
+)
+
+func JobToGraph(jobDB *outbox.Job) *graph.Job {
+	return &graph.Job{
+		ID:           jobDB.ID,
+		Type:         jobDB.Type,
+		Payload:      jobDB.Payload,
+		Attempts:     jobDB.Attempts,
+		LastError:    jobDB.LastError,
+		CreationTime: jobDB.CreationTime,
+		DeadTime:     jobDB.DeadTime,
+	}
+}
+
+func JobsToGraph(jobsDB []*outbox.Job) []*graph.Job {
+	jobs := make([]*graph.Job, len(jobsDB))
+	for i, jobDB := range jobsDB {
+		jobs[i] = JobToGraph(jobDB)
+	}
+	return jobs
+}
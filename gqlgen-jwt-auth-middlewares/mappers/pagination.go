@@ -0,0 +1,24 @@
+package mappers
+
+import (
+	"server/api/graphql/graph"
+	"server/internal/pagination"
+)
+
+func PaginationArgs(first *int, after *string, last *int, before *string) pagination.Args {
+	return pagination.Args{
+		First:  first,
+		After:  after,
+		Last:   last,
+		Before: before,
+	}
+}
+
+func PageInfoToGraph(page *pagination.Page) *graph.PageInfo {
+	return &graph.PageInfo{
+		HasNextPage:     page.HasNextPage,
+		HasPreviousPage: page.HasPreviousPage,
+		StartCursor:     page.StartCursor(),
+		EndCursor:       page.EndCursor(),
+	}
+}
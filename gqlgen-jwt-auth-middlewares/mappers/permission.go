@@ -0,0 +1,22 @@
+package mappers
+
+import (
+	"server/api/graphql/graph"
+	"server/internal/user"
+)
+
+func PermissionToGraph(permissionDB *user.Permission) *graph.Permission {
+	return &graph.Permission{
+		ID:          permissionDB.ID,
+		Name:        permissionDB.Name,
+		Description: permissionDB.Description,
+	}
+}
+
+func PermissionsToGraph(permissionsDB []*user.Permission) []*graph.Permission {
+	permissions := make([]*graph.Permission, len(permissionsDB))
+	for i, permissionDB := range permissionsDB {
+		permissions[i] = PermissionToGraph(permissionDB)
+	}
+	return permissions
+}
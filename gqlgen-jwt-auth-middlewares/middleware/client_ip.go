@@ -0,0 +1,142 @@
+package middleware
+
+import (
+	"github.com/pkg/errors"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+)
+
+// Forwarding headers a trusted proxy can be configured to set
+const (
+	HeaderForwarded     = "Forwarded"
+	HeaderXForwardedFor = "X-Forwarded-For"
+	HeaderXRealIP       = "X-Real-Ip"
+)
+
+// IPResolver finds the IP of the client behind the trusted proxies. Only the forwarding header the proxies set is read,
+// and only when the request comes from a trusted proxy. Its chain is walked from the right so entries added by the
+// client are never trusted.
+type IPResolver struct {
+	TrustedProxies []*net.IPNet
+	// TrustedHeader is one of HeaderForwarded, HeaderXForwardedFor or HeaderXRealIP
+	TrustedHeader string
+}
+
+// NewIPResolver loads the trusted proxies from the comma separated CIDRs or IPs of the TRUSTED_PROXIES env variable,
+// and the header they set from TRUSTED_PROXY_HEADER (Forwarded, X-Forwarded-For or X-Real-IP, X-Forwarded-For if
+// unset)
+func NewIPResolver() (*IPResolver, error) {
+	resolver := &IPResolver{TrustedHeader: HeaderXForwardedFor}
+	if header := strings.TrimSpace(os.Getenv("TRUSTED_PROXY_HEADER")); header != "" {
+		resolver.TrustedHeader = http.CanonicalHeaderKey(header)
+	}
+	switch resolver.TrustedHeader {
+	case HeaderForwarded, HeaderXForwardedFor, HeaderXRealIP:
+	default:
+		return nil, errors.New("unknown trusted proxy header " + resolver.TrustedHeader)
+	}
+
+	for _, proxy := range strings.Split(os.Getenv("TRUSTED_PROXIES"), ",") {
+		proxy = strings.TrimSpace(proxy)
+		if proxy == "" {
+			continue
+		}
+
+		if !strings.Contains(proxy, "/") {
+			ip := net.ParseIP(proxy)
+			if ip == nil {
+				return nil, errors.New("failed to parse trusted proxy " + proxy)
+			}
+			bits := 8 * net.IPv6len
+			if ip.To4() != nil {
+				ip, bits = ip.To4(), 8*net.IPv4len
+			}
+			resolver.TrustedProxies = append(resolver.TrustedProxies, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+
+		_, network, err := net.ParseCIDR(proxy)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to parse trusted proxy "+proxy)
+		}
+		resolver.TrustedProxies = append(resolver.TrustedProxies, network)
+	}
+
+	return resolver, nil
+}
+
+// ClientIP returns the IP of the client: the first untrusted hop of the chain of the trusted header, or the address of
+// the connection. It's empty when that hop is obfuscated or isn't an IP, the client can't be told apart then.
+func (r *IPResolver) ClientIP(request *http.Request) string {
+	remoteIP := parseIP(request.RemoteAddr)
+	if remoteIP == nil {
+		return ""
+	}
+	if !r.isTrusted(remoteIP) {
+		return remoteIP.String()
+	}
+
+	var chain []string
+	if r.TrustedHeader == HeaderForwarded {
+		chain = forwardedFor(request.Header)
+	} else {
+		chain = splitHeader(request.Header.Values(r.TrustedHeader))
+	}
+
+	clientIP := remoteIP
+	for i := len(chain) - 1; i >= 0 && r.isTrusted(clientIP); i-- {
+		clientIP = parseIP(chain[i])
+		if clientIP == nil {
+			return ""
+		}
+	}
+
+	return clientIP.String()
+}
+
+func (r *IPResolver) isTrusted(ip net.IP) bool {
+	for _, network := range r.TrustedProxies {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// forwardedFor returns the for parameters of the Forwarded headers, the client first
+func forwardedFor(header http.Header) []string {
+	var chain []string
+	for _, element := range splitHeader(header.Values("Forwarded")) {
+		for _, pair := range strings.Split(element, ";") {
+			key, value, ok := strings.Cut(strings.TrimSpace(pair), "=")
+			if ok && strings.EqualFold(key, "for") {
+				chain = append(chain, strings.Trim(value, `"`))
+			}
+		}
+	}
+	return chain
+}
+
+func splitHeader(values []string) []string {
+	var elements []string
+	for _, value := range values {
+		for _, element := range strings.Split(value, ",") {
+			element = strings.TrimSpace(element)
+			if element != "" {
+				elements = append(elements, element)
+			}
+		}
+	}
+	return elements
+}
+
+// parseIP accepts an IP with an optional port, IPv6 addresses with a port are in brackets
+func parseIP(address string) net.IP {
+	address = strings.TrimSpace(address)
+	if host, _, err := net.SplitHostPort(address); err == nil {
+		address = host
+	}
+	return net.ParseIP(strings.Trim(address, "[]"))
+}
@@ -0,0 +1,160 @@
+package middleware
+
+import (
+	"github.com/stretchr/testify/suite"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+type IPResolverSuite struct {
+	suite.Suite
+	resolver *IPResolver
+}
+
+func (s *IPResolverSuite) SetupTest() {
+	s.T().Setenv("TRUSTED_PROXIES", "10.0.0.0/8, 2001:db8:ffff::/48, 192.0.2.1")
+
+	var err error
+	s.resolver, err = NewIPResolver()
+	s.Require().NoError(err)
+}
+
+func (s *IPResolverSuite) TestClientIP() {
+	for _, test := range []struct {
+		name          string
+		trustedHeader string
+		remoteAddr    string
+		headers       http.Header
+		expected      string
+	}{
+		{
+			name:       "direct connection",
+			remoteAddr: "203.0.113.7:52000",
+			expected:   "203.0.113.7",
+		},
+		{
+			name:       "headers of untrusted peers are ignored",
+			remoteAddr: "203.0.113.7:52000",
+			headers:    http.Header{"X-Forwarded-For": {"198.51.100.1"}, "X-Real-Ip": {"198.51.100.2"}},
+			expected:   "203.0.113.7",
+		},
+		{
+			name:       "spoofed entries left of the first untrusted hop are ignored",
+			remoteAddr: "10.0.0.2:52000",
+			headers:    http.Header{"X-Forwarded-For": {"1.2.3.4, 198.51.100.1, 10.0.0.3"}},
+			expected:   "198.51.100.1",
+		},
+		{
+			name:       "chain split over several headers",
+			remoteAddr: "10.0.0.2:52000",
+			headers:    http.Header{"X-Forwarded-For": {"198.51.100.1", "192.0.2.1"}},
+			expected:   "198.51.100.1",
+		},
+		{
+			name:       "every hop trusted",
+			remoteAddr: "10.0.0.2:52000",
+			headers:    http.Header{"X-Forwarded-For": {"10.0.0.4, 10.0.0.3"}},
+			expected:   "10.0.0.4",
+		},
+		{
+			name:       "only the trusted header is read",
+			remoteAddr: "10.0.0.2:52000",
+			headers: http.Header{
+				"Forwarded":       {"for=198.51.100.9"},
+				"X-Forwarded-For": {"198.51.100.1"},
+				"X-Real-Ip":       {"198.51.100.2"},
+			},
+			expected: "198.51.100.1",
+		},
+		{
+			name:       "untrusted header without the trusted one",
+			remoteAddr: "10.0.0.2:52000",
+			headers:    http.Header{"Forwarded": {"for=198.51.100.9"}, "X-Real-Ip": {"198.51.100.2"}},
+			expected:   "10.0.0.2",
+		},
+		{
+			name:          "Forwarded",
+			trustedHeader: HeaderForwarded,
+			remoteAddr:    "10.0.0.2:52000",
+			headers: http.Header{
+				"Forwarded":       {`for=198.51.100.9;proto=https, For="[2001:db8:cafe::17]:4711";by=10.0.0.2`},
+				"X-Forwarded-For": {"198.51.100.1"},
+			},
+			expected: "2001:db8:cafe::17",
+		},
+		{
+			name:          "obfuscated hop",
+			trustedHeader: HeaderForwarded,
+			remoteAddr:    "10.0.0.2:52000",
+			headers:       http.Header{"Forwarded": {"for=198.51.100.9, for=_hidden, for=10.0.0.3"}},
+			expected:      "",
+		},
+		{
+			name:          "obfuscated hop left of the first untrusted hop",
+			trustedHeader: HeaderForwarded,
+			remoteAddr:    "10.0.0.2:52000",
+			headers:       http.Header{"Forwarded": {"for=_hidden, for=198.51.100.9"}},
+			expected:      "198.51.100.9",
+		},
+		{
+			name:       "hop that isn't an IP",
+			remoteAddr: "10.0.0.2:52000",
+			headers:    http.Header{"X-Forwarded-For": {"198.51.100.1, unknown"}},
+			expected:   "",
+		},
+		{
+			name:          "X-Real-IP from a trusted proxy",
+			trustedHeader: HeaderXRealIP,
+			remoteAddr:    "[2001:db8:ffff::1]:52000",
+			headers:       http.Header{"X-Real-Ip": {"198.51.100.2"}, "X-Forwarded-For": {"198.51.100.1"}},
+			expected:      "198.51.100.2",
+		},
+		{
+			name:       "trusted proxy without headers",
+			remoteAddr: "192.0.2.1:52000",
+			expected:   "192.0.2.1",
+		},
+	} {
+		s.resolver.TrustedHeader = HeaderXForwardedFor
+		if test.trustedHeader != "" {
+			s.resolver.TrustedHeader = test.trustedHeader
+		}
+
+		request := httptest.NewRequest(http.MethodPost, "/graphql", nil)
+		request.RemoteAddr = test.remoteAddr
+		for key, values := range test.headers {
+			request.Header[key] = values
+		}
+
+		s.Assert().Equal(test.expected, s.resolver.ClientIP(request), test.name)
+	}
+}
+
+func (s *IPResolverSuite) TestNewIPResolver_Invalid() {
+	s.T().Setenv("TRUSTED_PROXIES", "10.0.0.0/33")
+	_, err := NewIPResolver()
+	s.Assert().Error(err)
+
+	s.T().Setenv("TRUSTED_PROXIES", "proxy.internal")
+	_, err = NewIPResolver()
+	s.Assert().Error(err)
+
+	s.T().Setenv("TRUSTED_PROXIES", "10.0.0.0/8")
+	s.T().Setenv("TRUSTED_PROXY_HEADER", "True-Client-IP")
+	_, err = NewIPResolver()
+	s.Assert().ErrorContains(err, "unknown trusted proxy header")
+}
+
+func (s *IPResolverSuite) TestNewIPResolver_TrustedHeader() {
+	s.Assert().Equal(HeaderXForwardedFor, s.resolver.TrustedHeader)
+
+	s.T().Setenv("TRUSTED_PROXY_HEADER", "x-real-ip")
+	resolver, err := NewIPResolver()
+	s.Require().NoError(err)
+	s.Assert().Equal(HeaderXRealIP, resolver.TrustedHeader)
+}
+
+func TestIPResolverSuite(t *testing.T) {
+	suite.Run(t, new(IPResolverSuite))
+}
@@ -0,0 +1,138 @@
+package middleware
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"github.com/pkg/errors"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+)
+
+const (
+	hostCookiePrefix = "__Host-"
+
+	defaultCookieDomain = ".jevels.com"
+	csrfCookieName      = "CSRF-Token"
+)
+
+// CookiePolicy is how the authorization cookies are set. HostPrefix prefixes their names with __Host-, browsers then
+// only accept them from a secure origin, on the path / and without a domain, so a subdomain can't overwrite them.
+type CookiePolicy struct {
+	Domain     string
+	Path       string
+	Secure     bool
+	SameSite   http.SameSite
+	AuthName   string
+	ExpiryName string
+	CSRFName   string
+	HostPrefix bool
+	// CSRFSecret signs the CSRF tokens so they're tied to the session they were issued with
+	CSRFSecret []byte
+}
+
+// NewCookiePolicy loads the policy from the COOKIE_DOMAIN, COOKIE_PATH, COOKIE_SECURE, COOKIE_SAME_SITE (lax, strict or
+// none), COOKIE_AUTH_NAME, COOKIE_EXPIRY_NAME, COOKIE_CSRF_NAME, COOKIE_HOST_PREFIX and CSRF_SECRET env variables. The domain stays .jevels.com
+// unless COOKIE_DOMAIN is set, an empty one makes host-only cookies. Cookies are secure unless COOKIE_SECURE is false,
+// which is only needed on HTTP origins other than localhost.
+func NewCookiePolicy() (*CookiePolicy, error) {
+	domain, ok := os.LookupEnv("COOKIE_DOMAIN")
+	if !ok && os.Getenv("COOKIE_HOST_PREFIX") != "true" {
+		domain = defaultCookieDomain
+	}
+
+	policy := &CookiePolicy{
+		Domain:     domain,
+		Path:       envString("COOKIE_PATH", "/"),
+		Secure:     os.Getenv("COOKIE_SECURE") != "false",
+		SameSite:   http.SameSiteLaxMode,
+		AuthName:   envString("COOKIE_AUTH_NAME", authCookieName),
+		ExpiryName: envString("COOKIE_EXPIRY_NAME", authExpiryName),
+		CSRFName:   envString("COOKIE_CSRF_NAME", csrfCookieName),
+		HostPrefix: os.Getenv("COOKIE_HOST_PREFIX") == "true",
+		CSRFSecret: []byte(os.Getenv("CSRF_SECRET")),
+	}
+
+	switch sameSite := strings.ToLower(os.Getenv("COOKIE_SAME_SITE")); sameSite {
+	case "", "lax":
+	case "strict":
+		policy.SameSite = http.SameSiteStrictMode
+	case "none":
+		policy.SameSite = http.SameSiteNoneMode
+	default:
+		return nil, errors.New("unknown COOKIE_SAME_SITE " + sameSite)
+	}
+
+	err := policy.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	return policy, nil
+}
+
+// Validate rejects the policies browsers would silently ignore the cookies of
+func (p *CookiePolicy) Validate() error {
+	if p.AuthName == "" || p.ExpiryName == "" || p.CSRFName == "" {
+		return errors.New("cookie names can't be empty")
+	}
+	if len(p.CSRFSecret) < 32 {
+		return errors.New("the CSRF secret must be at least 32 bytes")
+	}
+	if p.SameSite == http.SameSiteNoneMode && !p.Secure {
+		return errors.New("SameSite=None cookies must be secure")
+	}
+	if p.HostPrefix && (!p.Secure || p.Path != "/" || p.Domain != "") {
+		return errors.New("__Host- cookies must be secure, on the path / and without a domain")
+	}
+	return nil
+}
+
+func (p *CookiePolicy) AuthCookieName() string {
+	return p.name(p.AuthName)
+}
+
+func (p *CookiePolicy) ExpiryCookieName() string {
+	return p.name(p.ExpiryName)
+}
+
+func (p *CookiePolicy) CSRFCookieName() string {
+	return p.name(p.CSRFName)
+}
+
+// CSRFToken is the HMAC of the JWT of the session, a token leaked from another session is of no use
+func (p *CookiePolicy) CSRFToken(jwt string) string {
+	mac := hmac.New(sha256.New, p.CSRFSecret)
+	mac.Write([]byte(jwt))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func (p *CookiePolicy) name(name string) string {
+	if p.HostPrefix {
+		return hostCookiePrefix + name
+	}
+	return name
+}
+
+func (p *CookiePolicy) cookie(name string, value string, expiry time.Time, httpOnly bool) *http.Cookie {
+	return &http.Cookie{
+		Name:     name,
+		Value:    value,
+		Path:     p.Path,
+		Domain:   p.Domain,
+		Expires:  expiry,
+		Secure:   p.Secure,
+		HttpOnly: httpOnly,
+		SameSite: p.SameSite,
+	}
+}
+
+func envString(key string, defaultValue string) string {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue
+	}
+	return value
+}
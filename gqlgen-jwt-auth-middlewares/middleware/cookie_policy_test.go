@@ -0,0 +1,147 @@
+package middleware
+
+import (
+	"github.com/stretchr/testify/suite"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+const testCSRFSecret = "0123456789abcdef0123456789abcdef"
+
+type CookiePolicySuite struct {
+	suite.Suite
+}
+
+func (s *CookiePolicySuite) SetupTest() {
+	s.T().Setenv("CSRF_SECRET", testCSRFSecret)
+}
+
+func (s *CookiePolicySuite) TestNewCookiePolicy_Defaults() {
+	policy, err := NewCookiePolicy()
+	s.Require().NoError(err)
+
+	s.Assert().Equal(&CookiePolicy{
+		Domain:     ".jevels.com",
+		Path:       "/",
+		Secure:     true,
+		SameSite:   http.SameSiteLaxMode,
+		AuthName:   "Authorization",
+		ExpiryName: "Authorization-expiration",
+		CSRFName:   "CSRF-Token",
+		CSRFSecret: []byte(testCSRFSecret),
+	}, policy)
+}
+
+func (s *CookiePolicySuite) TestNewCookiePolicy_Localhost() {
+	s.T().Setenv("COOKIE_DOMAIN", "")
+	s.T().Setenv("COOKIE_SECURE", "false")
+	s.T().Setenv("COOKIE_SAME_SITE", "strict")
+
+	policy, err := NewCookiePolicy()
+	s.Require().NoError(err)
+	s.Assert().Equal("", policy.Domain)
+	s.Assert().False(policy.Secure)
+	s.Assert().Equal(http.SameSiteStrictMode, policy.SameSite)
+}
+
+func (s *CookiePolicySuite) TestNewCookiePolicy_Invalid() {
+	s.T().Setenv("CSRF_SECRET", "short")
+	_, err := NewCookiePolicy()
+	s.Assert().Error(err)
+	s.T().Setenv("CSRF_SECRET", testCSRFSecret)
+
+	s.T().Setenv("COOKIE_SAME_SITE", "sometimes")
+	_, err = NewCookiePolicy()
+	s.Assert().Error(err)
+
+	s.T().Setenv("COOKIE_SAME_SITE", "none")
+	s.T().Setenv("COOKIE_SECURE", "false")
+	_, err = NewCookiePolicy()
+	s.Assert().Error(err)
+
+	s.T().Setenv("COOKIE_SAME_SITE", "")
+	s.T().Setenv("COOKIE_SECURE", "")
+	s.T().Setenv("COOKIE_HOST_PREFIX", "true")
+	s.T().Setenv("COOKIE_DOMAIN", ".jevels.com")
+	_, err = NewCookiePolicy()
+	s.Assert().Error(err)
+}
+
+func (s *CookiePolicySuite) TestHttpAccess_HostPrefixedCookies() {
+	s.T().Setenv("COOKIE_HOST_PREFIX", "true")
+	policy, err := NewCookiePolicy()
+	s.Require().NoError(err)
+
+	recorder := httptest.NewRecorder()
+	httpAccess := &HttpAccess{Writer: recorder, CookiePolicy: policy}
+	httpAccess.SetAuthorizationCookie("token", time.Hour)
+
+	cookies := recorder.Result().Cookies()
+	s.Require().Len(cookies, 3)
+
+	s.Assert().Equal("__Host-Authorization", cookies[0].Name)
+	s.Assert().Equal("Bearer token", cookies[0].Value)
+	s.Assert().True(cookies[0].HttpOnly)
+	s.Assert().True(cookies[0].Secure)
+	s.Assert().Equal("", cookies[0].Domain)
+	s.Assert().Equal("/", cookies[0].Path)
+	s.Assert().Equal(http.SameSiteLaxMode, cookies[0].SameSite)
+
+	// the expiry cookie is readable by the frontend and expires with the session
+	s.Assert().Equal("__Host-Authorization-expiration", cookies[1].Name)
+	s.Assert().False(cookies[1].HttpOnly)
+	s.Assert().Equal(cookies[0].Expires, cookies[1].Expires)
+
+	// the CSRF cookie is readable by the frontend and tied to the session
+	s.Assert().Equal("__Host-CSRF-Token", cookies[2].Name)
+	s.Assert().False(cookies[2].HttpOnly)
+	s.Assert().Equal(policy.CSRFToken("token"), cookies[2].Value)
+	s.Assert().NotEqual(policy.CSRFToken("other token"), cookies[2].Value)
+}
+
+func (s *CookiePolicySuite) TestHttpAccess_ClearAuthorizationCookies() {
+	policy, err := NewCookiePolicy()
+	s.Require().NoError(err)
+
+	recorder := httptest.NewRecorder()
+	httpAccess := &HttpAccess{Writer: recorder, CookiePolicy: policy}
+	httpAccess.ClearAuthorizationCookies()
+
+	cookies := recorder.Result().Cookies()
+	s.Require().Len(cookies, 3)
+	for i, name := range []string{"Authorization", "Authorization-expiration", "CSRF-Token"} {
+		s.Assert().Equal(name, cookies[i].Name)
+		s.Assert().Equal("", cookies[i].Value)
+		s.Assert().Equal(-1, cookies[i].MaxAge)
+		s.Assert().Equal("jevels.com", cookies[i].Domain)
+		s.Assert().True(cookies[i].Secure)
+	}
+}
+
+func (s *CookiePolicySuite) TestHttpAccess_SocialLoginStateCookie() {
+	s.T().Setenv("COOKIE_HOST_PREFIX", "true")
+	policy, err := NewCookiePolicy()
+	s.Require().NoError(err)
+
+	recorder := httptest.NewRecorder()
+	httpAccess := &HttpAccess{Writer: recorder, CookiePolicy: policy}
+	httpAccess.SetSocialLoginStateCookie("state hash", time.Now().Add(time.Minute))
+
+	cookies := recorder.Result().Cookies()
+	s.Require().Len(cookies, 1)
+	s.Assert().Equal("__Host-Social-Login-State", cookies[0].Name)
+	s.Assert().True(cookies[0].HttpOnly)
+	s.Assert().True(cookies[0].Secure)
+
+	request := httptest.NewRequest(http.MethodPost, "/graphql", nil)
+	httpAccess = &HttpAccess{Writer: httptest.NewRecorder(), Request: request, CookiePolicy: policy}
+	s.Assert().Equal("", httpAccess.SocialLoginStateCookie())
+	request.AddCookie(cookies[0])
+	s.Assert().Equal("state hash", httpAccess.SocialLoginStateCookie())
+}
+
+func TestCookiePolicySuite(t *testing.T) {
+	suite.Run(t, new(CookiePolicySuite))
+}
@@ -0,0 +1,150 @@
+package middleware
+
+import (
+	"crypto/subtle"
+	"github.com/pkg/errors"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/parser"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+)
+
+const csrfHeaderName = "X-CSRF-Token"
+
+var (
+	ErrCSRFGetMutation    = errors.New("mutations can't be sent with GET")
+	ErrCSRFOriginNotValid = errors.New("request origin isn't allowed")
+	ErrCSRFTokenNotValid  = errors.New("CSRF token is missing or not valid")
+)
+
+// CSRFProtection guards the requests authenticated by the authorization cookie, which browsers attach to requests
+// made by any site. Those requests must come from an allowed origin and send the CSRF cookie back in the X-CSRF-Token
+// header, which other sites can't read.
+type CSRFProtection struct {
+	CookiePolicy *CookiePolicy
+	// AllowedOrigins are the scheme://host[:port] of the frontends
+	AllowedOrigins []string
+}
+
+// NewCSRFProtection loads the allowed origins from the comma separated CSRF_ALLOWED_ORIGINS env variable
+func NewCSRFProtection(cookiePolicy *CookiePolicy) (*CSRFProtection, error) {
+	var allowedOrigins []string
+	for _, origin := range strings.Split(os.Getenv("CSRF_ALLOWED_ORIGINS"), ",") {
+		origin = strings.TrimRight(strings.TrimSpace(origin), "/")
+		if origin != "" {
+			allowedOrigins = append(allowedOrigins, origin)
+		}
+	}
+	if len(allowedOrigins) == 0 {
+		return nil, errors.New("CSRF_ALLOWED_ORIGINS is required")
+	}
+
+	return &CSRFProtection{
+		CookiePolicy:   cookiePolicy,
+		AllowedOrigins: allowedOrigins,
+	}, nil
+}
+
+// CSRFMiddleware rejects the requests that fail the CSRF checks with a 403
+func CSRFMiddleware(protection *CSRFProtection, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		err := protection.Check(r)
+		if err != nil {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(errorJson(err)))
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (p *CSRFProtection) Check(r *http.Request) error {
+	switch r.Method {
+	case http.MethodGet, http.MethodHead:
+		// GET requests can be triggered by links and images, they may only read
+		if isMutation(r.URL.Query().Get("query"), r.URL.Query().Get("operationName")) {
+			return ErrCSRFGetMutation
+		}
+		return nil
+	case http.MethodOptions:
+		return nil
+	}
+
+	// browsers never add the Authorization header on their own, the cookie is ignored when it's set
+	if header := r.Header.Get("Authorization"); len(header) > len(AuthCookiePrefix) && strings.EqualFold(header[:len(AuthCookiePrefix)], AuthCookiePrefix) {
+		return nil
+	}
+
+	authCookie, err := r.Cookie(p.CookiePolicy.AuthCookieName())
+	if err != nil || authCookie.Value == "" {
+		// not authenticated, there's nothing to forge
+		return nil
+	}
+
+	if !p.isAllowedOrigin(requestOrigin(r)) {
+		return ErrCSRFOriginNotValid
+	}
+
+	csrfCookie, err := r.Cookie(p.CookiePolicy.CSRFCookieName())
+	if err != nil {
+		return ErrCSRFTokenNotValid
+	}
+	token := r.Header.Get(csrfHeaderName)
+	expected := p.CookiePolicy.CSRFToken(strings.Replace(authCookie.Value, AuthCookiePrefix, "", 1))
+	if token == "" ||
+		subtle.ConstantTimeCompare([]byte(token), []byte(csrfCookie.Value)) != 1 ||
+		subtle.ConstantTimeCompare([]byte(token), []byte(expected)) != 1 {
+		return ErrCSRFTokenNotValid
+	}
+
+	return nil
+}
+
+func (p *CSRFProtection) isAllowedOrigin(origin string) bool {
+	for _, allowedOrigin := range p.AllowedOrigins {
+		if origin == allowedOrigin {
+			return true
+		}
+	}
+	return false
+}
+
+// requestOrigin is the Origin header, or the origin of the Referer for the browsers that don't send it
+func requestOrigin(r *http.Request) string {
+	if origin := r.Header.Get("Origin"); origin != "" {
+		return origin
+	}
+
+	referer, err := url.Parse(r.Header.Get("Referer"))
+	if err != nil || referer.Scheme == "" || referer.Host == "" {
+		return ""
+	}
+	return referer.Scheme + "://" + referer.Host
+}
+
+// isMutation reports if the operation that would run is a mutation, queries that don't parse are left to the GraphQL
+// handler to reject
+func isMutation(query string, operationName string) bool {
+	if query == "" {
+		return false
+	}
+
+	document, err := parser.ParseQuery(&ast.Source{Input: query})
+	if err != nil {
+		return false
+	}
+
+	for _, operation := range document.Operations {
+		if operationName != "" && operation.Name != operationName {
+			continue
+		}
+		if operation.Operation != ast.Query {
+			return true
+		}
+	}
+	return false
+}
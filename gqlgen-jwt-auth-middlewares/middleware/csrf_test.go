@@ -0,0 +1,119 @@
+package middleware
+
+import (
+	"github.com/stretchr/testify/suite"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+type CSRFSuite struct {
+	suite.Suite
+	protection *CSRFProtection
+	token      string
+}
+
+func (s *CSRFSuite) SetupTest() {
+	s.T().Setenv("CSRF_SECRET", testCSRFSecret)
+	s.T().Setenv("CSRF_ALLOWED_ORIGINS", "https://jevels.com, https://www.jevels.com/")
+
+	cookiePolicy, err := NewCookiePolicy()
+	s.Require().NoError(err)
+	s.protection, err = NewCSRFProtection(cookiePolicy)
+	s.Require().NoError(err)
+	s.token = cookiePolicy.CSRFToken("session-jwt")
+}
+
+// cookieRequest is a mutation sent by the frontend with the session cookies
+func (s *CSRFSuite) cookieRequest() *http.Request {
+	request := httptest.NewRequest(http.MethodPost, "/graphql", strings.NewReader(`{"query":"mutation { logout }"}`))
+	request.Header.Set("Origin", "https://jevels.com")
+	request.Header.Set(csrfHeaderName, s.token)
+	request.AddCookie(&http.Cookie{Name: "Authorization", Value: "Bearer session-jwt"})
+	request.AddCookie(&http.Cookie{Name: "CSRF-Token", Value: s.token})
+	return request
+}
+
+func (s *CSRFSuite) TestCheck_CookieRequest() {
+	s.Assert().NoError(s.protection.Check(s.cookieRequest()))
+
+	request := s.cookieRequest()
+	request.Header.Del("Origin")
+	request.Header.Set("Referer", "https://www.jevels.com/account")
+	s.Assert().NoError(s.protection.Check(request))
+}
+
+func (s *CSRFSuite) TestCheck_Origin() {
+	for _, origin := range []string{"https://evil.com", "null", "https://jevels.com.evil.com", ""} {
+		request := s.cookieRequest()
+		request.Header.Set("Origin", origin)
+		s.Assert().ErrorIs(s.protection.Check(request), ErrCSRFOriginNotValid, origin)
+	}
+
+	request := s.cookieRequest()
+	request.Header.Del("Origin")
+	request.Header.Set("Referer", "https://evil.com/jevels.com")
+	s.Assert().ErrorIs(s.protection.Check(request), ErrCSRFOriginNotValid)
+}
+
+func (s *CSRFSuite) TestCheck_Token() {
+	request := s.cookieRequest()
+	request.Header.Del(csrfHeaderName)
+	s.Assert().ErrorIs(s.protection.Check(request), ErrCSRFTokenNotValid)
+
+	// a token issued with another session
+	otherToken := s.protection.CookiePolicy.CSRFToken("other-jwt")
+	request = httptest.NewRequest(http.MethodPost, "/graphql", nil)
+	request.Header.Set("Origin", "https://jevels.com")
+	request.Header.Set(csrfHeaderName, otherToken)
+	request.AddCookie(&http.Cookie{Name: "Authorization", Value: "Bearer session-jwt"})
+	request.AddCookie(&http.Cookie{Name: "CSRF-Token", Value: otherToken})
+	s.Assert().ErrorIs(s.protection.Check(request), ErrCSRFTokenNotValid)
+}
+
+func (s *CSRFSuite) TestCheck_Exemptions() {
+	// not authenticated by cookie
+	request := httptest.NewRequest(http.MethodPost, "/graphql", nil)
+	s.Assert().NoError(s.protection.Check(request))
+
+	// authenticated by header, the cookie is ignored by the authenticate directive
+	request = httptest.NewRequest(http.MethodPost, "/graphql", nil)
+	request.Header.Set("Authorization", "bearer jvl_key")
+	request.AddCookie(&http.Cookie{Name: "Authorization", Value: "Bearer session-jwt"})
+	s.Assert().NoError(s.protection.Check(request))
+}
+
+func (s *CSRFSuite) TestCheck_GetMutation() {
+	query := url.Values{"query": {"query Me { me { id } } mutation Logout { logout }"}}
+
+	query.Set("operationName", "Me")
+	request := httptest.NewRequest(http.MethodGet, "/graphql?"+query.Encode(), nil)
+	s.Assert().NoError(s.protection.Check(request))
+
+	query.Set("operationName", "Logout")
+	request = httptest.NewRequest(http.MethodGet, "/graphql?"+query.Encode(), nil)
+	s.Assert().ErrorIs(s.protection.Check(request), ErrCSRFGetMutation)
+}
+
+func (s *CSRFSuite) TestCSRFMiddleware() {
+	handler := CSRFMiddleware(s.protection, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, s.cookieRequest())
+	s.Assert().Equal(http.StatusOK, recorder.Code)
+
+	request := s.cookieRequest()
+	request.Header.Set("Origin", "https://evil.com")
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, request)
+	s.Assert().Equal(http.StatusForbidden, recorder.Code)
+	s.Assert().JSONEq(`{"message":"request origin isn't allowed"}`, recorder.Body.String())
+}
+
+func TestCSRFSuite(t *testing.T) {
+	suite.Run(t, new(CSRFSuite))
+}
@@ -3,6 +3,7 @@ package middleware
 import (
 	"context"
 	"net/http"
+	"server/api/graphql/dataloader"
 	"server/internal/auth"
 	"server/internal/nft"
 	"server/internal/user"
@@ -12,6 +13,10 @@ type Dependencies struct {
 	NftRepository *nft.Repository
 	UserRepository *user.Repository
 	Auth *auth.Auth
+	// Cache holds what's looked up for the authorization of the request, like the roles and permissions of the user
+	Cache *RequestCache
+	// Loaders batch the lookups of the fields of the users of the request
+	Loaders *dataloader.Loaders
 }
 
 var (
@@ -25,6 +30,8 @@ func RepositoriesMiddleware(nftRepository *nft.Repository, userRepository *user.
 			NftRepository: nftRepository,
 			UserRepository: userRepository,
 			Auth: auth,
+			Cache: NewRequestCache(),
+			Loaders: dataloader.NewLoaders(userRepository),
 		}
 
 		ctx := context.WithValue(r.Context(), ctxKeyRepositories, dependencies)
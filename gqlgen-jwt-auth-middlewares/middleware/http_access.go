@@ -8,16 +8,18 @@ import (
 )
 
 type HttpAccess struct {
-	Writer    http.ResponseWriter
-	Request   *http.Request
-	IP        string
-	UserAgent string
+	Writer       http.ResponseWriter
+	Request      *http.Request
+	IP           string
+	UserAgent    string
+	CookiePolicy *CookiePolicy
 }
 
 const (
-	authCookieName   = "Authorization"
-	authExpiryName   = "Authorization-expiration"
-	authCookiePrefix = "Bearer "
+	authCookieName        = "Authorization"
+	authExpiryName        = "Authorization-expiration"
+	socialLoginCookieName = "Social-Login-State"
+	AuthCookiePrefix      = "Bearer "
 )
 
 var (
@@ -26,38 +28,64 @@ var (
 
 func (c *HttpAccess) SetAuthorizationCookie(cookieValue string, expireIn time.Duration) {
 	expiry := time.Now().Add(expireIn)
-	http.SetCookie(c.Writer, &http.Cookie{
-		Name:     authCookieName,
-		Value:    authCookiePrefix + cookieValue,
-		HttpOnly: true,
-		Path:     "/",
-		Expires:  expiry,
-		Domain:   ".jevels.com",
-	})
+	http.SetCookie(c.Writer, c.CookiePolicy.cookie(c.CookiePolicy.AuthCookieName(), AuthCookiePrefix+cookieValue, expiry, true))
 
-	http.SetCookie(c.Writer, &http.Cookie{
-		Name:     authExpiryName,
-		Value:    strconv.FormatInt(expiry.Unix(), 10),
-		HttpOnly: false,
-		Path:     "/",
-		Expires:  expiry.Add((24 * time.Hour) * 3600),
-		Domain:   ".jevels.com",
-	})
+	// readable by the frontend so it knows when the session ends, it expires with the authorization cookie
+	http.SetCookie(c.Writer, c.CookiePolicy.cookie(c.CookiePolicy.ExpiryCookieName(), strconv.FormatInt(expiry.Unix(), 10), expiry, false))
+
+	// readable by the frontend so it can send it back in the CSRF header
+	http.SetCookie(c.Writer, c.CookiePolicy.cookie(c.CookiePolicy.CSRFCookieName(), c.CookiePolicy.CSRFToken(cookieValue), expiry, false))
+}
+
+// ClearAuthorizationCookies expires the cookies set by SetAuthorizationCookie, the attributes have to match for the
+// browser to replace them
+func (c *HttpAccess) ClearAuthorizationCookies() {
+	for _, cookie := range []*http.Cookie{
+		c.CookiePolicy.cookie(c.CookiePolicy.AuthCookieName(), "", time.Unix(0, 0), true),
+		c.CookiePolicy.cookie(c.CookiePolicy.ExpiryCookieName(), "", time.Unix(0, 0), false),
+		c.CookiePolicy.cookie(c.CookiePolicy.CSRFCookieName(), "", time.Unix(0, 0), false),
+	} {
+		cookie.MaxAge = -1
+		http.SetCookie(c.Writer, cookie)
+	}
+}
+
+// SetSocialLoginStateCookie binds a social login flow to the browser that started it, stateHash is the hash of the
+// state of the flow
+func (c *HttpAccess) SetSocialLoginStateCookie(stateHash string, expiry time.Time) {
+	http.SetCookie(c.Writer, c.CookiePolicy.cookie(c.CookiePolicy.name(socialLoginCookieName), stateHash, expiry, true))
+}
+
+// SocialLoginStateCookie returns the state hash set by SetSocialLoginStateCookie, empty if the browser doesn't hold one
+func (c *HttpAccess) SocialLoginStateCookie() string {
+	cookie, err := c.Request.Cookie(c.CookiePolicy.name(socialLoginCookieName))
+	if err != nil {
+		return ""
+	}
+	return cookie.Value
+}
+
+// ClearSocialLoginStateCookie expires the cookie set by SetSocialLoginStateCookie once the flow ended
+func (c *HttpAccess) ClearSocialLoginStateCookie() {
+	cookie := c.CookiePolicy.cookie(c.CookiePolicy.name(socialLoginCookieName), "", time.Unix(0, 0), true)
+	cookie.MaxAge = -1
+	http.SetCookie(c.Writer, cookie)
 }
 
 // AuthMiddleware decodes the share session cookie and packs the session into context, also provides IP and User Agent
-func HttpAccessMiddleware(next http.Handler) http.Handler {
+func HttpAccessMiddleware(cookiePolicy *CookiePolicy, ipResolver *IPResolver, next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		// get IP and User Agent to read later
-		ip := r.Header.Get("X-Forwarded-For")
+		ip := ipResolver.ClientIP(r)
 		userAgent := r.UserAgent()
 
 		// cookieAccess is a pointer so any changes in future is changing cookieAccess in context
 		cookieAccess := &HttpAccess{
-			Writer:    w,
-			Request:   r,
-			IP:        ip,
-			UserAgent: userAgent,
+			Writer:       w,
+			Request:      r,
+			IP:           ip,
+			UserAgent:    userAgent,
+			CookiePolicy: cookiePolicy,
 		}
 		ctx := context.WithValue(r.Context(), ctxKeyHttpAccess, cookieAccess)
 
@@ -0,0 +1,39 @@
+package middleware
+
+import "sync"
+
+// RequestCache memoizes values for the duration of one request. Fields are resolved concurrently, a value asked for
+// by several resolvers at once is only loaded by the first one and the others wait for it.
+type RequestCache struct {
+	mutex   sync.Mutex
+	entries map[string]*requestCacheEntry
+}
+
+type requestCacheEntry struct {
+	once  sync.Once
+	value interface{}
+	err   error
+}
+
+func NewRequestCache() *RequestCache {
+	return &RequestCache{
+		entries: map[string]*requestCacheEntry{},
+	}
+}
+
+// Load returns the value of key, calling load the first time. Errors are cached too so a failing load isn't retried
+// by every field of the request.
+func (c *RequestCache) Load(key string, load func() (interface{}, error)) (interface{}, error) {
+	c.mutex.Lock()
+	entry, ok := c.entries[key]
+	if !ok {
+		entry = &requestCacheEntry{}
+		c.entries[key] = entry
+	}
+	c.mutex.Unlock()
+
+	entry.once.Do(func() {
+		entry.value, entry.err = load()
+	})
+	return entry.value, entry.err
+}
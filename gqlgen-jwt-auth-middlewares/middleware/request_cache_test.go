@@ -0,0 +1,69 @@
+package middleware
+
+import (
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/suite"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+type RequestCacheSuite struct {
+	suite.Suite
+	cache *RequestCache
+}
+
+func (s *RequestCacheSuite) SetupTest() {
+	s.cache = NewRequestCache()
+}
+
+func (s *RequestCacheSuite) TestLoad_Once() {
+	var loads int32
+	load := func() (interface{}, error) {
+		atomic.AddInt32(&loads, 1)
+		return "value", nil
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 16; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			value, err := s.cache.Load("key", load)
+			s.Assert().NoError(err)
+			s.Assert().Equal("value", value)
+		}()
+	}
+	wg.Wait()
+
+	s.Assert().Equal(int32(1), loads)
+}
+
+func (s *RequestCacheSuite) TestLoad_Keys() {
+	value, err := s.cache.Load("a", func() (interface{}, error) { return 1, nil })
+	s.Require().NoError(err)
+	s.Assert().Equal(1, value)
+
+	value, err = s.cache.Load("b", func() (interface{}, error) { return 2, nil })
+	s.Require().NoError(err)
+	s.Assert().Equal(2, value)
+}
+
+func (s *RequestCacheSuite) TestLoad_Error() {
+	errLoad := errors.New("failed")
+	loads := 0
+	load := func() (interface{}, error) {
+		loads++
+		return nil, errLoad
+	}
+
+	_, err := s.cache.Load("key", load)
+	s.Assert().ErrorIs(err, errLoad)
+	_, err = s.cache.Load("key", load)
+	s.Assert().ErrorIs(err, errLoad)
+	s.Assert().Equal(1, loads)
+}
+
+func TestRequestCache(t *testing.T) {
+	suite.Run(t, new(RequestCacheSuite))
+}
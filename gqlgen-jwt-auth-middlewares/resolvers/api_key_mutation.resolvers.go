@@ -0,0 +1,51 @@
+package resolvers
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+
+import (
+	"context"
+	"server/api/graphql/directives"
+	"server/api/graphql/graph"
+	"server/api/graphql/mappers"
+
+	"github.com/pkg/errors"
+)
+
+func (r *mutationResolver) CreateAPIKey(ctx context.Context, input graph.CreateAPIKeyInput) (*graph.CreatedAPIKey, error) {
+	err := directives.RequireSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	scopes := make([]string, len(input.Scopes))
+	for i, scope := range input.Scopes {
+		scopes[i] = scope.String()
+	}
+
+	userDB := directives.GetLoggedUser(ctx)
+	apiKeyDB, key, err := r.UserRepository.CreateAPIKey(userDB.ID, input.Name, scopes)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to resolve CreateAPIKey mutation")
+	}
+
+	return &graph.CreatedAPIKey{
+		APIKey: mappers.APIKeyToGraph(apiKeyDB),
+		Key:    key,
+	}, nil
+}
+
+func (r *mutationResolver) RevokeAPIKey(ctx context.Context, input graph.RevokeAPIKeyInput) (*string, error) {
+	err := directives.RequireSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	userDB := directives.GetLoggedUser(ctx)
+	err = r.UserRepository.RevokeAPIKey(userDB.ID, input.ID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to resolve RevokeAPIKey mutation")
+	}
+
+	return nil, nil
+}
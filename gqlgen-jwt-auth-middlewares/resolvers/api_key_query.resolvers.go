@@ -0,0 +1,24 @@
+package resolvers
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+
+import (
+	"context"
+	"server/api/graphql/directives"
+	"server/api/graphql/graph"
+	"server/api/graphql/mappers"
+
+	"github.com/pkg/errors"
+)
+
+func (r *queryResolver) MyAPIKeys(ctx context.Context) ([]*graph.APIKey, error) {
+	userDB := directives.GetLoggedUser(ctx)
+
+	apiKeysDB, err := r.UserRepository.APIKeys(userDB.ID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to resolve MyAPIKeys query")
+	}
+
+	return mappers.APIKeysToGraph(apiKeysDB), nil
+}
@@ -5,21 +5,45 @@ package resolvers
 
 import (
 	"context"
+	"encoding/json"
 	"server/api/graphql/directives"
 	"server/api/graphql/graph"
 	"server/api/graphql/middleware"
 	"server/internal/auth"
-	"server/pkg/blockchain"
+	"server/internal/loginguard"
+	"server/internal/outbox"
+	"server/internal/user"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/pkg/errors"
 )
 
 func (r *mutationResolver) Login(ctx context.Context, input *graph.LoginInput) (*graph.Authentication, error) {
-	userDB, err := r.UserRepository.Login(input.Email, input.Password)
+	httpAccess := middleware.GetHttpAccess(ctx)
+	attempt := loginguard.Attempt{Action: loginguard.ActionLogin, Email: input.Email, IP: httpAccess.IP}
+	err := r.waitAttempt(ctx, attempt)
 	if err != nil {
 		return nil, err
-	} else if userDB.RegisterTime == nil {
+	}
+
+	userDB, err := r.UserRepository.Login(input.Email, input.Password)
+	if errors.Is(err, user.ErrInvalidCredentials) {
+		failErr := r.LoginGuard.Fail(attempt)
+		if failErr != nil {
+			return nil, errors.Wrap(failErr, "failed to resolve Login mutation")
+		}
+		return nil, err
+	} else if err != nil {
+		return nil, err
+	}
+
+	err = r.LoginGuard.Succeed(attempt)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to resolve Login mutation")
+	}
+
+	if userDB.RegisterTime == nil {
 		return nil, errors.New("email not confirmed")
 	}
 
@@ -33,7 +57,6 @@ func (r *mutationResolver) Login(ctx context.Context, input *graph.LoginInput) (
 	}
 
 	// set authorization cookie
-	httpAccess := middleware.GetHttpAccess(ctx)
 	httpAccess.SetAuthorizationCookie(jwt, r.Auth.TimeToLive)
 
 	return &graph.Authentication{
@@ -42,23 +65,18 @@ func (r *mutationResolver) Login(ctx context.Context, input *graph.LoginInput) (
 	}, nil
 }
 
-func (r *mutationResolver) LoginBlockchainInitialize(ctx context.Context) (*string, error) {
-	key, err := r.UserRepository.GenerateLoginBlockchainMessage()
+func (r *mutationResolver) LoginBlockchainInitialize(ctx context.Context, input graph.LoginBlockchainInitializeInput) (*string, error) {
+	message, err := r.UserRepository.GenerateLoginBlockchainMessage(input.Address)
 	if err != nil {
 		err = errors.Wrap(err, "failed to resolve LoginBlockchainInitialize mutation")
 		return nil, err
 	}
 
-	return key, nil
+	return message, nil
 }
 
 func (r *mutationResolver) LoginBlockchainEnd(ctx context.Context, input *graph.LoginBlockchainEndInput) (*graph.Authentication, error) {
-	_, err := r.UserRepository.LoginBlockchainMessage(input.Message)
-	if err != nil {
-		return nil, errors.Wrap(err, "failed to resolve LoginBlockchainEndInput mutation")
-	}
-
-	err = r.UserRepository.RevokeLoginBlockchainMessageKey(input.Message)
+	message, err := r.UserRepository.LoginBlockchainMessage(input.Message)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to resolve LoginBlockchainEndInput mutation")
 	}
@@ -67,12 +85,12 @@ func (r *mutationResolver) LoginBlockchainEnd(ctx context.Context, input *graph.
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to decode message to hex")
 	}
-	address, err := blockchain.AddressFromSignature([]byte(input.Message), messageHex, true)
+	err = r.SignatureVerifier.Verify(ctx, common.HexToAddress(message.Address), []byte(input.Message), messageHex)
 	if err != nil {
 		return nil, err
 	}
 
-	userDB, err := r.UserRepository.UserFromAddress(address.String())
+	userDB, err := r.UserRepository.UserFromAddress(message.Address)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to resolve LoginBlockchainEndInput mutation")
 	} else if userDB == nil {
@@ -98,7 +116,125 @@ func (r *mutationResolver) LoginBlockchainEnd(ctx context.Context, input *graph.
 	}, nil
 }
 
+func (r *mutationResolver) LoginPasskeyInitialize(ctx context.Context) (*string, error) {
+	options, err := r.UserRepository.LoginPasskeyInitialize()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to resolve LoginPasskeyInitialize mutation")
+	}
+
+	optionsJSON, err := json.Marshal(options)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to resolve LoginPasskeyInitialize mutation: failed to encode options")
+	}
+	optionsString := string(optionsJSON)
+
+	return &optionsString, nil
+}
+
+func (r *mutationResolver) LoginPasskeyEnd(ctx context.Context, input graph.LoginPasskeyEndInput) (*graph.Authentication, error) {
+	userDB, err := r.UserRepository.LoginPasskeyEnd(input.Credential)
+	if err != nil {
+		return nil, err
+	} else if userDB.RegisterTime == nil {
+		return nil, errors.New("email not confirmed")
+	}
+
+	// create authorization token
+	jwt, err := r.Auth.GenerateJWT(&auth.Claims{
+		UserID:     userDB.ID,
+		Expiration: r.Auth.CalculateExpiration(),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to resolve LoginPasskeyEnd mutation: failed to generate jwt")
+	}
+
+	// set authorization cookie
+	httpAccess := middleware.GetHttpAccess(ctx)
+	httpAccess.SetAuthorizationCookie(jwt, r.Auth.TimeToLive)
+
+	return &graph.Authentication{
+		Jwt:  jwt,
+		User: userDB.ToGraph(),
+	}, nil
+}
+
+func (r *mutationResolver) SocialLoginInitialize(ctx context.Context, input graph.SocialLoginInitializeInput) (*string, error) {
+	start, err := r.UserRepository.SocialLoginInitialize(input.Provider)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to resolve SocialLoginInitialize mutation")
+	}
+
+	middleware.GetHttpAccess(ctx).SetSocialLoginStateCookie(start.StateHash, start.Expiry)
+
+	return &start.AuthCodeURL, nil
+}
+
+func (r *mutationResolver) SocialLoginEnd(ctx context.Context, input graph.SocialLoginEndInput) (*graph.Authentication, error) {
+	httpAccess := middleware.GetHttpAccess(ctx)
+	userDB, err := r.UserRepository.SocialLoginEnd(ctx, input.State, httpAccess.SocialLoginStateCookie(), input.Code)
+	if err != nil {
+		return nil, err
+	}
+	httpAccess.ClearSocialLoginStateCookie()
+
+	// create authorization token
+	jwt, err := r.Auth.GenerateJWT(&auth.Claims{
+		UserID:     userDB.ID,
+		Expiration: r.Auth.CalculateExpiration(),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to resolve SocialLoginEnd mutation: failed to generate jwt")
+	}
+
+	// set authorization cookie
+	httpAccess.SetAuthorizationCookie(jwt, r.Auth.TimeToLive)
+
+	return &graph.Authentication{
+		Jwt:  jwt,
+		User: userDB.ToGraph(),
+	}, nil
+}
+
+func (r *mutationResolver) UnlinkSocialLogin(ctx context.Context, input graph.UnlinkSocialLoginInput) (*string, error) {
+	userDB := directives.GetLoggedUser(ctx)
+
+	err := r.UserRepository.UnlinkIdentity(userDB.ID, input.Provider)
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+func (r *mutationResolver) SocialReauthenticateInitialize(ctx context.Context, input graph.SocialLoginInitializeInput) (*string, error) {
+	userDB := directives.GetLoggedUser(ctx)
+
+	authCodeURL, err := r.UserRepository.SocialReauthenticateInitialize(userDB, input.Provider)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to resolve SocialReauthenticateInitialize mutation")
+	}
+
+	return authCodeURL, nil
+}
+
+func (r *mutationResolver) SocialReauthenticateEnd(ctx context.Context, input graph.SocialLoginEndInput) (*string, error) {
+	userDB := directives.GetLoggedUser(ctx)
+
+	return r.UserRepository.SocialReauthenticateEnd(ctx, userDB, input.State, input.Code)
+}
+
 func (r *mutationResolver) Logout(ctx context.Context) (*string, error) {
+	// the cookies are cleared even if the session already ended, so a browser holding an expired or revoked one can
+	// still log out
+	if directives.IsCookieRequest(ctx) {
+		middleware.GetHttpAccess(ctx).ClearAuthorizationCookies()
+	}
+
+	// API keys don't hold a session, they're revoked with revokeAPIKey
+	if directives.GetLoggedUser(ctx) == nil || directives.GetCredentialSource(ctx) == directives.CredentialAPIKey {
+		return nil, nil
+	}
+
 	jwt, err := directives.GetJWT(ctx)
 	if err != nil {
 		m := "error"
@@ -115,19 +251,39 @@ func (r *mutationResolver) Logout(ctx context.Context) (*string, error) {
 }
 
 func (r *mutationResolver) ForgotPasswordInitialize(ctx context.Context, input *graph.ForgotPasswordInitialize) (*string, error) {
+	httpAccess := middleware.GetHttpAccess(ctx)
+	attempt := loginguard.Attempt{Action: loginguard.ActionForgotPassword, Email: input.Email, IP: httpAccess.IP}
+	err := r.waitAttempt(ctx, attempt)
+	if err != nil {
+		return nil, err
+	}
+
+	// every request counts so the reset emails can't be used to spam
+	err = r.LoginGuard.Fail(attempt)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to resolve ForgotPasswordInitialize mutation")
+	}
+
 	usersDB, err := r.UserRepository.GetUsers(graph.UsersFilter{
 		Email: &input.Email,
 	})
 	if err != nil {
 		err = errors.Wrap(err, "failed to resolve ForgotPasswordInitialize mutation")
 		return nil, err
-	} else if len(usersDB) == 0 {
-		return nil, errors.New("email not registered")
+	} else if len(usersDB) == 0 || usersDB[0].Email != input.Email {
+		// same response as registered emails so they can't be enumerated
+		return nil, nil
 	}
 
-	err = r.UserRepository.GenerateAndSendPassResetKey(*usersDB[0])
+	userID := usersDB[0].ID
+	err = outbox.EnqueueOnce(
+		r.UserRepository.DB,
+		user.JobSendPassResetEmail,
+		emailJobKey(user.JobSendPassResetEmail, userID),
+		user.UserJobPayload{UserID: userID},
+	)
 	if err != nil {
-		return nil, err
+		return nil, errors.Wrap(err, "failed to resolve ForgotPasswordInitialize mutation")
 	}
 
 	return nil, nil
@@ -139,14 +295,13 @@ func (r *mutationResolver) ForgotPasswordEnd(ctx context.Context, input *graph.F
 		return nil, err
 	}
 
-	err = r.UserRepository.RevokePassResetKey(*userID, input.Key)
-	if err != nil {
-		return nil, err
-	}
-
-	err = r.UserRepository.ChangePassword(*userID, input.NewPassword)
+	// the key is consumed once the password passed the policy, consuming fails for every call but the first so
+	// concurrent resets with the same key can't both succeed
+	err = r.UserRepository.ChangePassword(*userID, input.NewPassword, func() error {
+		return r.UserRepository.ConsumePassResetKey(*userID, input.Key)
+	})
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to resolve ForgotPasswordEnd mutation")
+		return nil, passwordPolicyError(ctx, err)
 	}
 
 	return nil, nil
@@ -172,31 +327,73 @@ func (r *mutationResolver) AssociateAddressInitialize(ctx context.Context, input
 func (r *mutationResolver) AssociateAddressEnd(ctx context.Context, input *graph.AssociateAddressEnd) (*string, error) {
 	userDB := directives.GetLoggedUser(ctx)
 
-	associateAddress, err := r.UserRepository.AssociateAddressMessage(userDB.ID)
+	associateAddress, err := r.UserRepository.ConsumeAssociateAddressMessage(userDB.ID)
 	if err != nil {
 		return nil, err
 	}
 
-	err = r.UserRepository.RevokeAssociateMessageKey(userDB.ID)
-	if err != nil {
-		return nil, errors.Wrap(err, "failed to resolve AssociateAddressEnd mutation")
-	}
-
 	signedMessageHex, err := hexutil.Decode(input.SignedMessage)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to decode message to hex")
 	}
-	address, err := blockchain.AddressFromSignature([]byte(associateAddress.Message), signedMessageHex, true)
+	err = r.SignatureVerifier.Verify(ctx, common.HexToAddress(associateAddress.Address), []byte(associateAddress.Message), signedMessageHex)
 	if err != nil {
 		return nil, err
-	} else if associateAddress.Address != address.String() {
-		return nil, errors.New("invalid signature")
 	}
 
-	err = r.UserRepository.AssociateAddress(userDB.ID, address.String())
+	err = r.UserRepository.AssociateAddress(userDB.ID, associateAddress.Address)
 	if err != nil {
 		return nil, err
 	}
 
 	return nil, nil
 }
+
+func (r *mutationResolver) RegisterPasskeyInitialize(ctx context.Context) (*string, error) {
+	userDB := directives.GetLoggedUser(ctx)
+
+	options, err := r.UserRepository.RegisterPasskeyInitialize(userDB)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to resolve RegisterPasskeyInitialize mutation")
+	}
+
+	optionsJSON, err := json.Marshal(options)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to resolve RegisterPasskeyInitialize mutation: failed to encode options")
+	}
+	optionsString := string(optionsJSON)
+
+	return &optionsString, nil
+}
+
+func (r *mutationResolver) RegisterPasskeyEnd(ctx context.Context, input graph.RegisterPasskeyEndInput) (*string, error) {
+	userDB := directives.GetLoggedUser(ctx)
+
+	_, err := r.UserRepository.RegisterPasskeyEnd(userDB, input.Credential)
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+func (r *mutationResolver) UnlockLogin(ctx context.Context, input graph.UnlockLoginInput) (*string, error) {
+	email := ""
+	if input.Email != nil {
+		email = *input.Email
+	}
+	ip := ""
+	if input.IP != nil {
+		ip = *input.IP
+	}
+	if email == "" && ip == "" {
+		return nil, errors.New("email or ip is required")
+	}
+
+	err := r.LoginGuard.Unlock(email, ip)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to resolve UnlockLogin mutation")
+	}
+
+	return nil, nil
+}
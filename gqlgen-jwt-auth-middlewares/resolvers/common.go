@@ -6,9 +6,15 @@ import (
 	"github.com/pkg/errors"
 	"github.com/vektah/gqlparser/v2/gqlerror"
 	"server/api/graphql/graph"
+	"server/api/graphql/grapherrors"
+	"server/api/graphql/middleware"
 	"server/internal/authorization"
 	"server/internal/constant"
 	"server/internal/envs"
+	"server/internal/loginguard"
+	"server/internal/pagination"
+	"server/internal/passwordhash"
+	"server/internal/passwordpolicy"
 	"server/internal/stripe"
 	"server/internal/user"
 	"server/pkg/blockchain"
@@ -16,6 +22,12 @@ import (
 	"time"
 )
 
+var (
+	ErrTooManyAttempts   = grapherrors.NewError("TOO_MANY_ATTEMPTS")
+	ErrPasswordPolicy    = grapherrors.NewError("PASSWORD_POLICY")
+	ErrInvalidPagination = grapherrors.NewError("INVALID_PAGINATION")
+)
+
 func (r *mutationResolver) CheckNftAvailability(nftID int, amountRequested int, userID int) error {
 	// check if nft exists
 	nftsDB, err := r.NftRepository.GetNfts(&graph.NftsFilter{
@@ -150,3 +162,88 @@ func GraphQLError(ctx context.Context, err error, code string) *gqlerror.Error {
 		},
 	}
 }
+
+// waitAttempt applies the brute force protection of the action to the caller's email and IP
+func (r *mutationResolver) waitAttempt(ctx context.Context, attempt loginguard.Attempt) error {
+	err := r.LoginGuard.Wait(ctx, attempt)
+	if errors.Is(err, loginguard.ErrLocked) {
+		return ErrTooManyAttempts.CompleteError(ctx, err)
+	} else if err != nil {
+		return errors.Wrap(err, "failed to check attempts")
+	}
+	return nil
+}
+
+// reauthenticate runs a call that re-checks the password or reauthentication key of the logged user with the same brute
+// force protection as the login, so sensitive mutations can't be used to guess it
+func (r *mutationResolver) reauthenticate(ctx context.Context, userDB *user.User, call func() error) error {
+	httpAccess := middleware.GetHttpAccess(ctx)
+	attempt := loginguard.Attempt{Action: loginguard.ActionLogin, Email: userDB.Email, IP: httpAccess.IP}
+	err := r.waitAttempt(ctx, attempt)
+	if err != nil {
+		return err
+	}
+
+	err = call()
+	if errors.Is(err, user.ErrInvalidCredentials) {
+		failErr := r.LoginGuard.Fail(attempt)
+		if failErr != nil {
+			return errors.Wrap(failErr, "failed to register failed attempt")
+		}
+		return err
+	} else if err != nil {
+		return err
+	}
+
+	err = r.LoginGuard.Succeed(attempt)
+	if err != nil {
+		return errors.Wrap(err, "failed to clear attempts")
+	}
+
+	return nil
+}
+
+// reauthentication reads the optional password and reauthentication key of an input, users created through a social
+// login have no password and get a key from socialReauthenticateEnd instead
+func reauthentication(password *string, key *string) user.Reauthentication {
+	var reauthentication user.Reauthentication
+	if password != nil {
+		reauthentication.Password = *password
+	}
+	if key != nil {
+		reauthentication.Key = *key
+	}
+	return reauthentication
+}
+
+// emailJobWindow groups the requests for the same email, submitting the form again within it doesn't send another one
+const emailJobWindow = time.Minute
+
+// emailJobKey is the idempotency key of an email job requested by the user, it changes every emailJobWindow
+func emailJobKey(jobType string, userID int) string {
+	window := time.Now().Truncate(emailJobWindow).Unix()
+	return jobType + ":request:" + strconv.Itoa(userID) + ":" + strconv.FormatInt(window, 10)
+}
+
+// passwordPolicyError lists the failed password rules in the "rules" extension of the error
+func passwordPolicyError(ctx context.Context, err error) error {
+	var violationErr *passwordpolicy.ViolationError
+	if errors.As(err, &violationErr) {
+		gqlErr := ErrPasswordPolicy.CompleteError(ctx, violationErr)
+		gqlErr.Extensions["rules"] = violationErr.Rules
+		return gqlErr
+	} else if errors.Is(err, passwordhash.ErrPasswordTooLong) {
+		gqlErr := ErrPasswordPolicy.CompleteError(ctx, err)
+		gqlErr.Extensions["rules"] = []passwordpolicy.Rule{passwordpolicy.RuleMaxLength}
+		return gqlErr
+	}
+	return err
+}
+
+// paginationError tells the client its cursor or page size is invalid, other errors are returned as they are
+func paginationError(ctx context.Context, err error) error {
+	if errors.Is(err, pagination.ErrInvalidCursor) || errors.Is(err, pagination.ErrInvalidArgs) {
+		return ErrInvalidPagination.CompleteError(ctx, err)
+	}
+	return err
+}
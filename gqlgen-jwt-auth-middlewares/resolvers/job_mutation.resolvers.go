@@ -0,0 +1,20 @@
+package resolvers
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+
+import (
+	"context"
+	"server/api/graphql/graph"
+
+	"github.com/pkg/errors"
+)
+
+func (r *mutationResolver) RetryJob(ctx context.Context, input graph.RetryJobInput) (*string, error) {
+	err := r.JobWorker.Retry(input.ID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to resolve RetryJob mutation")
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,27 @@
+package resolvers
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+
+import (
+	"context"
+	"server/api/graphql/graph"
+	"server/api/graphql/mappers"
+
+	"github.com/pkg/errors"
+)
+
+func (r *queryResolver) DeadJobs(ctx context.Context, pagination *graph.Pagination) ([]*graph.Job, error) {
+	limit, offset := 50, 0
+	if pagination != nil {
+		limit = pagination.Limit
+		offset = (pagination.Page - 1) * pagination.Limit
+	}
+
+	jobsDB, err := r.JobWorker.DeadJobs(limit, offset)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to resolve DeadJobs query")
+	}
+
+	return mappers.JobsToGraph(jobsDB), nil
+}
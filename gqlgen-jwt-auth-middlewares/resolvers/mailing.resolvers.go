@@ -0,0 +1,34 @@
+package resolvers
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+
+import (
+	"context"
+	"server/api/graphql/graph"
+
+	"github.com/pkg/errors"
+)
+
+func (r *queryResolver) EmailTemplates(ctx context.Context) ([]string, error) {
+	return r.UserRepository.MailTemplates.Names(), nil
+}
+
+func (r *queryResolver) PreviewEmail(ctx context.Context, input graph.PreviewEmailInput) (*graph.EmailPreview, error) {
+	locale := r.UserRepository.MailTemplates.DefaultLocale
+	if input.Locale != nil {
+		locale = *input.Locale
+	}
+
+	message, err := r.UserRepository.MailTemplates.Preview(input.Template, locale)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to resolve PreviewEmail query")
+	}
+
+	return &graph.EmailPreview{
+		From:    message.From,
+		Subject: message.Subject,
+		HTML:    message.HTML,
+		Text:    message.Text,
+	}, nil
+}
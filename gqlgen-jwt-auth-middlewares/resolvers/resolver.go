@@ -6,10 +6,13 @@ import (
 	"server/internal/authorization"
 	"server/internal/blog"
 	"server/internal/ipfs"
+	"server/internal/loginguard"
 	"server/internal/nft"
+	"server/internal/outbox"
 	"server/internal/sales"
 	"server/internal/subscription"
 	"server/internal/user"
+	"server/internal/wallet"
 	"server/pkg/blockchain"
 )
 
@@ -27,5 +30,8 @@ type Resolver struct {
 	IPFS                    *ipfs.IPFS
 	Auth                    *auth.Auth
 	Blockchain              *blockchain.Blockchain
+	SignatureVerifier       *wallet.SignatureVerifier
+	LoginGuard              *loginguard.Guard
+	JobWorker               *outbox.Worker
 	Gountries               *gountries.Query
 }
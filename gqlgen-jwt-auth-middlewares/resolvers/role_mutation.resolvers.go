@@ -0,0 +1,59 @@
+package resolvers
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+
+import (
+	"context"
+	"server/api/graphql/graph"
+	"server/api/graphql/mappers"
+	"server/internal/user"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+func (r *mutationResolver) CreateRole(ctx context.Context, input graph.CreateRoleInput) (*graph.Role, error) {
+	roleDB, err := r.UserRepository.CreateRole(strings.TrimSpace(input.Name))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to resolve CreateRole mutation")
+	}
+
+	return user.RolesDBToGraph([]*user.Role{roleDB})[0], nil
+}
+
+func (r *mutationResolver) DeleteRole(ctx context.Context, input graph.DeleteRoleInput) (*string, error) {
+	err := r.UserRepository.DeleteRole(input.ID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to resolve DeleteRole mutation")
+	}
+
+	return nil, nil
+}
+
+func (r *mutationResolver) CreatePermission(ctx context.Context, input graph.CreatePermissionInput) (*graph.Permission, error) {
+	permissionDB, err := r.UserRepository.CreatePermission(strings.TrimSpace(input.Name), input.Description)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to resolve CreatePermission mutation")
+	}
+
+	return mappers.PermissionToGraph(permissionDB), nil
+}
+
+func (r *mutationResolver) DeletePermission(ctx context.Context, input graph.DeletePermissionInput) (*string, error) {
+	err := r.UserRepository.DeletePermission(input.ID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to resolve DeletePermission mutation")
+	}
+
+	return nil, nil
+}
+
+func (r *mutationResolver) SetRolePermission(ctx context.Context, input graph.SetRolePermissionInput) (*string, error) {
+	err := r.UserRepository.SetRolePermission(input.RoleID, input.PermissionID, input.Activate)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to resolve SetRolePermission mutation")
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,26 @@
+package resolvers
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+
+import (
+	"context"
+	"server/api/graphql/graph"
+	"server/api/graphql/mappers"
+
+	"github.com/pkg/errors"
+)
+
+func (r *queryResolver) Permissions(ctx context.Context, filter *graph.PermissionsFilter) ([]*graph.Permission, error) {
+	var roleID *int
+	if filter != nil {
+		roleID = filter.RoleID
+	}
+
+	permissionsDB, err := r.UserRepository.GetPermissions(roleID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to resolve Permissions query")
+	}
+
+	return mappers.PermissionsToGraph(permissionsDB), nil
+}
@@ -6,6 +6,7 @@ package resolvers
 import (
 	"context"
 	"server/api/graphql/graph"
+	"server/api/graphql/middleware"
 	"server/internal/conversion"
 	"server/internal/nft"
 	"server/internal/thegraph"
@@ -15,7 +16,7 @@ import (
 )
 
 func (r *userResolver) Addresses(ctx context.Context, obj *graph.User) ([]string, error) {
-	userHasAddressesDB, err := r.UserRepository.Addresses(obj.ID)
+	userHasAddressesDB, err := middleware.GetDependencies(ctx).Loaders.Addresses(obj.ID)
 	if err != nil {
 		err = errors.Wrap(err, "failed to resolve Addresses field")
 		return nil, err
@@ -30,8 +31,10 @@ func (r *userResolver) Addresses(ctx context.Context, obj *graph.User) ([]string
 }
 
 func (r *userResolver) Owned(ctx context.Context, obj *graph.User) ([]*graph.UserHasNfts, error) {
+	loaders := middleware.GetDependencies(ctx).Loaders
+
 	// get user addresses
-	userHasAddressesDB, err := r.UserRepository.Addresses(obj.ID)
+	userHasAddressesDB, err := loaders.Addresses(obj.ID)
 	if err != nil {
 		err = errors.Wrap(err, "failed to resolve Owned field: failed to get user addresses")
 		return nil, err
@@ -78,9 +81,11 @@ func (r *userResolver) Owned(ctx context.Context, obj *graph.User) ([]*graph.Use
 	}
 
 	// get offchain nfts
-	offchainNfts, err := r.UserRepository.OffchainNfts(user.OffchainNftsFilter{
-		UserID: obj.ID,
-	})
+	offchainNfts, err := loaders.OffchainNfts(obj.ID)
+	if err != nil {
+		err = errors.Wrap(err, "failed to resolve Owned field: failed to get offchain Nfts")
+		return nil, err
+	}
 
 	for _, offchainNft := range offchainNfts {
 		seen := false
@@ -136,11 +141,7 @@ func (r *userResolver) Designed(ctx context.Context, obj *graph.User, filter *gr
 }
 
 func (r *userResolver) Roles(ctx context.Context, obj *graph.User) ([]*graph.Role, error) {
-	rolesDB, err := r.UserRepository.GetRoles(graph.RolesFilter{
-		User: &graph.RolesUserFilter{
-			ID: &obj.ID,
-		},
-	})
+	rolesDB, err := middleware.GetDependencies(ctx).Loaders.Roles(obj.ID)
 	if err != nil {
 		err = errors.Wrap(err, "failed to resolve Roles field")
 		return nil, err
@@ -150,7 +151,7 @@ func (r *userResolver) Roles(ctx context.Context, obj *graph.User) ([]*graph.Rol
 }
 
 func (r *userResolver) Profile(ctx context.Context, obj *graph.User) (*graph.Profile, error) {
-	profileDB, err := r.UserRepository.GetProfile(obj.ID)
+	profileDB, err := middleware.GetDependencies(ctx).Loaders.Profile(obj.ID)
 	if err != nil {
 		return nil, err
 	}
@@ -171,18 +172,13 @@ func (r *userResolver) Profile(ctx context.Context, obj *graph.User) (*graph.Pro
 }
 
 func (r *userResolver) Address(ctx context.Context, obj *graph.User) (*graph.Address, error) {
-	usersDB, err := r.UserRepository.GetUsers(graph.UsersFilter{
-		Ids: []int{obj.ID},
-	})
-
+	userDB, err := middleware.GetDependencies(ctx).Loaders.User(obj.ID)
 	if err != nil {
 		return nil, err
-	} else if len(usersDB) == 0 {
+	} else if userDB == nil {
 		return nil, errors.New("user doesn't exist")
 	}
 
-	userDB := usersDB[0]
-
 	country, err := r.Gountries.FindCountryByAlpha(userDB.Country)
 	if err != nil {
 		return nil, err
@@ -5,18 +5,19 @@ package resolvers
 
 import (
 	"context"
-	"fmt"
+	"encoding/base64"
 	"net/mail"
 	"server/api/graphql/directives"
 	"server/api/graphql/graph"
 	"server/api/graphql/middleware"
-	"server/internal/omnisend"
-	"server/internal/subscription"
+	"server/internal/loginguard"
+	"server/internal/outbox"
 	"server/internal/user"
 	"strconv"
 	"time"
 
 	"github.com/pkg/errors"
+	"gorm.io/gorm"
 )
 
 func (r *mutationResolver) CreateUser(ctx context.Context, input graph.CreateUserInput) (*graph.User, error) {
@@ -25,92 +26,53 @@ func (r *mutationResolver) CreateUser(ctx context.Context, input graph.CreateUse
 		return nil, errors.New("invalid email address")
 	}
 
-	// create user
-	userDB, err := r.UserRepository.Create(input)
-	if err != nil {
-		return nil, err
-	}
-
-	err = r.SubscriptionRepository.Create(&subscription.Subscription{
-		Email:              input.Email,
-		SubscriptionTypeID: subscription.SubscriptionTypeIDNewsletter,
-	})
-	if err != nil {
-		fmt.Println(err)
-	}
-
-	err = r.UserRepository.GenerateAndSendConfirmationKey(*userDB)
-	if err != nil {
-		return nil, err
-	}
-
+	var designerApplicationIP string
 	if input.ApplyAsDesigner {
-		httpAccess := middleware.GetHttpAccess(ctx)
-		if httpAccess.IP == "" {
-			_ = r.UserRepository.Delete(userDB.ID)
+		designerApplicationIP = middleware.GetHttpAccess(ctx).IP
+		if designerApplicationIP == "" {
 			return nil, errors.New("failed to apply as designer: failed to get IP from request for TOS acceptance")
 		}
+	}
 
-		_, err := r.UserRepository.SubmitDesignerApplication(userDB.ID, httpAccess.IP)
+	// every write is part of one transaction and the side effects only run once it commits
+	var userDB *user.User
+	err = r.UserRepository.DB.Transaction(func(tx *gorm.DB) error {
+		userRepository := r.UserRepository.WithTx(tx)
+
+		userDB, err = userRepository.Create(input)
 		if err != nil {
-			return nil, err
+			return err
 		}
-	}
 
-	// subscribe to Omnisend
-	omnisendContact, err := omnisend.GetContact(userDB.Email)
-	if err != nil {
-		fmt.Println("failed to upsert Omnisend contact for user with id " + strconv.Itoa(userDB.ID) + ": " + err.Error())
-	}
+		if input.ApplyAsDesigner {
+			_, err = userRepository.SubmitDesignerApplication(userDB.ID, designerApplicationIP)
+			if err != nil {
+				return errors.Wrap(err, "failed to apply as designer")
+			}
+		}
 
-	contactToUpsert := omnisend.Contact{
-		CreatedAt: omnisend.JSONTime(time.Now()),
-		FirstName: userDB.FirstName,
-		LastName:  userDB.LastName,
-		Tags: []omnisend.Tag{
-			omnisend.TagCustomer,
-		},
-		Identifiers: []omnisend.Identifier{{
-			Type: omnisend.IdentifierTypeEmail,
-			Id:   userDB.Email,
-			Channels: omnisend.Channel{Email: &omnisend.ChannelDetails{
-				Status:     omnisend.ChannelStatusSubscribed,
-				StatusDate: omnisend.JSONTime(time.Now()),
-			}},
-		}},
-		CountryCode: userDB.Country,
-	}
+		jobTypes := []string{user.JobSendConfirmationEmail, user.JobUpsertOmnisendContact, user.JobSubscribeNewsletter}
+		for _, jobType := range jobTypes {
+			err = outbox.EnqueueOnce(tx, jobType, jobType+":register:"+strconv.Itoa(userDB.ID), user.UserJobPayload{UserID: userDB.ID})
+			if err != nil {
+				return err
+			}
+		}
 
-	if omnisendContact == nil {
-		err = omnisend.CreateContact(contactToUpsert)
-	} else {
-		err = omnisend.PatchContact(contactToUpsert, omnisendContact.ContactID)
-	}
+		return nil
+	})
 	if err != nil {
-		fmt.Println("failed to upsert Omnisend contact for user with id " + strconv.Itoa(userDB.ID) + ": " + err.Error())
+		return nil, passwordPolicyError(ctx, err)
 	}
 
 	return userDB.ToGraph(), nil
 }
 
 func (r *mutationResolver) ConfirmEmail(ctx context.Context, input graph.ConfirmEmailInput) (*string, error) {
-	userID, err := r.UserRepository.UserIdFromConfirmationKey(input.Key)
+	// the key is consumed first so it can only confirm once, expired keys are replaced through resendConfirmationEmail
+	userID, err := r.UserRepository.ConsumeConfirmationKey(input.Key)
 	if err != nil {
-		if errors.Is(err, user.ErrConfirmationKeyNoLongerValid) {
-			usersDB, err := r.UserRepository.GetUsers(graph.UsersFilter{
-				Ids: []int{*userID},
-			})
-			if err != nil {
-				return nil, err
-			}
-			err = r.UserRepository.GenerateAndSendConfirmationKey(*usersDB[0])
-			if err != nil {
-				return nil, err
-			}
-			return nil, errors.Wrap(err, "please check your email for a new confirmation link")
-		} else {
-			return nil, err
-		}
+		return nil, err
 	}
 
 	usersDB, err := r.UserRepository.GetUsers(graph.UsersFilter{
@@ -137,72 +99,154 @@ func (r *mutationResolver) ConfirmEmail(ctx context.Context, input graph.Confirm
 			return nil, err
 		}*/
 
-	// subscribe to Omnisend
-	omnisendContact, err := omnisend.GetContact(userDB.Email)
+	// the Omnisend subscription runs once the confirmation commits
+	err = r.UserRepository.DB.Transaction(func(tx *gorm.DB) error {
+		err := r.UserRepository.WithTx(tx).ConfirmUser(userDB.ID)
+		if err != nil {
+			return err
+		}
+
+		return outbox.EnqueueOnce(tx, user.JobUpsertOmnisendContact, user.JobUpsertOmnisendContact+":confirm:"+strconv.Itoa(userDB.ID), user.UserJobPayload{UserID: userDB.ID})
+	})
 	if err != nil {
-		fmt.Println("failed to upsert Omnisend contact for user with id " + strconv.Itoa(userDB.ID) + ": " + err.Error())
+		return nil, errors.Wrap(err, "failed to resolve ConfirmEmail mutation")
 	}
 
-	contactToUpsert := omnisend.Contact{
-		CreatedAt: omnisend.JSONTime(time.Now()),
-		FirstName: userDB.FirstName,
-		LastName:  userDB.LastName,
-		Tags: []omnisend.Tag{
-			omnisend.TagCustomer,
-		},
-		Identifiers: []omnisend.Identifier{{
-			Type: omnisend.IdentifierTypeEmail,
-			Id:   userDB.Email,
-			Channels: omnisend.Channel{Email: &omnisend.ChannelDetails{
-				Status:     omnisend.ChannelStatusSubscribed,
-				StatusDate: omnisend.JSONTime(time.Now()),
-			}},
-		}},
-		CountryCode: userDB.Country,
+	return nil, nil
+}
+
+func (r *mutationResolver) ResendConfirmationEmail(ctx context.Context, input *graph.ResendConfirmationEmailInput) (*string, error) {
+	if input.Email == nil {
+		return nil, errors.New("email is required")
 	}
 
-	if omnisendContact == nil {
-		err = omnisend.CreateContact(contactToUpsert)
-	} else {
-		err = omnisend.PatchContact(contactToUpsert, omnisendContact.ContactID)
+	httpAccess := middleware.GetHttpAccess(ctx)
+	attempt := loginguard.Attempt{Action: loginguard.ActionResendConfirmation, Email: *input.Email, IP: httpAccess.IP}
+	err := r.waitAttempt(ctx, attempt)
+	if err != nil {
+		return nil, err
 	}
+
+	// every request counts so the confirmation emails can't be used to spam
+	err = r.LoginGuard.Fail(attempt)
 	if err != nil {
-		fmt.Println("failed to upsert Omnisend contact for user with id " + strconv.Itoa(userDB.ID) + ": " + err.Error())
+		return nil, errors.Wrap(err, "failed to resolve ResendConfirmationEmail mutation")
 	}
 
-	err = r.UserRepository.ConfirmUser(*userID)
+	usersDB, err := r.UserRepository.GetUsers(graph.UsersFilter{
+		Email: input.Email,
+	})
 	if err != nil {
 		return nil, err
+	} else if len(usersDB) == 0 || usersDB[0].Email != *input.Email || usersDB[0].RegisterTime != nil {
+		// same response for unregistered and confirmed emails so they can't be enumerated
+		return nil, nil
 	}
 
-	err = r.UserRepository.RevokeConfirmationKey(input.Key)
+	userID := usersDB[0].ID
+	err = outbox.EnqueueOnce(
+		r.UserRepository.DB,
+		user.JobSendConfirmationEmail,
+		emailJobKey(user.JobSendConfirmationEmail, userID),
+		user.UserJobPayload{UserID: userID},
+	)
 	if err != nil {
-		return nil, err
+		return nil, errors.Wrap(err, "failed to resolve ResendConfirmationEmail mutation")
 	}
 
 	return nil, nil
 }
 
-func (r *mutationResolver) ResendConfirmationEmail(ctx context.Context, input *graph.ResendConfirmationEmailInput) (*string, error) {
-	usersDB, err := r.UserRepository.GetUsers(graph.UsersFilter{
-		Email: input.Email,
+func (r *mutationResolver) RequestEmailChange(ctx context.Context, input graph.RequestEmailChangeInput) (*string, error) {
+	_, err := mail.ParseAddress(input.NewEmail)
+	if err != nil {
+		return nil, errors.New("invalid email address")
+	}
+
+	userDB := directives.GetLoggedUser(ctx)
+	err = r.reauthenticate(ctx, userDB, func() error {
+		return r.UserRepository.RequestEmailChange(userDB, reauthentication(input.Password, input.ReauthenticationKey), input.NewEmail)
 	})
 	if err != nil {
 		return nil, err
-	} else if len(usersDB) == 0 {
-		return nil, errors.New("email not registered")
-	} else if usersDB[0].RegisterTime != nil {
-		return nil, errors.New("email already confirmed")
 	}
 
-	err = r.UserRepository.GenerateAndSendConfirmationKey(*usersDB[0])
+	return nil, nil
+}
+
+func (r *mutationResolver) ConfirmEmailChange(ctx context.Context, input graph.ConfirmEmailChangeInput) (*string, error) {
+	emailChange, err := r.UserRepository.ConfirmEmailChange(input.Key)
+	if err != nil {
+		return nil, err
+	}
+
+	// keep the session that confirmed the change if it belongs to the user
+	currentJWT := ""
+	if loggedUser := directives.GetLoggedUser(ctx); loggedUser != nil && loggedUser.ID == emailChange.UserID {
+		jwt, err := directives.GetJWT(ctx)
+		if err == nil {
+			currentJWT = *jwt
+		}
+	}
+	err = r.Auth.RevokeUserTokens(emailChange.UserID, currentJWT)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to resolve ConfirmEmailChange mutation")
+	}
+
+	return nil, nil
+}
+
+func (r *mutationResolver) DeleteMyAccount(ctx context.Context, input graph.DeleteMyAccountInput) (*string, error) {
+	userDB := directives.GetLoggedUser(ctx)
+
+	var deletionTime *time.Time
+	err := r.reauthenticate(ctx, userDB, func() error {
+		var err error
+		deletionTime, err = r.UserRepository.RequestAccountDeletion(userDB, reauthentication(input.Password, input.ReauthenticationKey))
+		return err
+	})
 	if err != nil {
 		return nil, err
 	}
 
+	// every session ends, logging in again during the grace period allows cancelling the deletion
+	err = r.Auth.RevokeUserTokens(userDB.ID, "")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to resolve DeleteMyAccount mutation")
+	}
+
+	deletionTimeString := deletionTime.UTC().Format(time.RFC3339)
+	return &deletionTimeString, nil
+}
+
+func (r *mutationResolver) CancelAccountDeletion(ctx context.Context) (*string, error) {
+	userDB := directives.GetLoggedUser(ctx)
+
+	err := r.UserRepository.CancelAccountDeletion(userDB.ID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to resolve CancelAccountDeletion mutation")
+	}
+
 	return nil, nil
 }
 
+func (r *mutationResolver) ExportMyData(ctx context.Context, input graph.ExportMyDataInput) (*string, error) {
+	userDB := directives.GetLoggedUser(ctx)
+
+	var export []byte
+	err := r.reauthenticate(ctx, userDB, func() error {
+		var err error
+		export, err = r.UserRepository.ExportData(userDB, reauthentication(input.Password, input.ReauthenticationKey))
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	exportString := base64.StdEncoding.EncodeToString(export)
+	return &exportString, nil
+}
+
 func (r *mutationResolver) SetRole(ctx context.Context, input *graph.SetRoleInput) (*string, error) {
 	err := r.UserRepository.SetRole(input.UserID, input.RoleID, input.Activate)
 	if err != nil {
@@ -250,6 +294,17 @@ func (r *mutationResolver) UpdateProfile(ctx context.Context, input *graph.Profi
 	return nil, nil
 }
 
+func (r *mutationResolver) SetLocale(ctx context.Context, input graph.SetLocaleInput) (*string, error) {
+	userDB := directives.GetLoggedUser(ctx)
+
+	err := r.UserRepository.SetLocale(userDB.ID, input.Locale)
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
 func (r *mutationResolver) AssignOffChainNfts(ctx context.Context, input graph.AssignOffChainNftsInput) (*string, error) {
 	var err error
 	userDB := directives.GetLoggedUser(ctx)
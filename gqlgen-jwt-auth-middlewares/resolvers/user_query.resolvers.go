@@ -8,6 +8,9 @@ import (
 	"net/mail"
 	"server/api/graphql/directives"
 	"server/api/graphql/graph"
+	"server/api/graphql/mappers"
+	"server/api/graphql/middleware"
+	"server/internal/pagination"
 	"server/internal/user"
 
 	"github.com/99designs/gqlgen/graphql"
@@ -17,17 +20,50 @@ import (
 func (r *queryResolver) Users(ctx context.Context, filter graph.UsersFilter) (*graph.UsersResult, error) {
 	var err error
 
+	// bounded like a page of usersConnection, it's the size the cost of the field assumes
+	if filter.Pagination == nil {
+		filter.Pagination = &graph.Pagination{Limit: pagination.MaxPageSize, Page: 1}
+	}
+
 	usersDB, err := r.UserRepository.GetUsers(filter)
 	if err != nil {
 		err = errors.Wrap(err, "failed to resolve Users query")
 		return nil, err
 	}
 
+	// the fields of the users ask for them again
+	middleware.GetDependencies(ctx).Loaders.PrimeUsers(usersDB)
+
 	return &graph.UsersResult{
 		Users: user.UsersDBToGraph(usersDB),
 	}, nil
 }
 
+func (r *queryResolver) UsersConnection(ctx context.Context, filter graph.UsersFilter, first *int, after *string, last *int, before *string) (*graph.UserConnection, error) {
+	usersDB, page, err := r.UserRepository.GetUsersPage(filter, mappers.PaginationArgs(first, after, last, before))
+	if err != nil {
+		err = errors.Wrap(err, "failed to resolve UsersConnection query")
+		return nil, paginationError(ctx, err)
+	}
+
+	// the fields of the users ask for them again
+	middleware.GetDependencies(ctx).Loaders.PrimeUsers(usersDB)
+
+	usersGraph := user.UsersDBToGraph(usersDB)
+	edges := make([]*graph.UserEdge, len(usersGraph))
+	for i, userGraph := range usersGraph {
+		edges[i] = &graph.UserEdge{
+			Cursor: page.Cursors[i],
+			Node:   userGraph,
+		}
+	}
+
+	return &graph.UserConnection{
+		Edges:    edges,
+		PageInfo: mappers.PageInfoToGraph(page),
+	}, nil
+}
+
 func (r *queryResolver) OffchainNfts(ctx context.Context) ([]*graph.OffchainNft, error) {
 	userDB := directives.GetLoggedUser(ctx)
 	offchainNfts, err := r.UserRepository.OffchainNfts(user.OffchainNftsFilter{